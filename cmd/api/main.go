@@ -10,11 +10,13 @@ import (
 	httpHandler "go-ai/internal/transport/http"
 	"go-ai/internal/transport/http/middlewares"
 	"go-ai/pkg/logger"
+	uilts "go-ai/pkg/utils"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
+	_ "time/tzdata" // the alpine runtime image ships without zoneinfo
 
 	"github.com/labstack/echo-contrib/prometheus"
 	"github.com/labstack/echo/v4"
@@ -63,13 +65,34 @@ func main() {
 		}).Msg("Connect redis fail")
 	}
 
+	keys, err := uilts.LoadKeySet(cfg.JwtKeysDir, cfg.JwtSigningKid)
+	if err != nil {
+		if !cfg.IsDevelopment() {
+			logger.Fatal().Err(err).Str("dir", cfg.JwtKeysDir).Msg("Load jwt keys fail")
+		}
+		logger.Warn().Err(err).Str("dir", cfg.JwtKeysDir).Msg("no jwt keys, signing with an ephemeral key")
+		keys, err = uilts.NewEphemeralKeySet()
+		if err != nil {
+			logger.Fatal().Err(err).Msg("Generate jwt key fail")
+		}
+	}
+	logger.Info().Str("kid", keys.SigningKid()).Msg("jwt signing key loaded")
+
+	// with a known secret anyone can sign table links and order through them
+	if cfg.TableLinkSecret == "" || cfg.TableLinkSecret == config.DevTableLinkSecret {
+		if !cfg.IsDevelopment() {
+			logger.Fatal().Msg("TABLE_LINK_SECRET is not set")
+		}
+		logger.Warn().Msg("no TABLE_LINK_SECRET, signing table links with the development secret")
+	}
+
 	port := fmt.Sprintf(":%s", cfg.ServerPort)
 	go func() {
 		if err := e.Start(port); err != nil && err != http.ErrServerClosed {
 			logger.Fatal().Err(err).Msg("Shutting down the server")
 		}
 	}()
-	httpHandler.Router(pool, e, redisClient)
+	httpHandler.Router(pool, e, redisClient, keys)
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
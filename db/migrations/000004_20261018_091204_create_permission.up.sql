@@ -0,0 +1,45 @@
+-- =========================
+-- PERMISSIONS
+-- =========================
+CREATE TABLE IF NOT EXISTS permission (
+  id           INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  name         TEXT NOT NULL UNIQUE,
+  description  TEXT,
+  created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER trg_permission_updated_at
+BEFORE UPDATE ON permission
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+CREATE TABLE IF NOT EXISTS role_permission (
+  role_id        INT NOT NULL REFERENCES role(id) ON DELETE CASCADE,
+  permission_id  INT NOT NULL REFERENCES permission(id) ON DELETE CASCADE,
+  PRIMARY KEY (role_id, permission_id)
+);
+
+INSERT INTO permission (name, description)
+VALUES
+  ('restaurant:create', 'Create restaurants'),
+  ('restaurant:read', 'View restaurants'),
+  ('restaurant:update', 'Update restaurants'),
+  ('restaurant:delete', 'Delete restaurants'),
+  ('upload:create', 'Upload files'),
+  ('user:read', 'View users'),
+  ('user:manage', 'Activate, deactivate and assign roles to users');
+
+-- admin có tất cả quyền
+INSERT INTO role_permission (role_id, permission_id)
+SELECT r.id, p.id FROM role r CROSS JOIN permission p
+WHERE r.role_name = 'admin';
+
+INSERT INTO role_permission (role_id, permission_id)
+SELECT r.id, p.id FROM role r JOIN permission p ON p.name IN (
+  'restaurant:create', 'restaurant:read', 'restaurant:update', 'restaurant:delete', 'upload:create'
+)
+WHERE r.role_name IN ('user', 'manager');
+
+INSERT INTO role_permission (role_id, permission_id)
+SELECT r.id, p.id FROM role r JOIN permission p ON p.name IN ('restaurant:read')
+WHERE r.role_name = 'staff';
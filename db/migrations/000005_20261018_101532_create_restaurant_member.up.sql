@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS restaurant_member (
+  restaurant_id  INT NOT NULL REFERENCES restaurant(id) ON DELETE CASCADE,
+  user_id        UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+  role           TEXT NOT NULL CHECK (role IN ('owner', 'manager', 'staff')),
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (restaurant_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_restaurant_member_user ON restaurant_member(user_id);
+
+CREATE TRIGGER trg_restaurant_member_updated_at
+BEFORE UPDATE ON restaurant_member
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- người tạo nhà hàng trước đây trở thành owner
+INSERT INTO restaurant_member (restaurant_id, user_id, role)
+SELECT id, user_id, 'owner' FROM restaurant
+WHERE user_id IS NOT NULL
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,15 @@
+ALTER TABLE "user"
+ADD email_verified_at TIMESTAMPTZ;
+
+-- tài khoản đã tồn tại được coi là đã xác thực
+UPDATE "user" SET email_verified_at = NOW() WHERE email_verified_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS email_verification_token (
+  id           BIGSERIAL PRIMARY KEY,
+  user_id      UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+  token_hash   TEXT NOT NULL UNIQUE,
+  expires_at   TIMESTAMPTZ NOT NULL,
+  used_at      TIMESTAMPTZ,
+  created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_email_verification_token_user ON email_verification_token(user_id);
@@ -0,0 +1,12 @@
+ALTER TABLE "user"
+ADD totp_secret TEXT,
+ADD totp_enabled_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS user_recovery_code (
+  id           BIGSERIAL PRIMARY KEY,
+  user_id      UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+  code_hash    TEXT NOT NULL,
+  used_at      TIMESTAMPTZ,
+  created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_user_recovery_code_user ON user_recovery_code(user_id);
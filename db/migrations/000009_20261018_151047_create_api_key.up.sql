@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS api_key (
+  id             UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id        UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+  name           TEXT NOT NULL,
+  prefix         TEXT NOT NULL UNIQUE,
+  secret_hash    TEXT NOT NULL,
+  scopes         TEXT[] NOT NULL DEFAULT '{}',
+  expires_at     TIMESTAMPTZ,
+  last_used_at   TIMESTAMPTZ,
+  revoked_at     TIMESTAMPTZ,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_api_key_user ON api_key(user_id);
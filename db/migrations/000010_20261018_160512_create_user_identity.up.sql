@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS user_identity (
+  id             BIGSERIAL PRIMARY KEY,
+  user_id        UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+  provider       TEXT NOT NULL,
+  subject        TEXT NOT NULL,
+  email          TEXT,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  last_login_at  TIMESTAMPTZ,
+  UNIQUE (provider, subject)
+);
+CREATE INDEX IF NOT EXISTS idx_user_identity_user ON user_identity(user_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_restaurant_search;
+ALTER TABLE restaurant DROP COLUMN IF EXISTS search_vector;
+DROP TEXT SEARCH CONFIGURATION IF EXISTS vn_unaccent;
+DROP EXTENSION IF EXISTS unaccent;
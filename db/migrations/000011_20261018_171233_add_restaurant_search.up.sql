@@ -0,0 +1,16 @@
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- "simple" with accents folded: "Phở" and "pho" produce the same lexeme. Used both for the
+-- indexed vector and for parsing queries, so ts_headline can mark matches in the original text.
+CREATE TEXT SEARCH CONFIGURATION vn_unaccent (COPY = simple);
+ALTER TEXT SEARCH CONFIGURATION vn_unaccent
+  ALTER MAPPING FOR hword, hword_part, word WITH unaccent, simple;
+
+ALTER TABLE restaurant ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('vn_unaccent', coalesce(name, '')), 'A') ||
+  setweight(to_tsvector('vn_unaccent', coalesce(category, '')), 'B') ||
+  setweight(to_tsvector('vn_unaccent', coalesce(district, '') || ' ' || coalesce(city, '')), 'C') ||
+  setweight(to_tsvector('vn_unaccent', coalesce(address, '') || ' ' || coalesce(description, '')), 'D')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_restaurant_search ON restaurant USING GIN (search_vector);
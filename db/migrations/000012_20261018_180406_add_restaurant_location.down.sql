@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_restaurant_location;
+ALTER TABLE restaurant
+  DROP CONSTRAINT IF EXISTS chk_restaurant_location,
+  DROP COLUMN IF EXISTS latitude,
+  DROP COLUMN IF EXISTS longitude;
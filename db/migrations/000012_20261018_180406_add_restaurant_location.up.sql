@@ -0,0 +1,11 @@
+ALTER TABLE restaurant
+  ADD COLUMN latitude DOUBLE PRECISION,
+  ADD COLUMN longitude DOUBLE PRECISION,
+  ADD CONSTRAINT chk_restaurant_location CHECK (
+    (latitude IS NULL) = (longitude IS NULL)
+    AND (latitude IS NULL OR latitude BETWEEN -90 AND 90)
+    AND (longitude IS NULL OR longitude BETWEEN -180 AND 180)
+  );
+
+-- nearby queries narrow the candidates with a bounding box before computing distances
+CREATE INDEX IF NOT EXISTS idx_restaurant_location ON restaurant(latitude, longitude);
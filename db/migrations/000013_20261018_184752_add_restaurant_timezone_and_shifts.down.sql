@@ -0,0 +1,13 @@
+-- only the earliest shift of each day fits the old key
+DELETE FROM restaurant_hours rsh
+USING restaurant_hours earlier
+WHERE earlier.restaurant_id = rsh.restaurant_id
+  AND earlier.day_of_week = rsh.day_of_week
+  AND earlier.open_time < rsh.open_time;
+ALTER TABLE restaurant_hours
+  DROP CONSTRAINT IF EXISTS restaurant_hours_pkey,
+  ALTER COLUMN open_time DROP NOT NULL,
+  ALTER COLUMN close_time DROP NOT NULL,
+  ADD PRIMARY KEY (restaurant_id, day_of_week);
+
+ALTER TABLE restaurant DROP COLUMN IF EXISTS timezone;
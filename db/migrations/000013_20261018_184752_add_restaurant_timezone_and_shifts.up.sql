@@ -0,0 +1,9 @@
+ALTER TABLE restaurant
+  ADD COLUMN timezone TEXT NOT NULL DEFAULT 'Asia/Ho_Chi_Minh';
+
+-- a day may have several shifts, told apart by their opening time
+DELETE FROM restaurant_hours WHERE open_time IS NULL OR close_time IS NULL;
+ALTER TABLE restaurant_hours
+  DROP CONSTRAINT IF EXISTS restaurant_hours_pkey,
+  ALTER COLUMN close_time SET NOT NULL,
+  ADD PRIMARY KEY (restaurant_id, day_of_week, open_time);
@@ -0,0 +1,2 @@
+DROP FUNCTION IF EXISTS restaurant_is_open(INT, TIMESTAMP);
+DROP TABLE IF EXISTS restaurant_special_hours;
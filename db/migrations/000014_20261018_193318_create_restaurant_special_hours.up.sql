@@ -0,0 +1,58 @@
+-- date specific rules that replace the weekly hours of the days they cover: holidays, events,
+-- temporary closures
+CREATE TABLE IF NOT EXISTS restaurant_special_hours (
+  id             INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  restaurant_id  INT NOT NULL REFERENCES restaurant(id) ON DELETE CASCADE,
+  start_date     DATE NOT NULL,
+  end_date       DATE NOT NULL,
+  is_closed      BOOLEAN NOT NULL DEFAULT FALSE,
+  open_time      TIME,
+  close_time     TIME, -- at or before open_time runs past midnight
+  note           TEXT,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT chk_restaurant_special_hours_dates CHECK (end_date >= start_date),
+  CONSTRAINT chk_restaurant_special_hours_times CHECK (
+    is_closed OR (open_time IS NOT NULL AND close_time IS NOT NULL)
+  )
+);
+CREATE INDEX IF NOT EXISTS idx_restaurant_special_hours_dates ON restaurant_special_hours(restaurant_id, end_date, start_date);
+
+CREATE TRIGGER trg_restaurant_special_hours_updated_at
+BEFORE UPDATE ON restaurant_special_hours
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- restaurant_is_open tells whether a restaurant is open at a wall clock time of its timezone. The
+-- shifts of a day are its special hours when it has some, its weekly hours otherwise; a closed
+-- special rule closes the whole day. Shifts of the day before may run past midnight.
+CREATE OR REPLACE FUNCTION restaurant_is_open(p_restaurant_id INT, p_at TIMESTAMP)
+RETURNS BOOLEAN
+LANGUAGE sql STABLE AS $$
+  WITH days(day, is_today) AS (
+    VALUES (p_at::date, TRUE), (p_at::date - 1, FALSE)
+  ),
+  special AS (
+    SELECT d.day, d.is_today, sh.open_time, sh.close_time, sh.is_closed
+    FROM days d
+    JOIN restaurant_special_hours sh
+      ON sh.restaurant_id = p_restaurant_id AND d.day BETWEEN sh.start_date AND sh.end_date
+  ),
+  shifts AS (
+    SELECT s.day, s.is_today, s.open_time, s.close_time
+    FROM special s
+    WHERE NOT EXISTS (SELECT 1 FROM special c WHERE c.day = s.day AND c.is_closed)
+    UNION ALL
+    SELECT d.day, d.is_today, rh.open_time, rh.close_time
+    FROM days d
+    JOIN restaurant_hours rh
+      ON rh.restaurant_id = p_restaurant_id AND rh.day_of_week = extract(dow FROM d.day)::int
+    WHERE NOT rh.is_closed
+      AND NOT EXISTS (SELECT 1 FROM special s WHERE s.day = d.day)
+  )
+  SELECT EXISTS (
+    SELECT 1 FROM shifts s
+    WHERE (s.is_today AND s.open_time <= p_at::time
+        AND (s.close_time > p_at::time OR s.close_time <= s.open_time))
+      OR (NOT s.is_today AND s.close_time <= s.open_time AND s.close_time > p_at::time)
+  )
+$$;
@@ -0,0 +1,8 @@
+DELETE FROM permission WHERE name = 'restaurant:review';
+
+DROP INDEX IF EXISTS idx_restaurant_status;
+ALTER TABLE restaurant
+  DROP CONSTRAINT IF EXISTS chk_restaurant_status,
+  DROP COLUMN IF EXISTS status,
+  DROP COLUMN IF EXISTS status_reason,
+  DROP COLUMN IF EXISTS status_changed_at;
@@ -0,0 +1,19 @@
+-- existing restaurants were already public, new ones start as drafts
+ALTER TABLE restaurant
+  ADD COLUMN status TEXT NOT NULL DEFAULT 'active',
+  ADD COLUMN status_reason TEXT,
+  ADD COLUMN status_changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  ADD CONSTRAINT chk_restaurant_status CHECK (
+    status IN ('draft', 'pending_review', 'active', 'suspended', 'closed')
+  );
+ALTER TABLE restaurant ALTER COLUMN status SET DEFAULT 'draft';
+CREATE INDEX IF NOT EXISTS idx_restaurant_status ON restaurant(status);
+
+INSERT INTO permission (name, description)
+VALUES ('restaurant:review', 'Approve, reject and suspend restaurants')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permission (role_id, permission_id)
+SELECT r.id, p.id FROM role r JOIN permission p ON p.name = 'restaurant:review'
+WHERE r.role_name = 'admin'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_menu_item_option_group_group;
+DROP INDEX IF EXISTS idx_option_group_restaurant;
@@ -0,0 +1,3 @@
+-- option groups are read per restaurant and per menu item
+CREATE INDEX IF NOT EXISTS idx_option_group_restaurant ON option_group(restaurant_id);
+CREATE INDEX IF NOT EXISTS idx_menu_item_option_group_group ON menu_item_option_group(option_group_id);
@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS trg_menu_item_option_group_touch ON menu_item_option_group;
+DROP TRIGGER IF EXISTS trg_option_item_touch ON option_item;
+DROP TRIGGER IF EXISTS trg_option_group_touch ON option_group;
+DROP TRIGGER IF EXISTS trg_menu_item_touch ON menu_item;
+DROP TRIGGER IF EXISTS trg_topic_touch ON topic;
+DROP TRIGGER IF EXISTS trg_restaurant_special_hours_touch ON restaurant_special_hours;
+DROP TRIGGER IF EXISTS trg_restaurant_hours_touch ON restaurant_hours;
+DROP FUNCTION IF EXISTS touch_restaurant();
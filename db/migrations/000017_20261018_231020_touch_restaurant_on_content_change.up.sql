@@ -0,0 +1,51 @@
+-- restaurant.updated_at tracks every change of what the public pages show: the profile, the
+-- hours and the menu. Deletes count too, so it can serve as Last-Modified.
+CREATE OR REPLACE FUNCTION touch_restaurant()
+RETURNS trigger LANGUAGE plpgsql AS $$
+DECLARE
+  rec RECORD;
+  rid INT;
+BEGIN
+  IF TG_OP = 'DELETE' THEN
+    rec := OLD;
+  ELSE
+    rec := NEW;
+  END IF;
+  IF TG_TABLE_NAME = 'option_item' THEN
+    SELECT restaurant_id INTO rid FROM option_group WHERE id = rec.option_group_id;
+  ELSIF TG_TABLE_NAME = 'menu_item_option_group' THEN
+    SELECT restaurant_id INTO rid FROM menu_item WHERE id = rec.menu_item_id;
+  ELSE
+    rid := rec.restaurant_id;
+  END IF;
+  UPDATE restaurant SET updated_at = NOW() WHERE id = rid;
+  RETURN NULL;
+END; $$;
+
+CREATE TRIGGER trg_restaurant_hours_touch
+AFTER INSERT OR UPDATE OR DELETE ON restaurant_hours
+FOR EACH ROW EXECUTE FUNCTION touch_restaurant();
+
+CREATE TRIGGER trg_restaurant_special_hours_touch
+AFTER INSERT OR UPDATE OR DELETE ON restaurant_special_hours
+FOR EACH ROW EXECUTE FUNCTION touch_restaurant();
+
+CREATE TRIGGER trg_topic_touch
+AFTER INSERT OR UPDATE OR DELETE ON topic
+FOR EACH ROW EXECUTE FUNCTION touch_restaurant();
+
+CREATE TRIGGER trg_menu_item_touch
+AFTER INSERT OR UPDATE OR DELETE ON menu_item
+FOR EACH ROW EXECUTE FUNCTION touch_restaurant();
+
+CREATE TRIGGER trg_option_group_touch
+AFTER INSERT OR UPDATE OR DELETE ON option_group
+FOR EACH ROW EXECUTE FUNCTION touch_restaurant();
+
+CREATE TRIGGER trg_option_item_touch
+AFTER INSERT OR UPDATE OR DELETE ON option_item
+FOR EACH ROW EXECUTE FUNCTION touch_restaurant();
+
+CREATE TRIGGER trg_menu_item_option_group_touch
+AFTER INSERT OR UPDATE OR DELETE ON menu_item_option_group
+FOR EACH ROW EXECUTE FUNCTION touch_restaurant();
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS order_item_option;
+DROP TABLE IF EXISTS order_item;
+DROP TABLE IF EXISTS customer_order;
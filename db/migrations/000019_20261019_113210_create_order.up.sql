@@ -0,0 +1,62 @@
+-- orders of a restaurant. A cart is an order in status 'cart', at most one per customer and
+-- restaurant. Lines copy the names and prices of the menu when they are priced so a placed order
+-- keeps them; unit_price, line_total and subtotal are recomputed from those copies in NUMERIC by
+-- RecalculateOrder.
+CREATE TABLE IF NOT EXISTS customer_order (
+  id             BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  restaurant_id  INT NOT NULL REFERENCES restaurant(id) ON DELETE CASCADE,
+  customer_id    UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+  type           TEXT,
+  table_id       INT REFERENCES restaurant_table(id) ON DELETE SET NULL,
+  status         TEXT NOT NULL DEFAULT 'cart',
+  note           TEXT,
+  cancel_reason  TEXT,
+  subtotal       NUMERIC NOT NULL DEFAULT 0,
+  placed_at      TIMESTAMPTZ,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT chk_customer_order_status CHECK (
+    status IN ('cart', 'placed', 'accepted', 'preparing', 'ready', 'served', 'picked_up', 'completed', 'cancelled')
+  ),
+  CONSTRAINT chk_customer_order_type CHECK (type IN ('dine_in', 'takeaway')),
+  CONSTRAINT chk_customer_order_placed CHECK (status = 'cart' OR (type IS NOT NULL AND placed_at IS NOT NULL))
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_customer_order_cart ON customer_order(customer_id, restaurant_id) WHERE status = 'cart';
+CREATE INDEX IF NOT EXISTS idx_customer_order_restaurant ON customer_order(restaurant_id, id) WHERE status <> 'cart';
+CREATE INDEX IF NOT EXISTS idx_customer_order_customer ON customer_order(customer_id, id);
+CREATE INDEX IF NOT EXISTS idx_customer_order_table ON customer_order(table_id);
+
+CREATE TRIGGER trg_customer_order_updated_at
+BEFORE UPDATE ON customer_order
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+CREATE TABLE IF NOT EXISTS order_item (
+  id            BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  order_id      BIGINT NOT NULL REFERENCES customer_order(id) ON DELETE CASCADE,
+  menu_item_id  BIGINT REFERENCES menu_item(id) ON DELETE SET NULL,
+  name          TEXT NOT NULL,
+  base_price    NUMERIC(12,2) NOT NULL,
+  quantity      INT NOT NULL,
+  note          TEXT,
+  unit_price    NUMERIC NOT NULL DEFAULT 0,
+  line_total    NUMERIC GENERATED ALWAYS AS (unit_price * quantity) STORED,
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT chk_order_item_quantity CHECK (quantity BETWEEN 1 AND 99),
+  CONSTRAINT chk_order_item_base_price CHECK (base_price >= 0)
+);
+CREATE INDEX IF NOT EXISTS idx_order_item_order ON order_item(order_id);
+CREATE INDEX IF NOT EXISTS idx_order_item_menu_item ON order_item(menu_item_id);
+
+CREATE TABLE IF NOT EXISTS order_item_option (
+  id             BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  order_item_id  BIGINT NOT NULL REFERENCES order_item(id) ON DELETE CASCADE,
+  option_id      BIGINT REFERENCES option_item(id) ON DELETE SET NULL,
+  group_name     TEXT NOT NULL,
+  name           TEXT NOT NULL,
+  price_delta    NUMERIC(12,2) NOT NULL,
+  quantity       INT NOT NULL,
+  CONSTRAINT chk_order_item_option_quantity CHECK (quantity >= 1),
+  CONSTRAINT chk_order_item_option_price_delta CHECK (price_delta >= 0)
+);
+CREATE INDEX IF NOT EXISTS idx_order_item_option_item ON order_item_option(order_item_id);
+CREATE INDEX IF NOT EXISTS idx_order_item_option_option ON order_item_option(option_id);
@@ -0,0 +1 @@
+ALTER TABLE "user" DROP COLUMN IF EXISTS totp_last_step;
@@ -0,0 +1,2 @@
+ALTER TABLE "user"
+ADD totp_last_step BIGINT;
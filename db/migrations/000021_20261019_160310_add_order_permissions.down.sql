@@ -0,0 +1 @@
+DELETE FROM permission WHERE name IN ('order:write', 'order:manage');
@@ -0,0 +1,12 @@
+-- ordering used to need only restaurant:read, so a read-only API key could place and cancel orders
+INSERT INTO permission (name, description)
+VALUES
+  ('order:write', 'Fill the cart, place and cancel own orders'),
+  ('order:manage', 'Move the orders of restaurants one is a member of through their statuses')
+ON CONFLICT (name) DO NOTHING;
+
+-- every role could order before; restaurant membership still decides whose orders can be managed
+INSERT INTO role_permission (role_id, permission_id)
+SELECT r.id, p.id FROM role r JOIN permission p ON p.name IN ('order:write', 'order:manage')
+WHERE r.role_name IN ('admin', 'user', 'manager', 'staff')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,94 @@
+-- name: ListMenuCategories :many
+SELECT id, restaurant_id, name, slug, parent_id, sort_order, is_active, created_at, updated_at FROM "topic"
+WHERE restaurant_id = $1
+ORDER BY sort_order, id;
+
+-- name: GetMenuCategory :one
+SELECT id, restaurant_id, name, slug, parent_id, sort_order, is_active, created_at, updated_at FROM "topic"
+WHERE id = $1 AND restaurant_id = $2 LIMIT 1;
+
+-- name: CreateMenuCategory :one
+INSERT INTO "topic" (restaurant_id, name, slug, sort_order, is_active)
+VALUES($1, $2, $3, $4, $5)
+RETURNING id;
+
+-- name: UpdateMenuCategory :execrows
+UPDATE "topic"
+SET name = $3, slug = $4, sort_order = $5, is_active = $6
+WHERE id = $1 AND restaurant_id = $2;
+
+-- name: DeleteMenuCategory :execrows
+DELETE FROM "topic" WHERE id = $1 AND restaurant_id = $2;
+
+-- name: ListMenuItems :many
+SELECT id, restaurant_id, topic_id, type, name, description, image_url, sku, base_price, is_active, sort_order, created_at, updated_at FROM "menu_item"
+WHERE restaurant_id = $1
+ORDER BY sort_order, id;
+
+-- name: GetMenuItem :one
+SELECT id, restaurant_id, topic_id, type, name, description, image_url, sku, base_price, is_active, sort_order, created_at, updated_at FROM "menu_item"
+WHERE id = $1 AND restaurant_id = $2 LIMIT 1;
+
+-- name: CreateMenuItem :one
+INSERT INTO "menu_item" (restaurant_id, topic_id, type, name, description, image_url, base_price, is_active, sort_order)
+VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id;
+
+-- name: UpdateMenuItem :execrows
+UPDATE "menu_item"
+SET topic_id = $3, type = $4, name = $5, description = $6,
+    image_url = $7, base_price = $8, is_active = $9, sort_order = $10
+WHERE id = $1 AND restaurant_id = $2;
+
+-- name: SetMenuItemAvailability :execrows
+UPDATE "menu_item" SET is_active = $3
+WHERE id = $1 AND restaurant_id = $2;
+
+-- name: DeleteMenuItem :execrows
+DELETE FROM "menu_item" WHERE id = $1 AND restaurant_id = $2;
+
+-- name: ListMenuOptionGroups :many
+SELECT id, restaurant_id, name, min_select, max_select, is_required, sort_order, created_at, updated_at FROM "option_group"
+WHERE restaurant_id = $1
+ORDER BY sort_order, id;
+
+-- name: CreateMenuOptionGroup :one
+INSERT INTO "option_group" (restaurant_id, name, min_select, max_select, is_required, sort_order)
+VALUES($1, $2, $3, $4, $5, $6)
+RETURNING id;
+
+-- name: UpdateMenuOptionGroup :execrows
+UPDATE "option_group"
+SET name = $3, min_select = $4, max_select = $5, is_required = $6, sort_order = $7
+WHERE id = $1 AND restaurant_id = $2;
+
+-- name: DeleteMenuOptionGroup :execrows
+DELETE FROM "option_group" WHERE id = $1 AND restaurant_id = $2;
+
+-- name: ListMenuOptions :many
+SELECT oi.id, oi.option_group_id, oi.name, oi.linked_menu_item, oi.price_delta, oi.quantity_min, oi.quantity_max, oi.sort_order, oi.is_active, oi.created_at, oi.updated_at
+FROM "option_item" oi
+INNER JOIN "option_group" og ON og.id = oi.option_group_id
+WHERE og.restaurant_id = $1
+ORDER BY oi.option_group_id, oi.sort_order, oi.id;
+
+-- name: CreateMenuOption :exec
+INSERT INTO "option_item" (option_group_id, name, price_delta, quantity_max, sort_order, is_active)
+VALUES($1, $2, $3, $4, $5, $6);
+
+-- name: DeleteMenuOptions :exec
+DELETE FROM "option_item" WHERE option_group_id = $1;
+
+-- name: ListMenuItemOptionGroups :many
+SELECT miog.menu_item_id, miog.option_group_id, miog.sort_order
+FROM "menu_item_option_group" miog
+INNER JOIN "menu_item" mi ON mi.id = miog.menu_item_id
+WHERE mi.restaurant_id = $1
+ORDER BY miog.menu_item_id, miog.sort_order;
+
+-- name: CreateMenuItemOptionGroup :exec
+INSERT INTO "menu_item_option_group" (menu_item_id, option_group_id, sort_order)
+VALUES($1, $2, $3);
+
+-- name: DeleteMenuItemOptionGroups :exec
+DELETE FROM "menu_item_option_group" WHERE menu_item_id = $1;
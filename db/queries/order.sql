@@ -0,0 +1,100 @@
+-- name: GetCart :one
+SELECT id, restaurant_id, customer_id, type, table_id, status, note, cancel_reason, subtotal, placed_at, created_at, updated_at FROM "customer_order"
+WHERE restaurant_id = $1 AND customer_id = $2 AND status = 'cart';
+
+-- name: UpsertCart :one
+INSERT INTO "customer_order" (restaurant_id, customer_id)
+VALUES($1, $2)
+ON CONFLICT (customer_id, restaurant_id) WHERE status = 'cart'
+DO UPDATE SET updated_at = NOW()
+RETURNING id;
+
+-- name: LockCart :one
+SELECT id FROM "customer_order"
+WHERE restaurant_id = $1 AND customer_id = $2 AND status = 'cart'
+FOR UPDATE;
+
+-- name: DeleteCart :execrows
+DELETE FROM "customer_order"
+WHERE restaurant_id = $1 AND customer_id = $2 AND status = 'cart';
+
+-- name: GetOrder :one
+SELECT id, restaurant_id, customer_id, type, table_id, status, note, cancel_reason, subtotal, placed_at, created_at, updated_at FROM "customer_order"
+WHERE id = $1;
+
+-- name: ListRestaurantOrders :many
+SELECT id, restaurant_id, customer_id, type, table_id, status, note, cancel_reason, subtotal, placed_at, created_at, updated_at FROM "customer_order"
+WHERE restaurant_id = sqlc.arg('restaurant_id') AND status <> 'cart'
+  AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status'))
+  AND (sqlc.narg('before_id')::bigint IS NULL OR id < sqlc.narg('before_id'))
+ORDER BY id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: ListCustomerOrders :many
+SELECT id, restaurant_id, customer_id, type, table_id, status, note, cancel_reason, subtotal, placed_at, created_at, updated_at FROM "customer_order"
+WHERE customer_id = sqlc.arg('customer_id') AND status <> 'cart'
+  AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status'))
+  AND (sqlc.narg('before_id')::bigint IS NULL OR id < sqlc.narg('before_id'))
+ORDER BY id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: PlaceOrder :execrows
+UPDATE "customer_order"
+SET status = 'placed', type = $2, table_id = $3, note = $4, placed_at = NOW()
+WHERE id = $1 AND status = 'cart' AND updated_at = $5;
+
+-- name: UpdateOrderStatus :execrows
+UPDATE "customer_order"
+SET status = sqlc.arg('status'), cancel_reason = sqlc.narg('cancel_reason')
+WHERE id = sqlc.arg('id') AND status = sqlc.arg('from_status');
+
+-- name: RecalculateOrder :exec
+-- prices every line from its copied base price and options, then the order from its lines
+WITH line AS (
+  UPDATE "order_item" i
+  SET unit_price = i.base_price + COALESCE((
+    SELECT SUM(o.price_delta * o.quantity) FROM "order_item_option" o WHERE o.order_item_id = i.id
+  ), 0)
+  WHERE i.order_id = $1
+  RETURNING i.unit_price * i.quantity AS line_total
+)
+UPDATE "customer_order"
+SET subtotal = (SELECT COALESCE(SUM(line_total), 0) FROM line)
+WHERE id = $1;
+
+-- name: CountOrderItems :one
+SELECT COUNT(*) FROM "order_item" WHERE order_id = $1;
+
+-- name: ListOrderItems :many
+SELECT id, order_id, menu_item_id, name, base_price, quantity, note, unit_price, line_total, created_at FROM "order_item"
+WHERE order_id = ANY(sqlc.arg('order_ids')::bigint[])
+ORDER BY order_id, id;
+
+-- name: CreateOrderItem :one
+INSERT INTO "order_item" (order_id, menu_item_id, name, base_price, quantity, note)
+VALUES($1, $2, $3, $4, $5, $6)
+RETURNING id;
+
+-- name: UpdateOrderItem :execrows
+UPDATE "order_item"
+SET menu_item_id = $3, name = $4, base_price = $5, quantity = $6, note = $7
+WHERE id = $1 AND order_id = $2;
+
+-- name: DeleteOrderItem :execrows
+DELETE FROM "order_item" WHERE id = $1 AND order_id = $2;
+
+-- name: DeleteOrderItems :exec
+DELETE FROM "order_item" WHERE order_id = $1;
+
+-- name: ListOrderItemOptions :many
+SELECT o.id, o.order_item_id, o.option_id, o.group_name, o.name, o.price_delta, o.quantity FROM "order_item_option" o
+JOIN "order_item" i ON i.id = o.order_item_id
+WHERE i.order_id = ANY(sqlc.arg('order_ids')::bigint[])
+ORDER BY o.order_item_id, o.id;
+
+-- name: CreateOrderItemOption :exec
+INSERT INTO "order_item_option" (order_item_id, option_id, group_name, name, price_delta, quantity)
+VALUES($1, $2, $3, $4, $5, $6);
+
+-- name: DeleteOrderItemOptions :exec
+DELETE FROM "order_item_option" WHERE order_item_id = $1;
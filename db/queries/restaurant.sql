@@ -1,11 +1,11 @@
 -- name: CreateRestaurant :one
-INSERT INTO "restaurant" (name, description, address, category, city, district, logo_url, banner_url, phone_number, website_url, email, user_id)
-VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+INSERT INTO "restaurant" (name, description, address, category, city, district, logo_url, banner_url, phone_number, website_url, email, user_id, latitude, longitude, timezone)
+VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 RETURNING id;
 
 -- name: CreateRestaurantHours :exec
-INSERT INTO "restaurant_hours" (restaurant_id, day_of_week, open_time, close_time)
-VALUES($1, $2, $3, $4);
+INSERT INTO "restaurant_hours" (restaurant_id, day_of_week, open_time, close_time, is_closed)
+VALUES($1, $2, $3, $4, $5);
 
 -- name: GetByName :many
 SELECT
@@ -22,12 +22,19 @@ SELECT
     rs.website_url,
     rs.email,
     rs.user_id,
+    rs.latitude,
+    rs.longitude,
+    rs.timezone,
+    rs.status,
+    rs.status_reason,
     rsh.day_of_week,
     rsh.open_time,
-    rsh.close_time
+    rsh.close_time,
+    rsh.is_closed
 FROM "restaurant" rs
 INNER JOIN "restaurant_hours" rsh ON rs.id = rsh.restaurant_id
-WHERE name LIKE $1;
+WHERE name LIKE $1
+ORDER BY rsh.day_of_week, rsh.open_time;
 
 -- name: GetById :many
 SELECT
@@ -44,20 +51,38 @@ SELECT
     rs.website_url,
     rs.email,
     rs.user_id,
+    rs.latitude,
+    rs.longitude,
+    rs.timezone,
+    rs.status,
+    rs.status_reason,
+    rs.updated_at,
     rsh.day_of_week,
     rsh.open_time,
-    rsh.close_time
+    rsh.close_time,
+    rsh.is_closed
 FROM "restaurant" rs
 INNER JOIN "restaurant_hours" rsh ON rs.id = rsh.restaurant_id
-WHERE id = $1;
+WHERE id = $1
+ORDER BY rsh.day_of_week, rsh.open_time;
 
 -- name: UpdateRestaurant :exec
 UPDATE "restaurant"
 SET name = $1, description = $2, address = $3,
     category = $4, city = $5, district = $6,
     logo_url = $7, banner_url = $8, phone_number = $9,
-    website_url = $10, email = $11, user_id = $12
-WHERE id = $13;
+    website_url = $10, email = $11, user_id = $12,
+    latitude = $13, longitude = $14, timezone = $15
+WHERE id = $16;
+
+-- name: SetRestaurantStatus :execrows
+UPDATE "restaurant"
+SET status = sqlc.arg('status'), status_reason = sqlc.narg('status_reason'), status_changed_at = NOW()
+WHERE id = sqlc.arg('id') AND status = sqlc.arg('from_status');
+
+-- name: SetRestaurantDayClosed :execrows
+UPDATE "restaurant_hours" SET is_closed = $3
+WHERE restaurant_id = $1 AND day_of_week = $2;
 
 -- name: DeleteRestaurantHours :exec
 DELETE FROM "restaurant_hours" WHERE restaurant_id = $1;
@@ -65,3 +90,104 @@ DELETE FROM "restaurant_hours" WHERE restaurant_id = $1;
 
 -- name: DeleteRestaurant :exec
 DELETE FROM "restaurant" WHERE id = $1;
+
+-- name: UpsertRestaurantMember :exec
+INSERT INTO "restaurant_member" (restaurant_id, user_id, role)
+VALUES($1, $2, $3)
+ON CONFLICT (restaurant_id, user_id) DO UPDATE SET role = EXCLUDED.role;
+
+-- name: GetRestaurantMember :one
+SELECT restaurant_id, user_id, role, created_at FROM "restaurant_member"
+WHERE restaurant_id = $1 AND user_id = $2 LIMIT 1;
+
+-- name: ListRestaurantMembers :many
+SELECT restaurant_id, user_id, role, created_at FROM "restaurant_member"
+WHERE restaurant_id = $1
+ORDER BY created_at;
+
+-- name: DeleteRestaurantMember :exec
+DELETE FROM "restaurant_member" WHERE restaurant_id = $1 AND user_id = $2;
+
+-- name: ListNearbyRestaurants :many
+-- the bounding box lets the location index narrow the candidates, the haversine distance in
+-- meters then keeps the ones actually within the radius
+SELECT rs.id, rs.name, rs.description, rs.address, rs.category, rs.city, rs.district, rs.logo_url, rs.banner_url, rs.latitude, rs.longitude, rs.created_at,
+  d.distance::float8 AS distance
+FROM "restaurant" rs
+CROSS JOIN LATERAL (
+  SELECT 2 * 6371000 * asin(least(1, sqrt(
+    power(sin(radians(rs.latitude - sqlc.arg('latitude')::float8) / 2), 2)
+    + cos(radians(sqlc.arg('latitude'))) * cos(radians(rs.latitude))
+    * power(sin(radians(rs.longitude - sqlc.arg('longitude')::float8) / 2), 2)))) AS distance
+) d
+WHERE rs.latitude BETWEEN sqlc.arg('min_latitude')::float8 AND sqlc.arg('max_latitude')::float8
+  AND rs.longitude BETWEEN sqlc.arg('min_longitude')::float8 AND sqlc.arg('max_longitude')::float8
+  AND d.distance <= sqlc.arg('radius')::float8
+  AND (sqlc.narg('category')::text IS NULL OR lower(rs.category) = lower(sqlc.narg('category')))
+  -- open_at is read as wall clock time in the timezone of each restaurant
+  AND (sqlc.narg('open_at')::timestamptz IS NULL OR restaurant_is_open(rs.id, sqlc.narg('open_at') AT TIME ZONE rs.timezone))
+  AND rs.status = 'active'
+ORDER BY d.distance, rs.id
+LIMIT sqlc.arg('limit');
+
+-- name: ListRestaurants :many
+SELECT rs.id, rs.name, rs.description, rs.address, rs.category, rs.city, rs.district, rs.logo_url, rs.banner_url, rs.latitude, rs.longitude, rs.created_at, rs.status
+FROM "restaurant" rs
+WHERE (sqlc.narg('category')::text IS NULL OR lower(rs.category) = lower(sqlc.narg('category')))
+  AND (sqlc.narg('city')::text IS NULL OR lower(rs.city) = lower(sqlc.narg('city')))
+  AND (sqlc.narg('district')::text IS NULL OR lower(rs.district) = lower(sqlc.narg('district')))
+  AND (sqlc.narg('owner_id')::uuid IS NULL OR EXISTS (
+    SELECT 1 FROM "restaurant_member" rm
+    WHERE rm.restaurant_id = rs.id AND rm.user_id = sqlc.narg('owner_id') AND rm.role = 'owner'))
+  -- open_at is read as wall clock time in the timezone of each restaurant
+  AND (sqlc.narg('open_at')::timestamptz IS NULL OR restaurant_is_open(rs.id, sqlc.narg('open_at') AT TIME ZONE rs.timezone))
+  AND (sqlc.narg('cursor_id')::bigint IS NULL
+    OR (sqlc.arg('sort')::text = 'name' AND (rs.name, rs.id) > (sqlc.narg('cursor_name')::text, sqlc.narg('cursor_id')))
+    OR (sqlc.arg('sort') = '-name' AND (rs.name, rs.id) < (sqlc.narg('cursor_name'), sqlc.narg('cursor_id')))
+    OR (sqlc.arg('sort') = 'created_at' AND (rs.created_at, rs.id) > (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_id')))
+    OR (sqlc.arg('sort') = '-created_at' AND (rs.created_at, rs.id) < (sqlc.narg('cursor_created_at'), sqlc.narg('cursor_id'))))
+  AND rs.status = ANY(sqlc.arg('statuses')::text[])
+ORDER BY
+  CASE WHEN sqlc.arg('sort') = 'name' THEN rs.name END ASC,
+  CASE WHEN sqlc.arg('sort') = '-name' THEN rs.name END DESC,
+  CASE WHEN sqlc.arg('sort') = 'created_at' THEN rs.created_at END ASC,
+  CASE WHEN sqlc.arg('sort') = '-created_at' THEN rs.created_at END DESC,
+  CASE WHEN sqlc.arg('sort') IN ('name', 'created_at') THEN rs.id END ASC,
+  rs.id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: SearchRestaurants :many
+-- chr(2) and chr(3) mark the matches in the highlights, the repository turns them into HTML
+SELECT rs.id, rs.name, rs.description, rs.address, rs.category, rs.city, rs.district, rs.logo_url, rs.banner_url, rs.latitude, rs.longitude, rs.created_at,
+  ts_rank(rs.search_vector, q.query)::real AS rank,
+  ts_headline('vn_unaccent', rs.name, q.query,
+    'HighlightAll=true, StartSel=' || chr(2) || ', StopSel=' || chr(3))::text AS name_highlight,
+  ts_headline('vn_unaccent', concat_ws(' · ', rs.description, rs.address, rs.district, rs.city), q.query,
+    'MaxFragments=2, MaxWords=20, MinWords=8, FragmentDelimiter=" … ", StartSel=' || chr(2) || ', StopSel=' || chr(3))::text AS snippet
+FROM "restaurant" rs, to_tsquery('vn_unaccent', sqlc.arg('query')) q(query)
+WHERE rs.search_vector @@ q.query
+  AND (sqlc.narg('category')::text IS NULL OR lower(rs.category) = lower(sqlc.narg('category')))
+  AND (sqlc.narg('city')::text IS NULL OR lower(rs.city) = lower(sqlc.narg('city')))
+  AND rs.status = 'active'
+ORDER BY rank DESC, rs.id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: ListRestaurantSpecialHours :many
+SELECT id, restaurant_id, start_date, end_date, is_closed, open_time, close_time, note, created_at, updated_at
+FROM "restaurant_special_hours"
+WHERE restaurant_id = sqlc.arg('restaurant_id')
+  AND (sqlc.narg('from_date')::date IS NULL OR end_date >= sqlc.narg('from_date'))
+ORDER BY start_date, open_time NULLS FIRST, id;
+
+-- name: CreateRestaurantSpecialHours :one
+INSERT INTO "restaurant_special_hours" (restaurant_id, start_date, end_date, is_closed, open_time, close_time, note)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id;
+
+-- name: UpdateRestaurantSpecialHours :execrows
+UPDATE "restaurant_special_hours"
+SET start_date = $3, end_date = $4, is_closed = $5, open_time = $6, close_time = $7, note = $8
+WHERE id = $1 AND restaurant_id = $2;
+
+-- name: DeleteRestaurantSpecialHours :execrows
+DELETE FROM "restaurant_special_hours" WHERE id = $1 AND restaurant_id = $2;
@@ -0,0 +1,26 @@
+-- name: ListRestaurantTables :many
+SELECT id, restaurant_id, name, seats, is_active, qr_png_object, qr_svg_object, qr_generated_at, created_at, updated_at FROM "restaurant_table"
+WHERE restaurant_id = $1
+ORDER BY id;
+
+-- name: GetRestaurantTable :one
+SELECT id, restaurant_id, name, seats, is_active, qr_png_object, qr_svg_object, qr_generated_at, created_at, updated_at FROM "restaurant_table"
+WHERE id = $1 AND restaurant_id = $2;
+
+-- name: CreateRestaurantTable :one
+INSERT INTO "restaurant_table" (restaurant_id, name, seats, is_active)
+VALUES($1, $2, $3, $4)
+RETURNING id;
+
+-- name: UpdateRestaurantTable :execrows
+UPDATE "restaurant_table"
+SET name = $3, seats = $4, is_active = $5
+WHERE id = $1 AND restaurant_id = $2;
+
+-- name: SetRestaurantTableQR :execrows
+UPDATE "restaurant_table"
+SET qr_png_object = $3, qr_svg_object = $4, qr_generated_at = NOW()
+WHERE id = $1 AND restaurant_id = $2;
+
+-- name: DeleteRestaurantTable :execrows
+DELETE FROM "restaurant_table" WHERE id = $1 AND restaurant_id = $2;
@@ -1,15 +1,15 @@
 -- name: GetUserByID :one
-SELECT u.id, u.email, u.full_name, r.role_name, u.is_active, u.created_at, u.updated_at FROM "user" u
+SELECT u.id, u.email, u.full_name, r.role_name, u.is_active, u.email_verified_at, u.created_at, u.updated_at FROM "user" u
 LEFT JOIN "role" r ON r.id = u.role_id
 WHERE u.id = $1 LIMIT 1;
 
 -- name: GetUserByEmail :one
-SELECT u.id, u.email, u.full_name, r.role_name, u.password_hash, u.is_active, u.created_at, u.updated_at FROM "user" u
+SELECT u.id, u.email, u.full_name, r.role_name, u.password_hash, u.is_active, u.email_verified_at, u.created_at, u.updated_at FROM "user" u
 LEFT JOIN  "role" r ON r.id = u.role_id
 WHERE email = $1 LIMIT 1;
 
 -- name: GetUserByName :one
-SELECT u.id, u.email, u.full_name, r.role_name, u.is_active, u.created_at, u.updated_at FROM "user" u
+SELECT u.id, u.email, u.full_name, r.role_name, u.is_active, u.email_verified_at, u.created_at, u.updated_at FROM "user" u
 LEFT JOIN  "role" r ON r.id = u.role_id
 WHERE full_name = $1 LIMIT 1;
 
@@ -17,8 +17,140 @@ WHERE full_name = $1 LIMIT 1;
 INSERT INTO "user" (email, full_name, password_hash, role_id) VALUES ($1, $2, $3,(SELECT id FROM role WHERE role_name = 'user'))
 RETURNING id;
 
+-- name: GetUserEmailForUpdate :one
+SELECT email FROM "user" WHERE id = $1 FOR UPDATE;
+
+-- name: GetUserPasswordHash :one
+SELECT password_hash FROM "user" WHERE id = $1;
+
 -- name: UpdateUser :one
 UPDATE "user"
-SET full_name = $1, email = $2, password_hash = $3, is_active = $4, updated_at = NOW()
-WHERE id = $5
+SET full_name = $1, email = $2,
+    email_verified_at = CASE WHEN email = $2 THEN email_verified_at END,
+    updated_at = NOW()
+WHERE id = $3
+RETURNING *;
+
+-- name: GetPermissionsByRole :many
+SELECT p.name FROM "permission" p
+INNER JOIN "role_permission" rp ON rp.permission_id = p.id
+INNER JOIN "role" r ON r.id = rp.role_id
+WHERE r.role_name = $1
+ORDER BY p.name;
+
+-- name: UpdateUserPassword :exec
+UPDATE "user" SET password_hash = $1 WHERE id = $2;
+
+-- name: CreatePasswordResetToken :exec
+INSERT INTO "password_reset_token" (user_id, token_hash, expires_at) VALUES ($1, $2, $3);
+
+-- name: GetPasswordResetTokenByHash :one
+SELECT id, user_id, token_hash, expires_at, used_at FROM "password_reset_token"
+WHERE token_hash = $1 LIMIT 1;
+
+-- name: UsePasswordResetToken :execrows
+UPDATE "password_reset_token" SET used_at = NOW()
+WHERE id = $1 AND used_at IS NULL AND expires_at > NOW();
+
+-- name: UseUserPasswordResetTokens :exec
+UPDATE "password_reset_token" SET used_at = NOW()
+WHERE user_id = $1 AND used_at IS NULL;
+
+-- name: CreateEmailVerificationToken :exec
+INSERT INTO "email_verification_token" (user_id, token_hash, expires_at) VALUES ($1, $2, $3);
+
+-- name: GetEmailVerificationTokenByHash :one
+SELECT id, user_id, token_hash, expires_at, used_at FROM "email_verification_token"
+WHERE token_hash = $1 LIMIT 1;
+
+-- name: UseEmailVerificationToken :execrows
+UPDATE "email_verification_token" SET used_at = NOW()
+WHERE id = $1 AND used_at IS NULL AND expires_at > NOW();
+
+-- name: UseUserEmailVerificationTokens :exec
+UPDATE "email_verification_token" SET used_at = NOW()
+WHERE user_id = $1 AND used_at IS NULL;
+
+-- name: SetUserEmailVerified :exec
+UPDATE "user" SET email_verified_at = NOW() WHERE id = $1 AND email_verified_at IS NULL;
+
+-- name: GetUserTOTP :one
+SELECT totp_secret, totp_enabled_at FROM "user" WHERE id = $1 LIMIT 1;
+
+-- name: SetUserTOTPSecret :exec
+UPDATE "user" SET totp_secret = $1, totp_enabled_at = NULL WHERE id = $2;
+
+-- name: EnableUserTOTP :exec
+UPDATE "user" SET totp_enabled_at = NOW() WHERE id = $1;
+
+-- name: UseUserTOTPStep :execrows
+UPDATE "user" SET totp_last_step = $2
+WHERE id = $1 AND (totp_last_step IS NULL OR totp_last_step < $2);
+
+-- name: DisableUserTOTP :exec
+UPDATE "user" SET totp_secret = NULL, totp_enabled_at = NULL WHERE id = $1;
+
+-- name: CreateRecoveryCode :exec
+INSERT INTO "user_recovery_code" (user_id, code_hash) VALUES ($1, $2);
+
+-- name: DeleteUserRecoveryCodes :exec
+DELETE FROM "user_recovery_code" WHERE user_id = $1;
+
+-- name: UseRecoveryCode :execrows
+UPDATE "user_recovery_code" SET used_at = NOW()
+WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL;
+
+-- name: CreateAPIKey :one
+INSERT INTO "api_key" (user_id, name, prefix, secret_hash, scopes, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING *;
+
+-- name: ListAPIKeysByUser :many
+SELECT * FROM "api_key" WHERE user_id = $1 ORDER BY created_at DESC;
+
+-- name: GetAPIKeyByPrefix :one
+SELECT * FROM "api_key" WHERE prefix = $1 LIMIT 1;
+
+-- name: RevokeAPIKey :execrows
+UPDATE "api_key" SET revoked_at = NOW()
+WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL;
+
+-- name: TouchAPIKey :exec
+UPDATE "api_key" SET last_used_at = NOW() WHERE id = $1;
+
+-- name: GetUserIdentity :one
+SELECT * FROM "user_identity" WHERE provider = $1 AND subject = $2 LIMIT 1;
+
+-- name: CreateUserIdentity :exec
+INSERT INTO "user_identity" (user_id, provider, subject, email, last_login_at) VALUES ($1, $2, $3, $4, NOW());
+
+-- name: TouchUserIdentity :exec
+UPDATE "user_identity" SET last_login_at = NOW(), email = $2 WHERE id = $1;
+
+-- name: ListUsers :many
+SELECT u.id, u.email, u.full_name, r.role_name, u.is_active, u.email_verified_at, u.created_at, u.updated_at FROM "user" u
+LEFT JOIN "role" r ON r.id = u.role_id
+WHERE (sqlc.narg('search')::text IS NULL OR u.email ILIKE '%' || sqlc.narg('search') || '%' ESCAPE '\' OR u.full_name ILIKE '%' || sqlc.narg('search') || '%' ESCAPE '\')
+  AND (sqlc.narg('role')::text IS NULL OR r.role_name = sqlc.narg('role'))
+  AND (sqlc.narg('is_active')::boolean IS NULL OR u.is_active = sqlc.narg('is_active'))
+ORDER BY u.created_at DESC, u.id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountUsers :one
+SELECT COUNT(*) FROM "user" u
+LEFT JOIN "role" r ON r.id = u.role_id
+WHERE (sqlc.narg('search')::text IS NULL OR u.email ILIKE '%' || sqlc.narg('search') || '%' ESCAPE '\' OR u.full_name ILIKE '%' || sqlc.narg('search') || '%' ESCAPE '\')
+  AND (sqlc.narg('role')::text IS NULL OR r.role_name = sqlc.narg('role'))
+  AND (sqlc.narg('is_active')::boolean IS NULL OR u.is_active = sqlc.narg('is_active'));
+
+-- name: SetUserActive :execrows
+UPDATE "user" SET is_active = $1 WHERE id = $2;
+
+-- name: ListRoles :many
+SELECT role_name FROM "role" ORDER BY id;
+
+-- name: GetRoleIDByName :one
+SELECT id FROM "role" WHERE role_name = $1 LIMIT 1;
+
+-- name: SetUserRole :execrows
+UPDATE "user" SET role_id = $1 WHERE id = $2;
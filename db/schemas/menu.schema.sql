@@ -0,0 +1,106 @@
+-- =========================
+-- MENU CATEGORIES
+-- =========================
+CREATE TABLE IF NOT EXISTS topic (
+  id            BIGSERIAL PRIMARY KEY,
+  restaurant_id INT NOT NULL REFERENCES restaurant(id) ON DELETE CASCADE,
+  name          TEXT NOT NULL,
+  slug          TEXT,
+  parent_id     BIGINT REFERENCES topic(id) ON DELETE CASCADE,
+  sort_order    INT NOT NULL DEFAULT 0,
+  is_active     BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE (restaurant_id, slug)
+);
+CREATE INDEX IF NOT EXISTS idx_topic_parent ON topic(parent_id);
+
+-- =========================
+-- MENU ITEMS
+-- =========================
+CREATE TYPE menu_item_type AS ENUM ('dish', 'extra', 'beverage', 'combo');
+
+CREATE TABLE IF NOT EXISTS menu_item (
+  id             BIGSERIAL PRIMARY KEY,
+  restaurant_id  INT NOT NULL REFERENCES restaurant(id) ON DELETE CASCADE,
+  topic_id       BIGINT REFERENCES topic(id) ON DELETE SET NULL,
+  type           menu_item_type NOT NULL DEFAULT 'dish',
+  name           TEXT NOT NULL,
+  description    TEXT,
+  image_url      TEXT,
+  sku            TEXT,
+  base_price     NUMERIC(12,2) NOT NULL DEFAULT 0,
+  is_active      BOOLEAN NOT NULL DEFAULT TRUE,
+  sort_order     INT NOT NULL DEFAULT 0,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CHECK (base_price >= 0)
+);
+CREATE INDEX IF NOT EXISTS idx_menu_item_restaurant ON menu_item(restaurant_id);
+CREATE INDEX IF NOT EXISTS idx_menu_item_topic ON menu_item(topic_id);
+CREATE INDEX IF NOT EXISTS idx_menu_item_type ON menu_item(type);
+
+-- =========================
+-- MODIFIERS
+-- =========================
+CREATE TABLE IF NOT EXISTS option_group (
+  id             BIGSERIAL PRIMARY KEY,
+  restaurant_id  INT NOT NULL REFERENCES restaurant(id) ON DELETE CASCADE,
+  name           TEXT NOT NULL,
+  min_select     INT NOT NULL DEFAULT 0,
+  max_select     INT,
+  is_required    BOOLEAN NOT NULL DEFAULT FALSE,
+  sort_order     INT NOT NULL DEFAULT 0,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CHECK (min_select >= 0),
+  CHECK (max_select IS NULL OR max_select >= 0)
+);
+CREATE INDEX IF NOT EXISTS idx_option_group_restaurant ON option_group(restaurant_id);
+
+CREATE TABLE IF NOT EXISTS menu_item_option_group (
+  menu_item_id  BIGINT NOT NULL REFERENCES menu_item(id) ON DELETE CASCADE,
+  option_group_id BIGINT NOT NULL REFERENCES option_group(id) ON DELETE CASCADE,
+  sort_order    INT NOT NULL DEFAULT 0,
+  PRIMARY KEY (menu_item_id, option_group_id)
+);
+CREATE INDEX IF NOT EXISTS idx_menu_item_option_group_group ON menu_item_option_group(option_group_id);
+
+CREATE TABLE IF NOT EXISTS option_item (
+  id               BIGSERIAL PRIMARY KEY,
+  option_group_id  BIGINT NOT NULL REFERENCES option_group(id) ON DELETE CASCADE,
+  name             TEXT,
+  linked_menu_item BIGINT REFERENCES menu_item(id) ON DELETE SET NULL,
+  price_delta      NUMERIC(12,2) NOT NULL DEFAULT 0,
+  quantity_min     INT NOT NULL DEFAULT 0,
+  quantity_max     INT,
+  sort_order       INT NOT NULL DEFAULT 0,
+  is_active        BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CHECK (price_delta >= 0),
+  CHECK (quantity_min >= 0),
+  CHECK (quantity_max IS NULL OR quantity_max >= 0)
+);
+CREATE INDEX IF NOT EXISTS idx_option_group ON option_item(option_group_id);
+
+-- any menu change bumps restaurant.updated_at, see touch_restaurant
+CREATE TRIGGER trg_topic_touch
+AFTER INSERT OR UPDATE OR DELETE ON topic
+FOR EACH ROW EXECUTE FUNCTION touch_restaurant();
+
+CREATE TRIGGER trg_menu_item_touch
+AFTER INSERT OR UPDATE OR DELETE ON menu_item
+FOR EACH ROW EXECUTE FUNCTION touch_restaurant();
+
+CREATE TRIGGER trg_option_group_touch
+AFTER INSERT OR UPDATE OR DELETE ON option_group
+FOR EACH ROW EXECUTE FUNCTION touch_restaurant();
+
+CREATE TRIGGER trg_option_item_touch
+AFTER INSERT OR UPDATE OR DELETE ON option_item
+FOR EACH ROW EXECUTE FUNCTION touch_restaurant();
+
+CREATE TRIGGER trg_menu_item_option_group_touch
+AFTER INSERT OR UPDATE OR DELETE ON menu_item_option_group
+FOR EACH ROW EXECUTE FUNCTION touch_restaurant();
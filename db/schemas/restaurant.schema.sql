@@ -1,3 +1,10 @@
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- "simple" with accents folded, see migration 000011
+CREATE TEXT SEARCH CONFIGURATION vn_unaccent (COPY = simple);
+ALTER TEXT SEARCH CONFIGURATION vn_unaccent
+  ALTER MAPPING FOR hword, hword_part, word WITH unaccent, simple;
+
 -- =========================
 -- RESTAURANTS
 -- =========================
@@ -16,8 +23,31 @@ CREATE TABLE IF NOT EXISTS restaurant (
   email          CITEXT,
   created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
   updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-  user_id        UUID
+  user_id        UUID,
+  search_vector  tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('vn_unaccent', coalesce(name, '')), 'A') ||
+    setweight(to_tsvector('vn_unaccent', coalesce(category, '')), 'B') ||
+    setweight(to_tsvector('vn_unaccent', coalesce(district, '') || ' ' || coalesce(city, '')), 'C') ||
+    setweight(to_tsvector('vn_unaccent', coalesce(address, '') || ' ' || coalesce(description, '')), 'D')
+  ) STORED,
+  latitude       DOUBLE PRECISION,
+  longitude      DOUBLE PRECISION,
+  timezone       TEXT NOT NULL DEFAULT 'Asia/Ho_Chi_Minh',
+  status         TEXT NOT NULL DEFAULT 'draft',
+  status_reason  TEXT,
+  status_changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT chk_restaurant_status CHECK (
+    status IN ('draft', 'pending_review', 'active', 'suspended', 'closed')
+  ),
+  CONSTRAINT chk_restaurant_location CHECK (
+    (latitude IS NULL) = (longitude IS NULL)
+    AND (latitude IS NULL OR latitude BETWEEN -90 AND 90)
+    AND (longitude IS NULL OR longitude BETWEEN -180 AND 180)
+  )
 );
+CREATE INDEX IF NOT EXISTS idx_restaurant_search ON restaurant USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_restaurant_location ON restaurant(latitude, longitude);
+CREATE INDEX IF NOT EXISTS idx_restaurant_status ON restaurant(status);
 
 CREATE TRIGGER trg_restaurant_updated_at
 BEFORE UPDATE ON restaurant
@@ -27,8 +57,113 @@ FOR EACH ROW EXECUTE FUNCTION set_updated_at();
 CREATE TABLE restaurant_hours (
   restaurant_id INT REFERENCES restaurant(id) ON DELETE CASCADE,
   day_of_week   INT NOT NULL CHECK (day_of_week BETWEEN 0 AND 6), -- 0=Sun
-  open_time     TIME,
-  close_time    TIME,
+  open_time     TIME NOT NULL,
+  close_time    TIME NOT NULL, -- at or before open_time runs past midnight
   is_closed     BOOLEAN NOT NULL DEFAULT FALSE,
-  PRIMARY KEY (restaurant_id, day_of_week)
+  PRIMARY KEY (restaurant_id, day_of_week, open_time)
+);
+
+-- date specific rules that replace the weekly hours of the days they cover: holidays, events,
+-- temporary closures
+CREATE TABLE IF NOT EXISTS restaurant_special_hours (
+  id             INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  restaurant_id  INT NOT NULL REFERENCES restaurant(id) ON DELETE CASCADE,
+  start_date     DATE NOT NULL,
+  end_date       DATE NOT NULL,
+  is_closed      BOOLEAN NOT NULL DEFAULT FALSE,
+  open_time      TIME,
+  close_time     TIME, -- at or before open_time runs past midnight
+  note           TEXT,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT chk_restaurant_special_hours_dates CHECK (end_date >= start_date),
+  CONSTRAINT chk_restaurant_special_hours_times CHECK (
+    is_closed OR (open_time IS NOT NULL AND close_time IS NOT NULL)
+  )
 );
+CREATE INDEX IF NOT EXISTS idx_restaurant_special_hours_dates ON restaurant_special_hours(restaurant_id, end_date, start_date);
+
+CREATE TRIGGER trg_restaurant_special_hours_updated_at
+BEFORE UPDATE ON restaurant_special_hours
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- touch_restaurant bumps restaurant.updated_at on any change of the hours or the menu, see
+-- migration 000017
+CREATE OR REPLACE FUNCTION touch_restaurant()
+RETURNS trigger LANGUAGE plpgsql AS $$
+DECLARE
+  rec RECORD;
+  rid INT;
+BEGIN
+  IF TG_OP = 'DELETE' THEN
+    rec := OLD;
+  ELSE
+    rec := NEW;
+  END IF;
+  IF TG_TABLE_NAME = 'option_item' THEN
+    SELECT restaurant_id INTO rid FROM option_group WHERE id = rec.option_group_id;
+  ELSIF TG_TABLE_NAME = 'menu_item_option_group' THEN
+    SELECT restaurant_id INTO rid FROM menu_item WHERE id = rec.menu_item_id;
+  ELSE
+    rid := rec.restaurant_id;
+  END IF;
+  UPDATE restaurant SET updated_at = NOW() WHERE id = rid;
+  RETURN NULL;
+END; $$;
+
+CREATE TRIGGER trg_restaurant_hours_touch
+AFTER INSERT OR UPDATE OR DELETE ON restaurant_hours
+FOR EACH ROW EXECUTE FUNCTION touch_restaurant();
+
+CREATE TRIGGER trg_restaurant_special_hours_touch
+AFTER INSERT OR UPDATE OR DELETE ON restaurant_special_hours
+FOR EACH ROW EXECUTE FUNCTION touch_restaurant();
+
+-- restaurant_is_open tells whether a restaurant is open at a wall clock time of its timezone. The
+-- shifts of a day are its special hours when it has some, its weekly hours otherwise; a closed
+-- special rule closes the whole day. Shifts of the day before may run past midnight.
+CREATE OR REPLACE FUNCTION restaurant_is_open(p_restaurant_id INT, p_at TIMESTAMP)
+RETURNS BOOLEAN
+LANGUAGE sql STABLE AS $$
+  WITH days(day, is_today) AS (
+    VALUES (p_at::date, TRUE), (p_at::date - 1, FALSE)
+  ),
+  special AS (
+    SELECT d.day, d.is_today, sh.open_time, sh.close_time, sh.is_closed
+    FROM days d
+    JOIN restaurant_special_hours sh
+      ON sh.restaurant_id = p_restaurant_id AND d.day BETWEEN sh.start_date AND sh.end_date
+  ),
+  shifts AS (
+    SELECT s.day, s.is_today, s.open_time, s.close_time
+    FROM special s
+    WHERE NOT EXISTS (SELECT 1 FROM special c WHERE c.day = s.day AND c.is_closed)
+    UNION ALL
+    SELECT d.day, d.is_today, rh.open_time, rh.close_time
+    FROM days d
+    JOIN restaurant_hours rh
+      ON rh.restaurant_id = p_restaurant_id AND rh.day_of_week = extract(dow FROM d.day)::int
+    WHERE NOT rh.is_closed
+      AND NOT EXISTS (SELECT 1 FROM special s WHERE s.day = d.day)
+  )
+  SELECT EXISTS (
+    SELECT 1 FROM shifts s
+    WHERE (s.is_today AND s.open_time <= p_at::time
+        AND (s.close_time > p_at::time OR s.close_time <= s.open_time))
+      OR (NOT s.is_today AND s.close_time <= s.open_time AND s.close_time > p_at::time)
+  )
+$$;
+
+CREATE TABLE IF NOT EXISTS restaurant_member (
+  restaurant_id  INT NOT NULL REFERENCES restaurant(id) ON DELETE CASCADE,
+  user_id        UUID NOT NULL,
+  role           TEXT NOT NULL CHECK (role IN ('owner', 'manager', 'staff')),
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (restaurant_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_restaurant_member_user ON restaurant_member(user_id);
+
+CREATE TRIGGER trg_restaurant_member_updated_at
+BEFORE UPDATE ON restaurant_member
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
@@ -0,0 +1,26 @@
+-- =========================
+-- TABLES
+-- =========================
+CREATE TABLE IF NOT EXISTS restaurant_table (
+  id              INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  restaurant_id   INT NOT NULL REFERENCES restaurant(id) ON DELETE CASCADE,
+  name            TEXT NOT NULL,
+  seats           INT NOT NULL DEFAULT 0,
+  is_active       BOOLEAN NOT NULL DEFAULT TRUE,
+  qr_png_object   TEXT,
+  qr_svg_object   TEXT,
+  qr_generated_at TIMESTAMPTZ,
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT uq_restaurant_table_name UNIQUE (restaurant_id, name),
+  CONSTRAINT chk_restaurant_table_seats CHECK (seats >= 0)
+);
+
+CREATE TRIGGER trg_restaurant_table_updated_at
+BEFORE UPDATE ON restaurant_table
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- the public menu shows the table a QR code selects, see touch_restaurant
+CREATE TRIGGER trg_restaurant_table_touch
+AFTER INSERT OR UPDATE OR DELETE ON restaurant_table
+FOR EACH ROW EXECUTE FUNCTION touch_restaurant();
@@ -33,9 +33,104 @@ CREATE TABLE IF NOT EXISTS "user" (
   role_id        INT REFERENCES role(id) ON UPDATE CASCADE ON DELETE SET NULL,
   is_active      BOOLEAN NOT NULL DEFAULT TRUE,
   created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-  updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+  updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  email_verified_at TIMESTAMPTZ,
+  totp_secret    TEXT,
+  totp_enabled_at TIMESTAMPTZ,
+  totp_last_step BIGINT
 );
 
 CREATE TRIGGER trg_user_updated_at
 BEFORE UPDATE ON "user"
 FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- =========================
+-- PERMISSIONS
+-- =========================
+CREATE TABLE IF NOT EXISTS permission (
+  id           INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  name         TEXT NOT NULL UNIQUE,
+  description  TEXT,
+  created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER trg_permission_updated_at
+BEFORE UPDATE ON permission
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+CREATE TABLE IF NOT EXISTS role_permission (
+  role_id        INT NOT NULL REFERENCES role(id) ON DELETE CASCADE,
+  permission_id  INT NOT NULL REFERENCES permission(id) ON DELETE CASCADE,
+  PRIMARY KEY (role_id, permission_id)
+);
+
+-- =========================
+-- PASSWORD RESET
+-- =========================
+CREATE TABLE IF NOT EXISTS password_reset_token (
+  id           BIGSERIAL PRIMARY KEY,
+  user_id      UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+  token_hash   TEXT NOT NULL UNIQUE,
+  expires_at   TIMESTAMPTZ NOT NULL,
+  used_at      TIMESTAMPTZ,
+  created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_password_reset_token_user ON password_reset_token(user_id);
+
+-- =========================
+-- EMAIL VERIFICATION
+-- =========================
+CREATE TABLE IF NOT EXISTS email_verification_token (
+  id           BIGSERIAL PRIMARY KEY,
+  user_id      UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+  token_hash   TEXT NOT NULL UNIQUE,
+  expires_at   TIMESTAMPTZ NOT NULL,
+  used_at      TIMESTAMPTZ,
+  created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_email_verification_token_user ON email_verification_token(user_id);
+
+-- =========================
+-- TWO-FACTOR RECOVERY CODES
+-- =========================
+CREATE TABLE IF NOT EXISTS user_recovery_code (
+  id           BIGSERIAL PRIMARY KEY,
+  user_id      UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+  code_hash    TEXT NOT NULL,
+  used_at      TIMESTAMPTZ,
+  created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_user_recovery_code_user ON user_recovery_code(user_id);
+
+-- =========================
+-- API KEYS
+-- =========================
+CREATE TABLE IF NOT EXISTS api_key (
+  id             UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id        UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+  name           TEXT NOT NULL,
+  prefix         TEXT NOT NULL UNIQUE,
+  secret_hash    TEXT NOT NULL,
+  scopes         TEXT[] NOT NULL DEFAULT '{}',
+  expires_at     TIMESTAMPTZ,
+  last_used_at   TIMESTAMPTZ,
+  revoked_at     TIMESTAMPTZ,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_api_key_user ON api_key(user_id);
+
+-- =========================
+-- EXTERNAL IDENTITIES (OIDC)
+-- =========================
+CREATE TABLE IF NOT EXISTS user_identity (
+  id             BIGSERIAL PRIMARY KEY,
+  user_id        UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+  provider       TEXT NOT NULL,
+  subject        TEXT NOT NULL,
+  email          TEXT,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  last_login_at  TIMESTAMPTZ,
+  UNIQUE (provider, subject)
+);
+CREATE INDEX IF NOT EXISTS idx_user_identity_user ON user_identity(user_id);
@@ -15,9 +15,29 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/auth/login": {
-            "post": {
-                "description": "Authenticate user and return access and refresh tokens",
+        "/.well-known/jwks.json": {
+            "get": {
+                "description": "Public keys that verify the access tokens issued by go-ai, in RFC 7517 form",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "JSON Web Key Set",
+                "responses": {
+                    "200": {
+                        "description": "Key set",
+                        "schema": {
+                            "$ref": "#/definitions/utils.JWKS"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/restaurants": {
+            "get": {
+                "description": "List restaurants in every status page by page, with the same filters as the public list. Pass status=pending_review for the review queue",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,25 +45,70 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Admin"
                 ],
-                "summary": "User login",
+                "summary": "List restaurants for review",
                 "parameters": [
                     {
-                        "description": "User login payload",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/authapp.LoginRequest"
-                        }
+                        "type": "string",
+                        "description": "draft, pending_review, active, suspended or closed; every status when omitted",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "City",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "District",
+                        "name": "district",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID of the owner",
+                        "name": "owner_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only restaurants open at the moment",
+                        "name": "open_now",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "name, -name, created_at or -created_at (default)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Restaurants per page, at most 100",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Login successful",
+                        "description": "List restaurants successfully",
                         "schema": {
-                            "$ref": "#/definitions/app.LoginSuccessResponseDoc"
+                            "$ref": "#/definitions/app.ListRestaurantsSuccessResponseDoc"
                         }
                     },
                     "default": {
@@ -55,9 +120,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/auth/profile": {
+        "/api/admin/restaurants/{id}": {
             "get": {
-                "description": "Retrieve the profile information of the authenticated user",
+                "description": "Get detailed information of a restaurant in any status",
                 "consumes": [
                     "application/json"
                 ],
@@ -65,14 +130,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Admin"
+                ],
+                "summary": "Get restaurant by ID for review",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get user profile",
                 "responses": {
                     "200": {
-                        "description": "Profile retrieved successfully",
+                        "description": "Get restaurant successfully",
                         "schema": {
-                            "$ref": "#/definitions/app.GetProfileSuccessResponseDoc"
+                            "$ref": "#/definitions/app.GetRestaurantByIDSuccessResponseDoc"
                         }
                     },
                     "default": {
@@ -84,9 +158,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/auth/refresh-token": {
-            "post": {
-                "description": "Generate a new access token using a valid refresh token",
+        "/api/admin/restaurants/{id}/status": {
+            "put": {
+                "description": "Approve (active) or reject (draft) a restaurant pending review, suspend or reinstate an active one, or close it. Rejecting and suspending need a reason, shown to the restaurant members",
                 "consumes": [
                     "application/json"
                 ],
@@ -94,25 +168,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Admin"
                 ],
-                "summary": "Refresh access token",
+                "summary": "Review restaurant",
                 "parameters": [
                     {
-                        "description": "Refresh token payload",
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decision",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/authapp.RefreshTokenRequest"
+                            "$ref": "#/definitions/restaurantapp.ReviewRestaurantRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Token refreshed successfully",
+                        "description": "Review restaurant successfully",
                         "schema": {
-                            "$ref": "#/definitions/app.RefreshTokenSuccessResponseDoc"
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
                         }
                     },
                     "default": {
@@ -124,9 +205,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/auth/register": {
-            "post": {
-                "description": "Create a new user account with email and full name",
+        "/api/admin/roles": {
+            "get": {
+                "description": "List the roles that can be assigned to users",
                 "consumes": [
                     "application/json"
                 ],
@@ -134,25 +215,75 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Admin"
                 ],
-                "summary": "Register a new userRegisterRequest",
-                "parameters": [
-                    {
-                        "description": "User registration payload",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
+                "summary": "List roles",
+                "responses": {
+                    "200": {
+                        "description": "Roles retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/authapp.RegisterRequest"
+                            "$ref": "#/definitions/app.ListRolesSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
                         }
                     }
+                }
+            }
+        },
+        "/api/admin/users": {
+            "get": {
+                "description": "List users page by page, newest first, optionally filtered by search text, role and status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "List users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Matches email or full name",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Role name",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Account status",
+                        "name": "is_active",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Users per page, at most 100",
+                        "name": "page_size",
+                        "in": "query"
+                    }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User created successfully",
+                        "description": "Users retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/app.RegisterSuccessResponseDoc"
+                            "$ref": "#/definitions/app.ListUsersSuccessResponseDoc"
                         }
                     },
                     "default": {
@@ -164,9 +295,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/restaurant": {
-            "post": {
-                "description": "Create a new restaurant with name, email, phone, logo_url, banner_url,...",
+        "/api/admin/users/{id}/role": {
+            "put": {
+                "description": "Assign one of the existing roles to a user",
                 "consumes": [
                     "application/json"
                 ],
@@ -174,25 +305,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Restaurant"
+                    "Admin"
                 ],
-                "summary": "Create restaurant",
+                "summary": "Assign role to user",
                 "parameters": [
                     {
-                        "description": "Restaurant create payload",
-                        "name": "request",
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Role payload",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/restaurantapp.CreateRestaurantRequest"
+                            "$ref": "#/definitions/authapp.SetUserRoleRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Create restaurant successfully",
+                        "description": "User role updated successfully",
                         "schema": {
-                            "$ref": "#/definitions/app.CreateRestaurantSuccessResponseDoc"
+                            "$ref": "#/definitions/app.AdminUserSuccessResponseDoc"
                         }
                     },
                     "default": {
@@ -204,9 +342,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/restaurant/{id}": {
-            "get": {
-                "description": "Get detailed information of a restaurant using its ID",
+        "/api/admin/users/{id}/status": {
+            "put": {
+                "description": "Deactivating a user ends all of their sessions immediately",
                 "consumes": [
                     "application/json"
                 ],
@@ -214,23 +352,61 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Restaurant"
+                    "Admin"
                 ],
-                "summary": "Get restaurant by ID",
+                "summary": "Activate or deactivate user",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Restaurant ID",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Status payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authapp.SetUserStatusRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Get restaurant successfully",
+                        "description": "User status updated successfully",
                         "schema": {
-                            "$ref": "#/definitions/app.GetRestaurantByIDSuccessResponseDoc"
+                            "$ref": "#/definitions/app.AdminUserSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/api-keys": {
+            "get": {
+                "description": "List the API keys of the authenticated user, including revoked ones",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "List API keys",
+                "responses": {
+                    "200": {
+                        "description": "API keys retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.ListAPIKeysSuccessResponseDoc"
                         }
                     },
                     "default": {
@@ -241,8 +417,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Update restaurant fields such as name, address, contact info, logo, banner, etc.",
+            "post": {
+                "description": "Issue an API key for machine-to-machine access, sent in the X-API-Key header. The key is only returned in this response",
                 "consumes": [
                     "application/json"
                 ],
@@ -250,32 +426,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Restaurant"
+                    "Auth"
                 ],
-                "summary": "Update restaurant information",
+                "summary": "Create API key",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Restaurant ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Restaurant update payload",
+                        "description": "API key payload",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/restaurantapp.UpdateRestaurantRequest"
+                            "$ref": "#/definitions/authapp.CreateAPIKeyRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Update restaurant successfully",
+                        "description": "API key created successfully",
                         "schema": {
-                            "$ref": "#/definitions/app.UpdateRestaurantSuccessResponseDoc"
+                            "$ref": "#/definitions/app.CreateAPIKeySuccessResponseDoc"
                         }
                     },
                     "default": {
@@ -285,9 +454,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/auth/api-keys/{id}": {
             "delete": {
-                "description": "Remove a restaurant and its related data using its ID",
+                "description": "Revoke an API key of the authenticated user",
                 "consumes": [
                     "application/json"
                 ],
@@ -295,13 +466,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Restaurant"
+                    "Auth"
                 ],
-                "summary": "Delete restaurant by ID",
+                "summary": "Revoke API key",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Restaurant ID",
+                        "description": "API key ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -309,9 +480,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Restaurant deleted successfully",
+                        "description": "API key revoked successfully",
                         "schema": {
-                            "$ref": "#/definitions/app.DeleteRestaurantSuccessResponseDoc"
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
                         }
                     },
                     "default": {
@@ -323,33 +494,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/upload/logo": {
+        "/api/auth/login": {
             "post": {
-                "description": "Upload a logo image to storage and return the public URL",
+                "description": "Authenticate user and return access and refresh tokens",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Upload"
+                    "Auth"
                 ],
-                "summary": "Upload logo file",
+                "summary": "User login",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "Logo file (png, jpg, jpeg)",
-                        "name": "logo",
-                        "in": "formData",
-                        "required": true
+                        "description": "User login payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authapp.LoginRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Upload logo success",
+                        "description": "Login successful",
                         "schema": {
-                            "$ref": "#/definitions/app.UploadLogoSuccessResponseDoc"
+                            "$ref": "#/definitions/app.LoginSuccessResponseDoc"
                         }
                     },
                     "default": {
@@ -360,275 +533,5227 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "app.CreateRestaurantSuccessResponseDoc": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "$ref": "#/definitions/restaurantapp.CreateRestaurantResponse"
-                },
-                "message": {
-                    "type": "string"
+        },
+        "/api/auth/login/mfa": {
+            "post": {
+                "description": "Exchange the MFA challenge token returned by login and a TOTP or recovery code for access and refresh tokens",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Complete login with a second factor",
+                "parameters": [
+                    {
+                        "description": "MFA payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authapp.VerifyMfaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "login success",
+                        "schema": {
+                            "$ref": "#/definitions/app.LoginSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/logout": {
+            "post": {
+                "description": "Revoke the access token used for this request and drop the refresh token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Logout current session",
+                "responses": {
+                    "200": {
+                        "description": "Logout successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.LogoutSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/logout-all": {
+            "post": {
+                "description": "Revoke every access and refresh token issued to the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Logout everywhere",
+                "responses": {
+                    "200": {
+                        "description": "Logout all sessions successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.LogoutSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/mfa/totp/confirm": {
+            "post": {
+                "description": "Enable two-factor with a code from the authenticator app and return the recovery codes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Confirm TOTP enrolment",
+                "parameters": [
+                    {
+                        "description": "TOTP code",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authapp.TOTPCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Two-factor authentication enabled",
+                        "schema": {
+                            "$ref": "#/definitions/app.ConfirmTOTPSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/mfa/totp/disable": {
+            "post": {
+                "description": "Turn off two-factor with a TOTP or recovery code and drop the remaining recovery codes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Disable TOTP",
+                "parameters": [
+                    {
+                        "description": "TOTP or recovery code",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authapp.TOTPCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Two-factor authentication disabled",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/mfa/totp/setup": {
+            "post": {
+                "description": "Generate a TOTP secret and the otpauth URI to render as a QR code. Two-factor is enabled after confirmation",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Start TOTP enrolment",
+                "responses": {
+                    "200": {
+                        "description": "TOTP setup started",
+                        "schema": {
+                            "$ref": "#/definitions/app.SetupTOTPSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/oidc/{provider}/authorize": {
+            "get": {
+                "description": "Redirect the browser to the OpenID Connect provider to sign in",
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Start social login",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider name, as configured in OIDC_PROVIDERS",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Redirect to the provider"
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/oidc/{provider}/callback": {
+            "get": {
+                "description": "Exchange the authorization code returned by the provider for access and refresh tokens",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Finish social login",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider name, as configured in OIDC_PROVIDERS",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization code",
+                        "name": "code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "State from the authorization request",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "login success",
+                        "schema": {
+                            "$ref": "#/definitions/app.LoginSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/password/change": {
+            "post": {
+                "description": "Change the password of the authenticated user after confirming the current one. Every session, this one included, is signed out",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Change password",
+                "parameters": [
+                    {
+                        "description": "Change password payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authapp.ChangePasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Password changed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/password/forgot": {
+            "post": {
+                "description": "Send a single-use password reset link to the email if it belongs to an account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Request a password reset",
+                "parameters": [
+                    {
+                        "description": "Forgot password payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authapp.ForgotPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reset link sent",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/password/reset": {
+            "post": {
+                "description": "Set a new password with a reset token and log out every session",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Reset password",
+                "parameters": [
+                    {
+                        "description": "Reset password payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authapp.ResetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Password reset successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/profile": {
+            "get": {
+                "description": "Retrieve the profile information of the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Get user profile",
+                "responses": {
+                    "200": {
+                        "description": "Profile retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.GetProfileSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update the full name and email of the authenticated user. A new email is unverified until the link mailed to it is opened",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Update user profile",
+                "parameters": [
+                    {
+                        "description": "Profile payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authapp.UpdateProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Profile updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.GetProfileSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/refresh-token": {
+            "post": {
+                "description": "Generate a new access token using a valid refresh token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Refresh access token",
+                "parameters": [
+                    {
+                        "description": "Refresh token payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authapp.RefreshTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Token refreshed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.RefreshTokenSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/register": {
+            "post": {
+                "description": "Create a new user account with email and full name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Register a new userRegisterRequest",
+                "parameters": [
+                    {
+                        "description": "User registration payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authapp.RegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.RegisterSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/sessions": {
+            "get": {
+                "description": "List the devices the authenticated user is logged in on",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "List sessions",
+                "responses": {
+                    "200": {
+                        "description": "Sessions retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.ListSessionsSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/sessions/{id}": {
+            "delete": {
+                "description": "Log out a single device of the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Revoke session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Session revoked successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.LogoutSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/verify-email": {
+            "get": {
+                "description": "Confirm the email address of an account with the token from the verification email",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Verify email",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Verification token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Email verified successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/verify-email/resend": {
+            "post": {
+                "description": "Send a new email verification link if the account is not verified yet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Resend verification email",
+                "parameters": [
+                    {
+                        "description": "Resend verification payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authapp.ResendVerificationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Verification email sent",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/orders": {
+            "get": {
+                "description": "List the orders the signed in customer placed, at any restaurant, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "List my orders",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "placed, accepted, preparing, ready, served, picked_up, completed or cancelled; every status when omitted",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Orders per page, at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List orders successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.ListOrdersSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/orders/{order_id}": {
+            "get": {
+                "description": "Get an order placed by the signed in customer, or placed at a restaurant the user is a member of",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Get order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "order_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Get order successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.OrderSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/orders/{order_id}/cancel": {
+            "post": {
+                "description": "Take back an order of the signed in customer, until the restaurant accepts it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Cancel order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "order_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cancellation reason",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/orderapp.CancelOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cancel order successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.OrderSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/public/restaurants/{slug}": {
+            "get": {
+                "description": "Get the profile, hours and upcoming special hours of an active restaurant, no sign in needed. The slug ends with the restaurant id; a stale slug is redirected to the current one. Supports If-None-Match and If-Modified-Since",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Public"
+                ],
+                "summary": "Get public restaurant page",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant slug, such as com-tam-ba-ghien-42",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Get restaurant successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.GetPublicRestaurantSuccessResponseDoc"
+                        }
+                    },
+                    "301": {
+                        "description": "Moved to the current slug"
+                    },
+                    "304": {
+                        "description": "Not modified"
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/public/restaurants/{slug}/menu": {
+            "get": {
+                "description": "Get the menu of an active restaurant as diners see it, no sign in needed. Prices are in VND. The table and sig of a table QR code select that table. A stale slug is redirected to the current one. Supports If-None-Match and If-Modified-Since",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Public"
+                ],
+                "summary": "Get public restaurant menu",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant slug, such as com-tam-ba-ghien-42",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Table id, from the QR code of a table",
+                        "name": "table",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Signature of the table link, from the QR code of a table",
+                        "name": "sig",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Get menu successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.GetPublicMenuSuccessResponseDoc"
+                        }
+                    },
+                    "301": {
+                        "description": "Moved to the current slug"
+                    },
+                    "304": {
+                        "description": "Not modified"
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant": {
+            "get": {
+                "description": "List active restaurants page by page with filters and sorting. Pass meta.next_cursor as cursor to get the next page. Your own restaurants in any status are listed with owner_id set to your user ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Restaurant"
+                ],
+                "summary": "List restaurants",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "City",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "District",
+                        "name": "district",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID of the owner",
+                        "name": "owner_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "draft, pending_review, active, suspended or closed; other than active only with owner_id of yourself",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only restaurants open at the moment",
+                        "name": "open_now",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "name, -name, created_at or -created_at (default)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Restaurants per page, at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List restaurants successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.ListRestaurantsSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new restaurant with name, email, phone, logo_url, banner_url,...",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Restaurant"
+                ],
+                "summary": "Create restaurant",
+                "parameters": [
+                    {
+                        "description": "Restaurant create payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/restaurantapp.CreateRestaurantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Create restaurant successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.CreateRestaurantSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/nearby": {
+            "get": {
+                "description": "List the restaurants within radius_m meters of a point, nearest first, with their distance in meters. Restaurants without a location are not listed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Restaurant"
+                ],
+                "summary": "Restaurants near a location",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Latitude",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude",
+                        "name": "lng",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Radius in meters, 5000 by default and at most 50000",
+                        "name": "radius_m",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only restaurants open now",
+                        "name": "open_now",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Results, at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List nearby restaurants successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.NearbyRestaurantsSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/search": {
+            "get": {
+                "description": "Full-text search over name, description, category, address, district and city. Words match by prefix and without diacritics, so \"pho\" finds \"Phở\". Pass meta.next_cursor as cursor to get the next page",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Restaurant"
+                ],
+                "summary": "Search restaurants",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search text",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "City",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Results per page, at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Search restaurants successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SearchRestaurantsSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}": {
+            "get": {
+                "description": "Get detailed information of a restaurant using its ID, with whether it is open now by its hours and timezone. Restaurants that are not active are only found by their members",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Restaurant"
+                ],
+                "summary": "Get restaurant by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Get restaurant successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.GetRestaurantByIDSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update restaurant fields such as name, address, contact info, logo, banner, etc.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Restaurant"
+                ],
+                "summary": "Update restaurant information",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Restaurant update payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/restaurantapp.UpdateRestaurantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Update restaurant successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.UpdateRestaurantSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a restaurant and its related data using its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Restaurant"
+                ],
+                "summary": "Delete restaurant by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Restaurant deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.DeleteRestaurantSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/cart": {
+            "get": {
+                "description": "Get the cart of the signed in customer at a restaurant. Prices are in VND",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Get cart",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Get cart successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.OrderSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Empty the cart of the signed in customer at a restaurant",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Delete cart",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete cart successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/cart/items": {
+            "post": {
+                "description": "Add a menu item with the options picked from its groups to the cart of the signed in customer at an active restaurant, starting the cart when there is none. Prices are taken from the menu",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Add cart item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cart item payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/orderapp.CartItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Add cart item successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.OrderSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/cart/items/{item_id}": {
+            "put": {
+                "description": "Replace a line of the cart of the signed in customer, priced again from the menu",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Update cart item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cart item ID",
+                        "name": "item_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cart item payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/orderapp.CartItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Update cart item successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.OrderSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a line from the cart of the signed in customer",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Delete cart item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cart item ID",
+                        "name": "item_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete cart item successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.OrderSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/cart/place": {
+            "post": {
+                "description": "Place the cart of the signed in customer as a dine-in or takeaway order while the restaurant is open. Every line is priced again from the current menu. A dine-in order from the QR code of a table passes the table_id and sig of its link",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Place order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Place order payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/orderapp.PlaceOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Place order successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.OrderSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/hours/{day}/closed": {
+            "put": {
+                "description": "Close every shift of one day of the week, or reopen them, keeping their times",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Restaurant"
+                ],
+                "summary": "Close or reopen a day",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Day of week, 0 for Sunday to 6 for Saturday",
+                        "name": "day",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Whether the day is closed",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/restaurantapp.SetDayClosedRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Update opening hours successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/members": {
+            "get": {
+                "description": "List the owner, managers and staff of a restaurant",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Restaurant"
+                ],
+                "summary": "List restaurant members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Get members successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.ListMembersSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a manager or staff member to a restaurant, or change the role of an existing member",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Restaurant"
+                ],
+                "summary": "Add restaurant member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Member payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/restaurantapp.AddMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Add member successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/members/{user_id}": {
+            "delete": {
+                "description": "Remove a manager or staff member from a restaurant",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Restaurant"
+                ],
+                "summary": "Remove restaurant member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Member user ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Remove member successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/menu": {
+            "get": {
+                "description": "Get the menu of a restaurant: its categories in order with their items, then the items without a category. Prices are in VND. Only members see the inactive categories",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menu"
+                ],
+                "summary": "Get restaurant menu",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Get menu successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.GetMenuSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/menu/categories": {
+            "post": {
+                "description": "Add a category to the menu of a restaurant. Names are unique within the restaurant, ignoring case and accents",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menu"
+                ],
+                "summary": "Create menu category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Category payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/menuapp.CategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Create category successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.CreateMenuCategorySuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/menu/categories/{category_id}": {
+            "put": {
+                "description": "Rename, reorder, hide or show a menu category",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menu"
+                ],
+                "summary": "Update menu category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category ID",
+                        "name": "category_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Category payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/menuapp.CategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Update category successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a menu category. Its items stay on the menu without a category",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menu"
+                ],
+                "summary": "Delete menu category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category ID",
+                        "name": "category_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete category successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/menu/items": {
+            "post": {
+                "description": "Add a dish, beverage, extra or combo to the menu of a restaurant. Price is in VND, image_url comes from /api/upload/image",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menu"
+                ],
+                "summary": "Create menu item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Menu item payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/menuapp.ItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Create menu item successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.CreateMenuItemSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/menu/items/{item_id}": {
+            "get": {
+                "description": "Get one item of the menu of a restaurant",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menu"
+                ],
+                "summary": "Get menu item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Menu item ID",
+                        "name": "item_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Get menu item successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.GetMenuItemSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace a menu item of a restaurant",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menu"
+                ],
+                "summary": "Update menu item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Menu item ID",
+                        "name": "item_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Menu item payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/menuapp.ItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Update menu item successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove an item from the menu of a restaurant",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menu"
+                ],
+                "summary": "Delete menu item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Menu item ID",
+                        "name": "item_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete menu item successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/menu/items/{item_id}/availability": {
+            "put": {
+                "description": "Mark a menu item sold out or available again. Any member of the restaurant may do it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menu"
+                ],
+                "summary": "Set menu item availability",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Menu item ID",
+                        "name": "item_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Availability",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/menuapp.SetItemAvailabilityRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Update menu item successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/menu/items/{item_id}/option-groups": {
+            "put": {
+                "description": "Attach option groups of the restaurant to a menu item in place of its current ones, in display order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menu"
+                ],
+                "summary": "Set menu item option groups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Menu item ID",
+                        "name": "item_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Option group ids",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/menuapp.SetItemOptionGroupsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Update menu item successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/menu/items/{item_id}/price": {
+            "post": {
+                "description": "Check the options picked for a menu item against the rules of its option groups and return the unit price in VND",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menu"
+                ],
+                "summary": "Price menu item configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Menu item ID",
+                        "name": "item_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Options picked",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/menuapp.PriceItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Price menu item successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.PriceMenuItemSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/menu/option-groups": {
+            "get": {
+                "description": "List the option groups of a restaurant with their options, whether attached to an item or not",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menu"
+                ],
+                "summary": "List option groups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List option groups successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.ListOptionGroupsSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a group of modifiers, such as sizes or toppings, to a restaurant. min_select and max_select bound the total quantity picked across the group, max_select null means no upper bound. Price deltas are in VND",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menu"
+                ],
+                "summary": "Create option group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Option group payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/menuapp.OptionGroupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Create option group successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.CreateOptionGroupSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/menu/option-groups/{group_id}": {
+            "put": {
+                "description": "Replace an option group of a restaurant along with all of its options",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menu"
+                ],
+                "summary": "Update option group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Option group ID",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Option group payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/menuapp.OptionGroupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Update option group successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove an option group of a restaurant, detaching it from every item",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menu"
+                ],
+                "summary": "Delete option group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Option group ID",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete option group successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/orders": {
+            "get": {
+                "description": "List the orders placed at a restaurant, newest first, for its members. Pass status=placed for the orders waiting to be accepted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "List restaurant orders",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "placed, accepted, preparing, ready, served, picked_up, completed or cancelled; every status when omitted",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Orders per page, at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List orders successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.ListOrdersSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/orders/{order_id}/status": {
+            "put": {
+                "description": "Move an order of a restaurant along placed, accepted, preparing, ready, then served for dine-in or picked_up for takeaway, then completed. Orders are cancelled up to preparing, with an optional reason shown to the customer",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Update order status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "order_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New status",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/orderapp.UpdateOrderStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Update order status successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.OrderSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/special-hours": {
+            "get": {
+                "description": "List the date specific rules overriding the weekly hours of a restaurant: holidays, events and temporary closures",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Restaurant"
+                ],
+                "summary": "List special hours",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Leave out the rules that ended before today",
+                        "name": "upcoming",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List special hours successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.ListSpecialHoursSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Close a restaurant or give it alternate hours on a range of dates. The rules of a date replace its weekly hours",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Restaurant"
+                ],
+                "summary": "Create special hours",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Special hours payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/restaurantapp.SpecialHoursRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Create special hours successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.CreateSpecialHoursSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/special-hours/{special_id}": {
+            "put": {
+                "description": "Replace a special hours rule of a restaurant",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Restaurant"
+                ],
+                "summary": "Update special hours",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Special hours ID",
+                        "name": "special_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Special hours payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/restaurantapp.SpecialHoursRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Update special hours successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a special hours rule, the weekly hours apply again on its dates",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Restaurant"
+                ],
+                "summary": "Delete special hours",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Special hours ID",
+                        "name": "special_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete special hours successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/status": {
+            "put": {
+                "description": "Owner side of the restaurant lifecycle: submit a draft for review (pending_review), withdraw it (draft), close the restaurant (closed) or submit a closed one again (pending_review)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Restaurant"
+                ],
+                "summary": "Update restaurant status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New status",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/restaurantapp.UpdateStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Update restaurant status successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/tables": {
+            "get": {
+                "description": "List the tables of a restaurant with the links to their QR code images",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Table"
+                ],
+                "summary": "List tables",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List tables successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.ListTablesSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a table to a restaurant. Its QR code is generated separately",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Table"
+                ],
+                "summary": "Create table",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Table payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tableapp.TableRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Create table successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.CreateTableSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/tables/qr-codes": {
+            "get": {
+                "description": "Download the PNG and SVG QR codes of every active table of a restaurant as a zip archive, for printing. Missing codes are generated first",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "Table"
+                ],
+                "summary": "Download table QR codes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Zip archive of the QR codes",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/tables/{table_id}": {
+            "put": {
+                "description": "Rename a table, change its seats or deactivate it. Its QR code keeps working",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Table"
+                ],
+                "summary": "Update table",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Table ID",
+                        "name": "table_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Table payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tableapp.TableRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Update table successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a table and its QR code images. Printed QR codes of the table then open the menu without selecting it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Table"
+                ],
+                "summary": "Delete table",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Table ID",
+                        "name": "table_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete table successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.SuccecssResponseBaseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/restaurant/{id}/tables/{table_id}/qr": {
+            "post": {
+                "description": "Generate the PNG and SVG QR code of a table, replacing the previous images. The code opens the public menu with the table selected",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Table"
+                ],
+                "summary": "Generate table QR code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restaurant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Table ID",
+                        "name": "table_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Generate QR code successfully",
+                        "schema": {
+                            "$ref": "#/definitions/app.TableQRSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/upload/image": {
+            "post": {
+                "description": "Upload an image, such as a menu item photo, to storage and return the public URL",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Upload"
+                ],
+                "summary": "Upload image file",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Image file (png, jpg, jpeg)",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Upload image success",
+                        "schema": {
+                            "$ref": "#/definitions/app.UploadImageSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/upload/logo": {
+            "post": {
+                "description": "Upload a logo image to storage and return the public URL",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Upload"
+                ],
+                "summary": "Upload logo file",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Logo file (png, jpg, jpeg)",
+                        "name": "logo",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Upload logo success",
+                        "schema": {
+                            "$ref": "#/definitions/app.UploadLogoSuccessResponseDoc"
+                        }
+                    },
+                    "default": {
+                        "description": "Errors",
+                        "schema": {
+                            "$ref": "#/definitions/app.ErrorResponseDoc"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "app.AdminUserSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/authapp.AdminUserResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.ConfirmTOTPSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/authapp.ConfirmTOTPResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.CreateAPIKeySuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/authapp.CreateAPIKeyResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.CreateMenuCategorySuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/menuapp.CreateCategoryResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.CreateMenuItemSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/menuapp.CreateItemResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.CreateOptionGroupSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/menuapp.CreateOptionGroupResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.CreateRestaurantSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/restaurantapp.CreateRestaurantResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.CreateSpecialHoursSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/restaurantapp.CreateSpecialHoursResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.CreateTableSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/tableapp.CreateTableResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.DeleteRestaurantSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.ErrorResponseDoc": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "$ref": "#/definitions/response.ErrorDetail"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.GetMenuItemSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/menuapp.ItemResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.GetMenuSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/menuapp.MenuResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.GetProfileSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/authapp.GetProfileResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.GetPublicMenuSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/menuapp.PublicMenuResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.GetPublicRestaurantSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/restaurantapp.PublicRestaurantResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.GetRestaurantByIDSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/restaurantapp.GetRestaurantByIDResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.ListAPIKeysSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/authapp.ListAPIKeysResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.ListMembersSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/restaurantapp.ListMembersResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.ListOptionGroupsSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/menuapp.ListOptionGroupsResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.ListOrdersSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/orderapp.ListOrdersResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "meta": {
+                    "$ref": "#/definitions/response.PageMeta"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.ListRestaurantsSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/restaurantapp.ListRestaurantsResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "meta": {
+                    "$ref": "#/definitions/response.PageMeta"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.ListRolesSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/authapp.ListRolesResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.ListSessionsSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/authapp.ListSessionsResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.ListSpecialHoursSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/restaurantapp.ListSpecialHoursResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.ListTablesSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/tableapp.ListTablesResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.ListUsersSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/authapp.ListUsersResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.LoginSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/authapp.LoginResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.LogoutSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.NearbyRestaurantsSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/restaurantapp.NearbyRestaurantsResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "meta": {
+                    "$ref": "#/definitions/response.PageMeta"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.OrderSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/orderapp.OrderResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.PriceMenuItemSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/menuapp.PriceItemResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.RefreshTokenSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/authapp.RefreshTokenResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.RegisterSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/authapp.RegisterSuccess"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.SearchRestaurantsSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/restaurantapp.SearchRestaurantsResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "meta": {
+                    "$ref": "#/definitions/response.PageMeta"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.SetupTOTPSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/authapp.SetupTOTPResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.SuccecssResponseBaseDoc": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.TableQRSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/tableapp.TableQRResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.UpdateRestaurantSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.UploadImageSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/uploadapp.UploadImageResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.UploadLogoSuccessResponseDoc": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/uploadapp.UploadLogoResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "authapp.APIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prefix": {
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "authapp.AdminUserResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "email_verified_at": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "authapp.ChangePasswordRequest": {
+            "type": "object",
+            "properties": {
+                "current_password": {
+                    "type": "string"
+                },
+                "new_password": {
+                    "type": "string"
+                }
+            }
+        },
+        "authapp.ConfirmTOTPResponse": {
+            "type": "object",
+            "properties": {
+                "recovery_codes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "authapp.CreateAPIKeyRequest": {
+            "type": "object",
+            "properties": {
+                "expires_in_days": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "authapp.CreateAPIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "key": {
+                    "description": "Key is the full secret, returned only once.",
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prefix": {
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "authapp.ForgotPasswordRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "authapp.GetProfileResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "authapp.ListAPIKeysResponse": {
+            "type": "object",
+            "properties": {
+                "api_keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/authapp.APIKeyResponse"
+                    }
+                }
+            }
+        },
+        "authapp.ListRolesResponse": {
+            "type": "object",
+            "properties": {
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "authapp.ListSessionsResponse": {
+            "type": "object",
+            "properties": {
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/authapp.SessionResponse"
+                    }
+                }
+            }
+        },
+        "authapp.ListUsersResponse": {
+            "type": "object",
+            "properties": {
+                "page": {
+                    "type": "integer"
+                },
+                "page_size": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/authapp.AdminUserResponse"
+                    }
+                }
+            }
+        },
+        "authapp.LoginRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "authapp.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "expires_in": {
+                    "type": "integer"
+                },
+                "mfa_required": {
+                    "type": "boolean"
+                },
+                "mfa_token": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "authapp.RefreshTokenRequest": {
+            "type": "object",
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "authapp.RefreshTokenResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "expires_in": {
+                    "type": "integer"
+                },
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "authapp.RegisterRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "authapp.RegisterSuccess": {
+            "type": "object"
+        },
+        "authapp.ResendVerificationRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "authapp.ResetPasswordRequest": {
+            "type": "object",
+            "properties": {
+                "new_password": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "authapp.SessionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "current": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ip_address": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "user_agent": {
+                    "type": "string"
+                }
+            }
+        },
+        "authapp.SetUserRoleRequest": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "authapp.SetUserStatusRequest": {
+            "type": "object",
+            "properties": {
+                "is_active": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "authapp.SetupTOTPResponse": {
+            "type": "object",
+            "properties": {
+                "otpauth_uri": {
+                    "type": "string"
+                },
+                "secret": {
+                    "type": "string"
+                }
+            }
+        },
+        "authapp.TOTPCodeRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "authapp.UpdateProfileRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "authapp.VerifyMfaRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "mfa_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "menuapp.CategoryRequest": {
+            "type": "object",
+            "properties": {
+                "is_active": {
+                    "description": "IsActive defaults to true; inactive categories are hidden from diners with their items",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sort_order": {
+                    "type": "integer"
+                }
+            }
+        },
+        "menuapp.CategoryResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/menuapp.ItemResponse"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "sort_order": {
+                    "type": "integer"
+                }
+            }
+        },
+        "menuapp.CreateCategoryResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "menuapp.CreateItemResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "menuapp.CreateOptionGroupResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "menuapp.ItemRequest": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "is_available": {
+                    "description": "IsAvailable defaults to true; clear it when the item is sold out",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "integer"
+                },
+                "sort_order": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "menuapp.ItemResponse": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "is_available": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "option_groups": {
+                    "description": "OptionGroups are the modifiers a diner picks from when ordering the item",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/menuapp.OptionGroupResponse"
+                    }
+                },
+                "price": {
+                    "type": "integer"
+                },
+                "sort_order": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "menuapp.ListOptionGroupsResponse": {
+            "type": "object",
+            "properties": {
+                "option_groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/menuapp.OptionGroupResponse"
+                    }
+                }
+            }
+        },
+        "menuapp.MenuResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/menuapp.CategoryResponse"
+                    }
+                },
+                "uncategorized": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/menuapp.ItemResponse"
+                    }
+                }
+            }
+        },
+        "menuapp.OptionGroupRequest": {
+            "type": "object",
+            "properties": {
+                "max_select": {
+                    "type": "integer"
+                },
+                "min_select": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/menuapp.OptionRequest"
+                    }
+                },
+                "sort_order": {
+                    "type": "integer"
+                }
+            }
+        },
+        "menuapp.OptionGroupResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "max_select": {
+                    "type": "integer"
+                },
+                "min_select": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/menuapp.OptionResponse"
+                    }
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "sort_order": {
+                    "type": "integer"
+                }
+            }
+        },
+        "menuapp.OptionRequest": {
+            "type": "object",
+            "properties": {
+                "is_available": {
+                    "description": "IsAvailable defaults to true",
+                    "type": "boolean"
+                },
+                "max_quantity": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price_delta": {
+                    "type": "integer"
+                }
+            }
+        },
+        "menuapp.OptionResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "is_available": {
+                    "type": "boolean"
+                },
+                "max_quantity": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price_delta": {
+                    "type": "integer"
+                }
+            }
+        },
+        "menuapp.PriceItemRequest": {
+            "type": "object",
+            "properties": {
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/menuapp.SelectionRequest"
+                    }
+                }
+            }
+        },
+        "menuapp.PriceItemResponse": {
+            "type": "object",
+            "properties": {
+                "base_price": {
+                    "type": "integer"
+                },
+                "options_price": {
+                    "type": "integer"
+                },
+                "unit_price": {
+                    "type": "integer"
+                }
+            }
+        },
+        "menuapp.PublicMenuResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/menuapp.CategoryResponse"
+                    }
+                },
+                "last_modified": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "table": {
+                    "description": "Table is the table selected by the QR code the menu was opened from",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/menuapp.PublicTableResponse"
+                        }
+                    ]
+                },
+                "uncategorized": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/menuapp.ItemResponse"
+                    }
+                }
+            }
+        },
+        "menuapp.PublicTableResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "menuapp.SelectionRequest": {
+            "type": "object",
+            "properties": {
+                "option_id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "menuapp.SetItemAvailabilityRequest": {
+            "type": "object",
+            "properties": {
+                "is_available": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "menuapp.SetItemOptionGroupsRequest": {
+            "type": "object",
+            "properties": {
+                "option_group_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "orderapp.CancelOrderRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "orderapp.CartItemRequest": {
+            "type": "object",
+            "properties": {
+                "menu_item_id": {
+                    "type": "integer"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/orderapp.SelectionRequest"
+                    }
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "orderapp.ListOrdersResponse": {
+            "type": "object",
+            "properties": {
+                "orders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/orderapp.OrderResponse"
+                    }
+                }
+            }
+        },
+        "orderapp.OrderItemOptionResponse": {
+            "type": "object",
+            "properties": {
+                "group_name": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "option_id": {
+                    "type": "integer"
+                },
+                "price_delta": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "orderapp.OrderItemResponse": {
+            "type": "object",
+            "properties": {
+                "base_price": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "line_total": {
+                    "type": "integer"
+                },
+                "menu_item_id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/orderapp.OrderItemOptionResponse"
+                    }
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "unit_price": {
+                    "type": "integer"
+                }
+            }
+        },
+        "orderapp.OrderResponse": {
+            "type": "object",
+            "properties": {
+                "cancel_reason": {
+                    "type": "string"
+                },
+                "customer_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/orderapp.OrderItemResponse"
+                    }
+                },
+                "note": {
+                    "type": "string"
+                },
+                "placed_at": {
+                    "type": "string"
+                },
+                "restaurant_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "subtotal": {
+                    "type": "integer"
+                },
+                "table_id": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "orderapp.PlaceOrderRequest": {
+            "type": "object",
+            "properties": {
+                "note": {
+                    "type": "string"
+                },
+                "sig": {
+                    "type": "string"
+                },
+                "table_id": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "orderapp.SelectionRequest": {
+            "type": "object",
+            "properties": {
+                "option_id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "orderapp.UpdateOrderStatusRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "description": "Reason is kept when cancelling, and shown to the customer",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.ErrorDetail": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.PageMeta": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "restaurant.DayOfWeek": {
+            "type": "integer",
+            "enum": [
+                0,
+                1,
+                2,
+                3,
+                4,
+                5,
+                6
+            ],
+            "x-enum-comments": {
+                "Friday": "5",
+                "Monday": "1",
+                "Saturday": "6",
+                "Sunday": "0",
+                "Thursday": "4",
+                "Tuesday": "2",
+                "Wednesday": "3"
+            },
+            "x-enum-varnames": [
+                "Sunday",
+                "Monday",
+                "Tuesday",
+                "Wednesday",
+                "Thursday",
+                "Friday",
+                "Saturday"
+            ]
+        },
+        "restaurantapp.AddMemberRequest": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "restaurantapp.CreateRestaurantRequest": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "banner_url": {
+                    "type": "string"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "district": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/restaurantapp.RestaurantHoursBase"
+                    }
+                },
+                "latitude": {
+                    "description": "Latitude and Longitude locate the restaurant; when omitted the address is geocoded",
+                    "type": "number"
+                },
+                "logo_url": {
+                    "type": "string"
+                },
+                "longitude": {
+                    "type": "number"
                 },
-                "response_code": {
+                "name": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "description": "Timezone is an IANA name such as Asia/Ho_Chi_Minh the hours are read in, DEFAULT_TIMEZONE\nwhen omitted",
+                    "type": "string"
+                },
+                "website_url": {
                     "type": "string"
                 }
             }
         },
-        "app.DeleteRestaurantSuccessResponseDoc": {
+        "restaurantapp.CreateRestaurantResponse": {
             "type": "object",
             "properties": {
-                "message": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "restaurantapp.CreateSpecialHoursResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "restaurantapp.GetRestaurantByIDResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
                     "type": "string"
                 },
-                "response_code": {
+                "banner_url": {
+                    "type": "string"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "closes_at": {
+                    "description": "ClosesAt is set while open, NextOpenAt when another opening is due within a week",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "district": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/restaurantapp.RestaurantHoursBase"
+                    }
+                },
+                "is_open": {
+                    "type": "boolean"
+                },
+                "latitude": {
+                    "description": "Latitude and Longitude locate the restaurant; when omitted the address is geocoded",
+                    "type": "number"
+                },
+                "logo_url": {
+                    "type": "string"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "next_open_at": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "slug": {
+                    "description": "Slug names the public page of the restaurant, see /api/public/restaurants/{slug}",
+                    "type": "string"
+                },
+                "special_hours": {
+                    "description": "SpecialHours are the current and upcoming overrides of Hours",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/restaurantapp.SpecialHoursResponse"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                },
+                "status_reason": {
+                    "description": "StatusReason is the reviewer note on the last rejection or suspension",
+                    "type": "string"
+                },
+                "timezone": {
+                    "description": "Timezone is an IANA name such as Asia/Ho_Chi_Minh the hours are read in, DEFAULT_TIMEZONE\nwhen omitted",
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                },
+                "website_url": {
                     "type": "string"
                 }
             }
         },
-        "app.ErrorResponseDoc": {
+        "restaurantapp.ListMembersResponse": {
             "type": "object",
             "properties": {
-                "error": {
-                    "$ref": "#/definitions/response.ErrorDetail"
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/restaurantapp.MemberResponse"
+                    }
+                }
+            }
+        },
+        "restaurantapp.ListRestaurantsResponse": {
+            "type": "object",
+            "properties": {
+                "restaurants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/restaurantapp.RestaurantSummaryResponse"
+                    }
+                }
+            }
+        },
+        "restaurantapp.ListSpecialHoursResponse": {
+            "type": "object",
+            "properties": {
+                "special_hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/restaurantapp.SpecialHoursResponse"
+                    }
+                }
+            }
+        },
+        "restaurantapp.MemberResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
                 },
-                "message": {
+                "role": {
                     "type": "string"
                 },
-                "response_code": {
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "app.GetProfileSuccessResponseDoc": {
+        "restaurantapp.NearbyRestaurant": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/authapp.GetProfileResponse"
+                "address": {
+                    "type": "string"
                 },
-                "message": {
+                "banner_url": {
                     "type": "string"
                 },
-                "response_code": {
+                "category": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "distance_m": {
+                    "type": "number"
+                },
+                "district": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "logo_url": {
+                    "type": "string"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "app.GetRestaurantByIDSuccessResponseDoc": {
+        "restaurantapp.NearbyRestaurantsResponse": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/restaurantapp.GetRestaurantByIDResponse"
+                "restaurants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/restaurantapp.NearbyRestaurant"
+                    }
+                }
+            }
+        },
+        "restaurantapp.PublicRestaurantResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
                 },
-                "message": {
+                "banner_url": {
                     "type": "string"
                 },
-                "response_code": {
+                "category": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "district": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/restaurantapp.RestaurantHoursBase"
+                    }
+                },
+                "last_modified": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "description": "Latitude and Longitude locate the restaurant; when omitted the address is geocoded",
+                    "type": "number"
+                },
+                "logo_url": {
+                    "type": "string"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "special_hours": {
+                    "description": "SpecialHours are the current and upcoming overrides of Hours",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/restaurantapp.SpecialHoursResponse"
+                    }
+                },
+                "timezone": {
+                    "description": "Timezone is an IANA name such as Asia/Ho_Chi_Minh the hours are read in, DEFAULT_TIMEZONE\nwhen omitted",
+                    "type": "string"
+                },
+                "website_url": {
                     "type": "string"
                 }
             }
         },
-        "app.LoginSuccessResponseDoc": {
+        "restaurantapp.RestaurantHoursBase": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/authapp.LoginResponse"
-                },
-                "message": {
+                "close_time": {
                     "type": "string"
                 },
-                "response_code": {
+                "day": {
+                    "$ref": "#/definitions/restaurant.DayOfWeek"
+                },
+                "is_closed": {
+                    "type": "boolean"
+                },
+                "next_day": {
+                    "type": "boolean"
+                },
+                "open_time": {
                     "type": "string"
                 }
             }
         },
-        "app.RefreshTokenSuccessResponseDoc": {
+        "restaurantapp.RestaurantSearchResult": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/authapp.RefreshTokenResponse"
+                "address": {
+                    "type": "string"
+                },
+                "banner_url": {
+                    "type": "string"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "district": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "logo_url": {
+                    "type": "string"
                 },
-                "message": {
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
                     "type": "string"
                 },
-                "response_code": {
+                "name_highlight": {
+                    "description": "NameHighlight and Snippet are HTML escaped with the matched words wrapped in \u003cmark\u003e",
                     "type": "string"
-                }
-            }
-        },
-        "app.RegisterSuccessResponseDoc": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "$ref": "#/definitions/authapp.RegisterSuccess"
                 },
-                "message": {
+                "rank": {
+                    "type": "number"
+                },
+                "snippet": {
                     "type": "string"
                 },
-                "response_code": {
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "app.UpdateRestaurantSuccessResponseDoc": {
+        "restaurantapp.RestaurantSummaryResponse": {
             "type": "object",
             "properties": {
-                "message": {
+                "address": {
                     "type": "string"
                 },
-                "response_code": {
+                "banner_url": {
                     "type": "string"
-                }
-            }
-        },
-        "app.UploadLogoSuccessResponseDoc": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "$ref": "#/definitions/uploadapp.UploadLogoResponse"
                 },
-                "message": {
+                "category": {
                     "type": "string"
                 },
-                "response_code": {
+                "city": {
                     "type": "string"
-                }
-            }
-        },
-        "authapp.GetProfileResponse": {
-            "type": "object",
-            "properties": {
-                "email": {
+                },
+                "created_at": {
                     "type": "string"
                 },
-                "full_name": {
+                "description": {
                     "type": "string"
                 },
-                "is_active": {
-                    "type": "boolean"
+                "district": {
+                    "type": "string"
                 },
-                "role": {
+                "id": {
+                    "type": "integer"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "logo_url": {
+                    "type": "string"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "authapp.LoginRequest": {
+        "restaurantapp.ReviewRestaurantRequest": {
             "type": "object",
             "properties": {
-                "email": {
+                "reason": {
                     "type": "string"
                 },
-                "password": {
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "authapp.LoginResponse": {
+        "restaurantapp.SearchRestaurantsResponse": {
             "type": "object",
             "properties": {
-                "access_token": {
-                    "type": "string"
-                },
-                "expires_in": {
-                    "type": "integer"
-                },
-                "refresh_token": {
-                    "type": "string"
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/restaurantapp.RestaurantSearchResult"
+                    }
                 }
             }
         },
-        "authapp.RefreshTokenRequest": {
+        "restaurantapp.SetDayClosedRequest": {
             "type": "object",
             "properties": {
-                "refresh_token": {
-                    "type": "string"
+                "is_closed": {
+                    "type": "boolean"
                 }
             }
         },
-        "authapp.RefreshTokenResponse": {
+        "restaurantapp.SpecialHoursRequest": {
             "type": "object",
             "properties": {
-                "access_token": {
+                "close_time": {
                     "type": "string"
                 },
-                "expires_in": {
-                    "type": "integer"
+                "end_date": {
+                    "type": "string"
                 },
-                "refresh_token": {
+                "is_closed": {
+                    "type": "boolean"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "open_time": {
+                    "type": "string"
+                },
+                "start_date": {
                     "type": "string"
                 }
             }
         },
-        "authapp.RegisterRequest": {
+        "restaurantapp.SpecialHoursResponse": {
             "type": "object",
             "properties": {
-                "email": {
+                "close_time": {
                     "type": "string"
                 },
-                "full_name": {
+                "end_date": {
                     "type": "string"
                 },
-                "password": {
+                "id": {
+                    "type": "integer"
+                },
+                "is_closed": {
+                    "type": "boolean"
+                },
+                "next_day": {
+                    "type": "boolean"
+                },
+                "note": {
                     "type": "string"
-                }
-            }
-        },
-        "authapp.RegisterSuccess": {
-            "type": "object"
-        },
-        "response.ErrorDetail": {
-            "type": "object",
-            "properties": {
-                "field": {
+                },
+                "open_time": {
                     "type": "string"
                 },
-                "message": {
+                "start_date": {
                     "type": "string"
                 }
             }
         },
-        "restaurant.DayOfWeek": {
-            "type": "integer",
-            "enum": [
-                0,
-                1,
-                2,
-                3,
-                4,
-                5,
-                6
-            ],
-            "x-enum-comments": {
-                "Friday": "5",
-                "Monday": "1",
-                "Saturday": "6",
-                "Sunday": "0",
-                "Thursday": "4",
-                "Tuesday": "2",
-                "Wednesday": "3"
-            },
-            "x-enum-descriptions": [
-                "0",
-                "1",
-                "2",
-                "3",
-                "4",
-                "5",
-                "6"
-            ],
-            "x-enum-varnames": [
-                "Sunday",
-                "Monday",
-                "Tuesday",
-                "Wednesday",
-                "Thursday",
-                "Friday",
-                "Saturday"
-            ]
-        },
-        "restaurantapp.CreateRestaurantRequest": {
+        "restaurantapp.UpdateRestaurantRequest": {
             "type": "object",
             "properties": {
                 "address": {
@@ -658,21 +5783,40 @@ const docTemplate = `{
                         "$ref": "#/definitions/restaurantapp.RestaurantHoursBase"
                     }
                 },
+                "latitude": {
+                    "description": "Latitude and Longitude locate the restaurant; when omitted the address is geocoded",
+                    "type": "number"
+                },
                 "logo_url": {
                     "type": "string"
                 },
+                "longitude": {
+                    "type": "number"
+                },
                 "name": {
                     "type": "string"
                 },
                 "phone_number": {
                     "type": "string"
                 },
+                "timezone": {
+                    "description": "Timezone is an IANA name such as Asia/Ho_Chi_Minh the hours are read in, DEFAULT_TIMEZONE\nwhen omitted",
+                    "type": "string"
+                },
                 "website_url": {
                     "type": "string"
                 }
             }
         },
-        "restaurantapp.CreateRestaurantResponse": {
+        "restaurantapp.UpdateStatusRequest": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "tableapp.CreateTableResponse": {
             "type": "object",
             "properties": {
                 "id": {
@@ -680,119 +5824,125 @@ const docTemplate = `{
                 }
             }
         },
-        "restaurantapp.GetRestaurantByIDResponse": {
+        "tableapp.ListTablesResponse": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "banner_url": {
-                    "type": "string"
-                },
-                "category": {
-                    "type": "string"
-                },
-                "city": {
+                "tables": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tableapp.TableResponse"
+                    }
+                }
+            }
+        },
+        "tableapp.TableQRResponse": {
+            "type": "object",
+            "properties": {
+                "link": {
                     "type": "string"
                 },
-                "description": {
+                "qr_png_url": {
                     "type": "string"
                 },
-                "district": {
+                "qr_svg_url": {
                     "type": "string"
+                }
+            }
+        },
+        "tableapp.TableRequest": {
+            "type": "object",
+            "properties": {
+                "is_active": {
+                    "description": "IsActive defaults to true; the QR code of an inactive table opens the menu without selecting it",
+                    "type": "boolean"
                 },
-                "email": {
+                "name": {
                     "type": "string"
                 },
-                "hours": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/restaurantapp.RestaurantHoursBase"
-                    }
+                "seats": {
+                    "type": "integer"
+                }
+            }
+        },
+        "tableapp.TableResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
                 },
                 "is_active": {
                     "type": "boolean"
                 },
-                "logo_url": {
-                    "type": "string"
-                },
                 "name": {
                     "type": "string"
                 },
-                "phone_number": {
+                "qr_generated_at": {
                     "type": "string"
                 },
-                "user_name": {
+                "qr_png_url": {
                     "type": "string"
                 },
-                "website_url": {
+                "qr_svg_url": {
                     "type": "string"
+                },
+                "seats": {
+                    "type": "integer"
                 }
             }
         },
-        "restaurantapp.RestaurantHoursBase": {
+        "uploadapp.UploadImageResponse": {
             "type": "object",
             "properties": {
-                "close_time": {
-                    "type": "string"
-                },
-                "day": {
-                    "$ref": "#/definitions/restaurant.DayOfWeek"
-                },
-                "open_time": {
+                "url": {
                     "type": "string"
                 }
             }
         },
-        "restaurantapp.UpdateRestaurantRequest": {
+        "uploadapp.UploadLogoResponse": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "banner_url": {
-                    "type": "string"
-                },
-                "category": {
+                "url": {
                     "type": "string"
-                },
-                "city": {
+                }
+            }
+        },
+        "utils.JWK": {
+            "type": "object",
+            "properties": {
+                "alg": {
                     "type": "string"
                 },
-                "description": {
+                "crv": {
                     "type": "string"
                 },
-                "district": {
+                "e": {
                     "type": "string"
                 },
-                "email": {
+                "kid": {
                     "type": "string"
                 },
-                "hours": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/restaurantapp.RestaurantHoursBase"
-                    }
-                },
-                "logo_url": {
+                "kty": {
                     "type": "string"
                 },
-                "name": {
+                "n": {
                     "type": "string"
                 },
-                "phone_number": {
+                "use": {
                     "type": "string"
                 },
-                "website_url": {
+                "x": {
                     "type": "string"
                 }
             }
         },
-        "uploadapp.UploadLogoResponse": {
+        "utils.JWKS": {
             "type": "object",
             "properties": {
-                "url": {
-                    "type": "string"
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/utils.JWK"
+                    }
                 }
             }
         }
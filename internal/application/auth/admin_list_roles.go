@@ -0,0 +1,29 @@
+package authapp
+
+import (
+	"context"
+	"go-ai/internal/domain/auth"
+)
+
+type ListRolesUseCase struct {
+	repo auth.Repository
+}
+
+func NewListRolesUseCase(repo auth.Repository) *ListRolesUseCase {
+	return &ListRolesUseCase{
+		repo: repo,
+	}
+}
+
+func (uc *ListRolesUseCase) Execute(ctx context.Context) (*ListRolesResponse, error) {
+	roles, err := uc.repo.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if roles == nil {
+		roles = []string{}
+	}
+	return &ListRolesResponse{
+		Roles: roles,
+	}, nil
+}
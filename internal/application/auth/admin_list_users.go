@@ -0,0 +1,67 @@
+package authapp
+
+import (
+	"context"
+	"go-ai/internal/domain/auth"
+	"strings"
+)
+
+const (
+	defaultUsersPageSize = 20
+	maxUsersPageSize     = 100
+)
+
+type ListUsersUseCase struct {
+	repo auth.Repository
+}
+
+func NewListUsersUseCase(repo auth.Repository) *ListUsersUseCase {
+	return &ListUsersUseCase{
+		repo: repo,
+	}
+}
+
+// Execute returns one page of users, newest first. Search matches email and full name.
+func (uc *ListUsersUseCase) Execute(ctx context.Context, request ListUsersRequest) (*ListUsersResponse, error) {
+	if request.Page < 1 {
+		request.Page = 1
+	}
+	if request.PageSize < 1 {
+		request.PageSize = defaultUsersPageSize
+	}
+	if request.PageSize > maxUsersPageSize {
+		request.PageSize = maxUsersPageSize
+	}
+	records, total, err := uc.repo.ListUsers(ctx, auth.UserFilter{
+		Search:   strings.TrimSpace(request.Search),
+		Role:     strings.TrimSpace(request.Role),
+		IsActive: request.IsActive,
+		Limit:    int32(request.PageSize),
+		Offset:   int32((request.Page - 1) * request.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+	users := make([]AdminUserResponse, 0, len(records))
+	for _, u := range records {
+		users = append(users, toAdminUserResponse(&u))
+	}
+	return &ListUsersResponse{
+		Users:    users,
+		Page:     request.Page,
+		PageSize: request.PageSize,
+		Total:    total,
+	}, nil
+}
+
+func toAdminUserResponse(u *auth.Entity) AdminUserResponse {
+	return AdminUserResponse{
+		Id:              u.ID,
+		Email:           u.Email,
+		FullName:        u.FullName,
+		Role:            u.Role,
+		IsActive:        u.IsActive,
+		EmailVerifiedAt: u.EmailVerifiedAt,
+		CreatedAt:       u.CreatedAt,
+	}
+}
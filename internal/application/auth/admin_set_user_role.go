@@ -0,0 +1,62 @@
+package authapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-ai/internal/config"
+	"go-ai/internal/domain/auth"
+	"go-ai/internal/infra/cache"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type SetUserRoleUseCase struct {
+	repo  auth.Repository
+	cache *cache.AuthCache
+}
+
+func NewSetUserRoleUseCase(repo auth.Repository, cache *cache.AuthCache) *SetUserRoleUseCase {
+	return &SetUserRoleUseCase{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// Execute assigns one of the roles of the role table to a user. The cached profile is updated
+// in place so the new permissions apply to the running sessions right away.
+func (uc *SetUserRoleUseCase) Execute(ctx context.Context, adminId, userId uuid.UUID, request SetUserRoleRequest) (*AdminUserResponse, error) {
+	config, _ := config.LoadConfig()
+	if adminId == userId {
+		return nil, auth.ErrCannotModifySelf
+	}
+	role := strings.TrimSpace(request.Role)
+	if role == "" {
+		return nil, auth.ErrRoleNotFound
+	}
+	if err := uc.repo.SetRole(ctx, userId, role); err != nil {
+		return nil, err
+	}
+	record, err := uc.repo.GetById(ctx, userId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, auth.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	keyAuthCache := fmt.Sprintf("profile_%s", userId.String())
+	authData, err := uc.cache.GetAuthCache(keyAuthCache)
+	if err != nil {
+		return nil, err
+	}
+	// without a cached profile the user has no live session to update
+	if authData != nil {
+		authData.Role = record.Role
+		uc.cache.SetAuthCache(keyAuthCache, authData, time.Duration(config.JwtExpiresIn*int(time.Second)))
+	}
+	resp := toAdminUserResponse(record)
+	return &resp, nil
+}
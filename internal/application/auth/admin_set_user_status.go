@@ -0,0 +1,55 @@
+package authapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-ai/internal/domain/auth"
+	"go-ai/internal/infra/cache"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type SetUserStatusUseCase struct {
+	repo  auth.Repository
+	cache *cache.AuthCache
+}
+
+func NewSetUserStatusUseCase(repo auth.Repository, cache *cache.AuthCache) *SetUserStatusUseCase {
+	return &SetUserStatusUseCase{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// Execute activates or deactivates a user. Deactivation ends every session and drops the cached
+// profile, so the auth middleware rejects the user's tokens and API keys on the next request.
+func (uc *SetUserStatusUseCase) Execute(ctx context.Context, adminId, userId uuid.UUID, request SetUserStatusRequest) (*AdminUserResponse, error) {
+	if adminId == userId {
+		return nil, auth.ErrCannotModifySelf
+	}
+	if err := uc.repo.SetActive(ctx, userId, request.IsActive); err != nil {
+		return nil, err
+	}
+	if request.IsActive {
+		// the API key path may have cached the profile as inactive
+		keyAuthCache := fmt.Sprintf("profile_%s", userId.String())
+		if err := uc.cache.DeleteAuthCache(keyAuthCache); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := revokeAllSessions(uc.cache, userId); err != nil {
+			return nil, err
+		}
+	}
+	record, err := uc.repo.GetById(ctx, userId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, auth.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp := toAdminUserResponse(record)
+	return &resp, nil
+}
@@ -0,0 +1,51 @@
+package authapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/domain/auth"
+	"go-ai/internal/infra/cache"
+	"go-ai/internal/transport/http/status"
+	uilts "go-ai/pkg/utils"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ChangePasswordUseCase struct {
+	repo  auth.Repository
+	cache *cache.AuthCache
+}
+
+func NewChangePasswordUseCase(repo auth.Repository, cache *cache.AuthCache) *ChangePasswordUseCase {
+	return &ChangePasswordUseCase{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// Execute replaces the password after checking the current one and signs the user out everywhere,
+// the calling session included, so a stolen session does not outlive the password change.
+func (uc *ChangePasswordUseCase) Execute(ctx context.Context, userId uuid.UUID, request ChangePasswordRequest) error {
+	if request.NewPassword == "" {
+		return status.ErrInvalidPassword
+	}
+	passwordHash, err := uc.repo.GetPasswordHash(ctx, userId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.ErrNotFound
+		}
+		return err
+	}
+	if !uilts.CheckPasswordHash(request.CurrentPassword, passwordHash) {
+		return auth.ErrPasswordVerifyFail
+	}
+	hashedPassword, err := uilts.HashPassword(request.NewPassword)
+	if err != nil {
+		return status.ErrInternalServerError
+	}
+	if err := uc.repo.UpdatePassword(ctx, userId, hashedPassword); err != nil {
+		return err
+	}
+	return revokeAllSessions(uc.cache, userId)
+}
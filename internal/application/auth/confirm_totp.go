@@ -0,0 +1,63 @@
+package authapp
+
+import (
+	"context"
+	"go-ai/internal/domain/auth"
+	uilts "go-ai/pkg/utils"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const recoveryCodeCount = 10
+
+type ConfirmTOTPUseCase struct {
+	repo auth.Repository
+}
+
+func NewConfirmTOTPUseCase(repo auth.Repository) *ConfirmTOTPUseCase {
+	return &ConfirmTOTPUseCase{
+		repo: repo,
+	}
+}
+
+// Execute enables two-factor once the user proves the authenticator app holds the pending secret.
+// The recovery codes are returned only here; the database keeps their hashes.
+func (uc *ConfirmTOTPUseCase) Execute(ctx context.Context, userId uuid.UUID, request TOTPCodeRequest) (*ConfirmTOTPResponse, error) {
+	totp, err := uc.repo.GetTOTP(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if totp.EnabledAt != nil {
+		return nil, auth.ErrMfaAlreadyEnabled
+	}
+	if totp.Secret == "" {
+		return nil, auth.ErrMfaSetupRequired
+	}
+	step, ok := uilts.ValidateTOTP(totp.Secret, request.Code, time.Now())
+	if !ok {
+		return nil, auth.ErrMfaCodeInvalid
+	}
+	// the code that confirmed the app must not log in as well
+	used, err := uc.repo.UseTOTPStep(ctx, userId, step)
+	if err != nil {
+		return nil, err
+	}
+	if !used {
+		return nil, auth.ErrMfaCodeInvalid
+	}
+	codes, err := uilts.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		hashes = append(hashes, uilts.HashToken(code))
+	}
+	if err := uc.repo.EnableTOTP(ctx, userId, hashes); err != nil {
+		return nil, err
+	}
+	return &ConfirmTOTPResponse{
+		RecoveryCodes: codes,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package authapp
+
+import (
+	"context"
+	"go-ai/internal/domain/auth"
+	uilts "go-ai/pkg/utils"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxAPIKeyDays caps the lifetime a user can give a key; 0 means the key never expires.
+const maxAPIKeyDays = 365
+
+type CreateAPIKeyUseCase struct {
+	repo auth.Repository
+}
+
+func NewCreateAPIKeyUseCase(repo auth.Repository) *CreateAPIKeyUseCase {
+	return &CreateAPIKeyUseCase{
+		repo: repo,
+	}
+}
+
+// Execute issues a key limited to the requested scopes. A scope must be a permission the role of
+// the user holds today, so a key can never grant more than its owner has.
+func (uc *CreateAPIKeyUseCase) Execute(ctx context.Context, userId uuid.UUID, request CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	name := strings.TrimSpace(request.Name)
+	if name == "" {
+		return nil, auth.ErrAPIKeyNameRequired
+	}
+	if request.ExpiresInDays < 0 || request.ExpiresInDays > maxAPIKeyDays {
+		return nil, auth.ErrAPIKeyExpiryInvalid
+	}
+	record, err := uc.repo.GetById(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	granted, err := uc.repo.GetPermissionsByRole(ctx, record.Role)
+	if err != nil {
+		return nil, err
+	}
+	scopes := make([]string, 0, len(request.Scopes))
+	for _, scope := range request.Scopes {
+		if !slices.Contains(granted, scope) {
+			return nil, auth.ErrAPIKeyScopeInvalid
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	if len(scopes) == 0 {
+		return nil, auth.ErrAPIKeyScopeInvalid
+	}
+	var expiresAt *time.Time
+	if request.ExpiresInDays > 0 {
+		t := time.Now().AddDate(0, 0, request.ExpiresInDays)
+		expiresAt = &t
+	}
+	key, prefix, secret, err := uilts.GenerateAPIKey()
+	if err != nil {
+		return nil, auth.ErrTokenGenerateFail
+	}
+	created, err := uc.repo.CreateAPIKey(ctx, &auth.APIKey{
+		UserID:     userId,
+		Name:       name,
+		Prefix:     prefix,
+		SecretHash: uilts.HashToken(secret),
+		Scopes:     scopes,
+		ExpiresAt:  expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &CreateAPIKeyResponse{
+		APIKeyResponse: toAPIKeyResponse(created),
+		Key:            key,
+	}, nil
+}
+
+func toAPIKeyResponse(k *auth.APIKey) APIKeyResponse {
+	return APIKeyResponse{
+		Id:         k.ID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+		CreatedAt:  k.CreatedAt,
+	}
+}
@@ -0,0 +1,50 @@
+package authapp
+
+import (
+	"context"
+	"go-ai/internal/domain/auth"
+	uilts "go-ai/pkg/utils"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DisableTOTPUseCase struct {
+	repo auth.Repository
+}
+
+func NewDisableTOTPUseCase(repo auth.Repository) *DisableTOTPUseCase {
+	return &DisableTOTPUseCase{
+		repo: repo,
+	}
+}
+
+func (uc *DisableTOTPUseCase) Execute(ctx context.Context, userId uuid.UUID, request TOTPCodeRequest) error {
+	totp, err := uc.repo.GetTOTP(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if totp.EnabledAt == nil {
+		return auth.ErrMfaNotEnabled
+	}
+	ok, err := verifySecondFactor(ctx, uc.repo, userId, totp.Secret, request.Code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return auth.ErrMfaCodeInvalid
+	}
+	return uc.repo.DisableTOTP(ctx, userId)
+}
+
+// verifySecondFactor accepts either a current TOTP code or an unused recovery code, consuming the
+// code when it matches: a TOTP code already accepted once is rejected.
+func verifySecondFactor(ctx context.Context, repo auth.Repository, userId uuid.UUID, secret, code string) (bool, error) {
+	if code == "" {
+		return false, nil
+	}
+	if step, ok := uilts.ValidateTOTP(secret, code, time.Now()); ok {
+		return repo.UseTOTPStep(ctx, userId, step)
+	}
+	return repo.UseRecoveryCode(ctx, userId, uilts.HashToken(uilts.NormalizeRecoveryCode(code)))
+}
@@ -1,7 +1,15 @@
 package authapp
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
 type RefreshTokenRequest struct {
 	RefreshToken string `json:"refresh_token"`
+	UserAgent    string `json:"-"`
+	IpAddress    string `json:"-"`
 }
 type RefreshTokenResponse struct {
 	AccessToken  string `json:"access_token"`
@@ -10,14 +18,25 @@ type RefreshTokenResponse struct {
 }
 
 type LoginRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email     string `json:"email"`
+	Password  string `json:"password"`
+	UserAgent string `json:"-"`
+	IpAddress string `json:"-"`
 }
 
 type LoginResponse struct {
-	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
+	AccessToken  string `json:"access_token,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
 	ExpresIn     int    `json:"expires_in"`
+	MfaRequired  bool   `json:"mfa_required,omitempty"`
+	MfaToken     string `json:"mfa_token,omitempty"`
+}
+
+type VerifyMfaRequest struct {
+	MfaToken  string `json:"mfa_token"`
+	Code      string `json:"code"`
+	UserAgent string `json:"-"`
+	IpAddress string `json:"-"`
 }
 
 type RegisterRequest struct {
@@ -37,3 +56,124 @@ type GetProfileResponse struct {
 	Role     string `json:"role"`
 	IsActive bool   `json:"is_active"`
 }
+
+type UpdateProfileRequest struct {
+	Email    string `json:"email"`
+	FullName string `json:"full_name"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+type SetupTOTPResponse struct {
+	Secret     string `json:"secret"`
+	OtpauthUri string `json:"otpauth_uri"`
+}
+
+type TOTPCodeRequest struct {
+	Code string `json:"code"`
+}
+
+type ConfirmTOTPResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type OIDCCallbackRequest struct {
+	Provider  string `json:"-"`
+	Code      string `json:"-"`
+	State     string `json:"-"`
+	UserAgent string `json:"-"`
+	IpAddress string `json:"-"`
+}
+
+type CreateAPIKeyRequest struct {
+	Name          string   `json:"name"`
+	Scopes        []string `json:"scopes"`
+	ExpiresInDays int      `json:"expires_in_days"`
+}
+
+type APIKeyResponse struct {
+	Id         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type CreateAPIKeyResponse struct {
+	APIKeyResponse
+	// Key is the full secret, returned only once.
+	Key string `json:"key"`
+}
+
+type ListAPIKeysResponse struct {
+	ApiKeys []APIKeyResponse `json:"api_keys"`
+}
+
+type SessionResponse struct {
+	Id         string    `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IpAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	Current    bool      `json:"current"`
+}
+
+type ListSessionsResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
+}
+
+type ResendVerificationRequest struct {
+	Email string `json:"email"`
+}
+
+type ListUsersRequest struct {
+	Search   string
+	Role     string
+	IsActive *bool
+	Page     int
+	PageSize int
+}
+
+type AdminUserResponse struct {
+	Id              uuid.UUID  `json:"id"`
+	Email           string     `json:"email"`
+	FullName        string     `json:"full_name"`
+	Role            string     `json:"role"`
+	IsActive        bool       `json:"is_active"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+type ListUsersResponse struct {
+	Users    []AdminUserResponse `json:"users"`
+	Page     int                 `json:"page"`
+	PageSize int                 `json:"page_size"`
+	Total    int64               `json:"total"`
+}
+
+type SetUserStatusRequest struct {
+	IsActive bool `json:"is_active"`
+}
+
+type SetUserRoleRequest struct {
+	Role string `json:"role"`
+}
+
+type ListRolesResponse struct {
+	Roles []string `json:"roles"`
+}
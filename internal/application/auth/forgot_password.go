@@ -0,0 +1,64 @@
+package authapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-ai/internal/config"
+	"go-ai/internal/domain/auth"
+	"go-ai/internal/infra/mail"
+	"go-ai/internal/transport/http/status"
+	uilts "go-ai/pkg/utils"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type ForgotPasswordUseCase struct {
+	repo   auth.Repository
+	mailer mail.Mailer
+}
+
+func NewForgotPasswordUseCase(repo auth.Repository, mailer mail.Mailer) *ForgotPasswordUseCase {
+	return &ForgotPasswordUseCase{
+		repo:   repo,
+		mailer: mailer,
+	}
+}
+
+// Execute mails a single-use reset link to the account. Unknown or inactive emails are accepted
+// silently so the endpoint cannot be used to discover which emails are registered.
+func (uc *ForgotPasswordUseCase) Execute(ctx context.Context, request ForgotPasswordRequest) error {
+	if !strings.Contains(request.Email, "@") {
+		return status.ErrInvalidEmail
+	}
+	config, _ := config.LoadConfig()
+	record, err := uc.repo.GetByEmail(ctx, request.Email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	if !record.IsActive {
+		return nil
+	}
+	token, err := uilts.GenerateOpaqueToken(32)
+	if err != nil {
+		return auth.ErrTokenGenerateFail
+	}
+	expiresIn := time.Duration(config.PasswordResetExpiresIn * int(time.Second))
+	err = uc.repo.CreatePasswordResetToken(ctx, record.ID, uilts.HashToken(token), time.Now().Add(expiresIn))
+	if err != nil {
+		return err
+	}
+	link := fmt.Sprintf("%s/reset-password?token=%s", strings.TrimRight(config.AppBaseUrl, "/"), url.QueryEscape(token))
+	return uc.mailer.Send(ctx, mail.Message{
+		To:      record.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\nUse the link below to choose a new password. It expires in %d minutes.\n\n%s\n\nIf you did not ask for this, you can ignore this email.\n",
+			record.FullName, int(expiresIn.Minutes()), link),
+	})
+}
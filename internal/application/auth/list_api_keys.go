@@ -0,0 +1,32 @@
+package authapp
+
+import (
+	"context"
+	"go-ai/internal/domain/auth"
+
+	"github.com/google/uuid"
+)
+
+type ListAPIKeysUseCase struct {
+	repo auth.Repository
+}
+
+func NewListAPIKeysUseCase(repo auth.Repository) *ListAPIKeysUseCase {
+	return &ListAPIKeysUseCase{
+		repo: repo,
+	}
+}
+
+func (uc *ListAPIKeysUseCase) Execute(ctx context.Context, userId uuid.UUID) (*ListAPIKeysResponse, error) {
+	records, err := uc.repo.ListAPIKeys(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]APIKeyResponse, 0, len(records))
+	for i := range records {
+		keys = append(keys, toAPIKeyResponse(&records[i]))
+	}
+	return &ListAPIKeysResponse{
+		ApiKeys: keys,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package authapp
+
+import (
+	"context"
+	"fmt"
+	"go-ai/internal/domain/auth"
+	"go-ai/internal/infra/cache"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+type ListSessionsUseCase struct {
+	cache *cache.AuthCache
+}
+
+func NewListSessionsUseCase(cache *cache.AuthCache) *ListSessionsUseCase {
+	return &ListSessionsUseCase{
+		cache: cache,
+	}
+}
+
+func (uc *ListSessionsUseCase) Execute(ctx context.Context, userId uuid.UUID, currentSessionId string) (*ListSessionsResponse, error) {
+	if userId == uuid.Nil {
+		return nil, auth.ErrTokenMissing
+	}
+	keySessions := fmt.Sprintf("sessions_%s", userId.String())
+	records, err := uc.cache.GetSessionsCache(keySessions)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].LastUsedAt.After(records[j].LastUsedAt)
+	})
+	sessions := make([]SessionResponse, 0, len(records))
+	for _, record := range records {
+		sessions = append(sessions, SessionResponse{
+			Id:         record.SessionId,
+			UserAgent:  record.UserAgent,
+			IpAddress:  record.IpAddress,
+			CreatedAt:  record.CreatedAt,
+			LastUsedAt: record.LastUsedAt,
+			Current:    record.SessionId == currentSessionId,
+		})
+	}
+	return &ListSessionsResponse{
+		Sessions: sessions,
+	}, nil
+}
@@ -2,6 +2,7 @@ package authapp
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"go-ai/internal/config"
 	"go-ai/internal/domain/auth"
@@ -10,17 +11,22 @@ import (
 
 	uilts "go-ai/pkg/utils"
 	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type LoginUseCase struct {
 	repo  auth.Repository
 	cache *cache.AuthCache
+	keys  *uilts.KeySet
 }
 
-func NewLoginUseCase(repo auth.Repository, cache *cache.AuthCache) *LoginUseCase {
+func NewLoginUseCase(repo auth.Repository, cache *cache.AuthCache, keys *uilts.KeySet) *LoginUseCase {
 	return &LoginUseCase{
 		repo:  repo,
 		cache: cache,
+		keys:  keys,
 	}
 }
 
@@ -35,21 +41,56 @@ func (s *LoginUseCase) Execute(ctx context.Context, request LoginRequest) (*Logi
 		return nil, status.ErrInvalidPassword
 	}
 
+	if err := checkLoginLockout(s.cache, request.Email, request.IpAddress); err != nil {
+		return nil, err
+	}
 	storedUser, err := s.repo.GetByEmail(ctx, request.Email)
 	if err != nil {
-		return nil, err
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		// unknown emails count as failures too, otherwise probing them is free
+		if err := recordLoginFailure(ctx, s.cache, request.Email, request.IpAddress); err != nil {
+			return nil, err
+		}
+		return nil, auth.ErrPasswordVerifyFail
 	}
+	if !uilts.CheckPasswordHash(request.Password, storedUser.Password) {
+		if err := recordLoginFailure(ctx, s.cache, request.Email, request.IpAddress); err != nil {
+			return nil, err
+		}
+		return nil, auth.ErrPasswordVerifyFail
+	}
+	// only after the password, so a disabled account cannot be told apart without it
 	if storedUser.IsActive == false {
 		return nil, status.ErrUserInactive
 	}
-	if !uilts.CheckPasswordHash(request.Password, storedUser.Password) {
-		return nil, auth.ErrPasswordVerifyFail
+	if config.RequireEmailVerified && storedUser.EmailVerifiedAt == nil {
+		return nil, auth.ErrEmailNotVerified
 	}
-	accessToken, err := uilts.GenerateToken(storedUser.ID, storedUser.Email, storedUser.Role, config.JwtAccessSecret, config.JwtExpiresIn)
+	totp, err := s.repo.GetTOTP(ctx, storedUser.ID)
+	if err != nil {
+		return nil, err
+	}
+	// the failures are only cleared once every factor passed, see VerifyMfaUseCase
+	if totp.EnabledAt != nil {
+		return startMfaChallenge(s.cache, storedUser, request.UserAgent, request.IpAddress)
+	}
+	if err := resetLoginFailures(s.cache, request.Email); err != nil {
+		return nil, err
+	}
+	return issueTokens(s.cache, s.keys, storedUser, request.UserAgent, request.IpAddress)
+}
+
+// issueTokens opens a new session for user and returns its access and refresh tokens.
+func issueTokens(authCache *cache.AuthCache, keys *uilts.KeySet, storedUser *auth.Entity, userAgent, ipAddress string) (*LoginResponse, error) {
+	config, _ := config.LoadConfig()
+	sessionId := uuid.NewString()
+	accessToken, err := uilts.GenerateToken(keys, uilts.TokenUseAccess, storedUser.ID, storedUser.Email, storedUser.Role, sessionId, config.JwtExpiresIn)
 	if err != nil {
 		return nil, auth.ErrTokenGenerateFail
 	}
-	refreshToken, err := uilts.GenerateToken(storedUser.ID, storedUser.Email, storedUser.Role, config.JwtRefreshSecret, config.JwtRefreshExpiresIn)
+	refreshToken, err := uilts.GenerateToken(keys, uilts.TokenUseRefresh, storedUser.ID, storedUser.Email, storedUser.Role, sessionId, config.JwtRefreshExpiresIn)
 	if err != nil {
 		return nil, auth.ErrTokenGenerateFail
 	}
@@ -61,12 +102,52 @@ func (s *LoginUseCase) Execute(ctx context.Context, request LoginRequest) (*Logi
 		FullName: storedUser.FullName,
 	}
 	keyAuthCache := fmt.Sprintf("profile_%s", storedUser.ID.String())
-	s.cache.SetAuthCache(keyAuthCache, dataCache, time.Duration(config.JwtExpiresIn*int(time.Second)))
-	keyRefreshToken := fmt.Sprintf("refresh_token_%s", storedUser.ID.String())
-	s.cache.SetRefreshTokenCache(keyRefreshToken, refreshToken, time.Duration(config.JwtRefreshExpiresIn*int(time.Second)))
+	authCache.SetAuthCache(keyAuthCache, dataCache, time.Duration(config.JwtExpiresIn*int(time.Second)))
+	now := time.Now()
+	session := &cache.SessionData{
+		SessionId:        sessionId,
+		UserId:           storedUser.ID,
+		RefreshTokenHash: uilts.HashToken(refreshToken),
+		UserAgent:        userAgent,
+		IpAddress:        ipAddress,
+		CreatedAt:        now,
+		LastUsedAt:       now,
+	}
+	keySessions := fmt.Sprintf("sessions_%s", storedUser.ID.String())
+	keySession := fmt.Sprintf("session_%s_%s", storedUser.ID.String(), sessionId)
+	err = authCache.SetSessionCache(keySessions, keySession, session, time.Duration(config.JwtRefreshExpiresIn*int(time.Second)))
+	if err != nil {
+		return nil, err
+	}
 	return &LoginResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
 		ExpresIn:     config.JwtExpiresIn,
 	}, nil
 }
+
+// startMfaChallenge parks a login that passed the password check until the second factor is
+// verified, and returns the challenge token instead of the real token pair.
+func startMfaChallenge(authCache *cache.AuthCache, storedUser *auth.Entity, userAgent, ipAddress string) (*LoginResponse, error) {
+	config, _ := config.LoadConfig()
+	token, err := uilts.GenerateOpaqueToken(32)
+	if err != nil {
+		return nil, auth.ErrTokenGenerateFail
+	}
+	challenge := &cache.MfaChallengeData{
+		UserId:    storedUser.ID,
+		Email:     storedUser.Email,
+		UserAgent: userAgent,
+		IpAddress: ipAddress,
+	}
+	key := fmt.Sprintf("mfa_challenge_%s", uilts.HashToken(token))
+	err = authCache.SetMfaChallengeCache(key, challenge, time.Duration(config.MfaChallengeExpiresIn*int(time.Second)))
+	if err != nil {
+		return nil, err
+	}
+	return &LoginResponse{
+		MfaRequired: true,
+		MfaToken:    token,
+		ExpresIn:    config.MfaChallengeExpiresIn,
+	}, nil
+}
@@ -0,0 +1,117 @@
+package authapp
+
+import (
+	"context"
+	"fmt"
+	"go-ai/internal/config"
+	"go-ai/internal/domain/auth"
+	"go-ai/internal/infra/cache"
+	"go-ai/internal/infra/metrics"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Failed logins are counted per email and per client IP. Each failure is answered after a delay
+// that doubles with the email counter, and once a counter reaches its limit every login for that
+// key is refused until the lockout expires.
+
+func loginEmailKey(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// checkLoginLockout returns a *auth.LockoutError when the email or the IP is currently locked.
+func checkLoginLockout(authCache *cache.AuthCache, email, ipAddress string) error {
+	scopes := map[string]string{
+		"email": fmt.Sprintf("login_lock_email_%s", loginEmailKey(email)),
+	}
+	if ipAddress != "" {
+		scopes["ip"] = fmt.Sprintf("login_lock_ip_%s", ipAddress)
+	}
+	var retryAfter time.Duration
+	var scope string
+	for s, key := range scopes {
+		ttl, err := authCache.GetLockoutCache(key)
+		if err != nil {
+			return err
+		}
+		if ttl > retryAfter {
+			retryAfter, scope = ttl, s
+		}
+	}
+	if retryAfter > 0 {
+		metrics.LoginRejectedLocked.WithLabelValues(scope).Inc()
+		return &auth.LockoutError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// recordLoginFailure counts a failed attempt, waits the progressive delay and locks the email or
+// IP when its limit is reached. It returns the lockout error it created, or nil.
+func recordLoginFailure(ctx context.Context, authCache *cache.AuthCache, email, ipAddress string) error {
+	config, _ := config.LoadConfig()
+	metrics.LoginFailures.Inc()
+	window := time.Duration(config.LoginAttemptWindow * int(time.Second))
+	lockout := time.Duration(config.LoginLockoutDuration * int(time.Second))
+	emailKey := loginEmailKey(email)
+
+	emailFailures, err := authCache.IncrLoginFailureCache(fmt.Sprintf("login_fail_email_%s", emailKey), window)
+	if err != nil {
+		return err
+	}
+	var ipFailures int64
+	if ipAddress != "" {
+		ipFailures, err = authCache.IncrLoginFailureCache(fmt.Sprintf("login_fail_ip_%s", ipAddress), window)
+		if err != nil {
+			return err
+		}
+	}
+
+	var locked error
+	if emailFailures >= int64(config.LoginMaxAttemptsEmail) {
+		if err := lockLogin(ctx, authCache, "email", fmt.Sprintf("login_lock_email_%s", emailKey), fmt.Sprintf("login_fail_email_%s", emailKey), lockout); err != nil {
+			return err
+		}
+		locked = &auth.LockoutError{RetryAfter: lockout}
+	}
+	if ipAddress != "" && ipFailures >= int64(config.LoginMaxAttemptsIp) {
+		if err := lockLogin(ctx, authCache, "ip", fmt.Sprintf("login_lock_ip_%s", ipAddress), fmt.Sprintf("login_fail_ip_%s", ipAddress), lockout); err != nil {
+			return err
+		}
+		locked = &auth.LockoutError{RetryAfter: lockout}
+	}
+	if locked != nil {
+		return locked
+	}
+
+	delay := time.Duration(config.LoginDelayBaseMs) * time.Millisecond << (emailFailures - 1)
+	if maxDelay := time.Duration(config.LoginDelayMaxMs) * time.Millisecond; delay > maxDelay || delay <= 0 {
+		delay = maxDelay
+	}
+	select {
+	case <-time.After(delay):
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+func lockLogin(ctx context.Context, authCache *cache.AuthCache, scope, lockKey, failKey string, ttl time.Duration) error {
+	if err := authCache.SetLockoutCache(lockKey, ttl); err != nil {
+		return err
+	}
+	// the counter starts over once the lock expires
+	if err := authCache.DeleteAuthCache(failKey); err != nil {
+		return err
+	}
+	metrics.LoginLockouts.WithLabelValues(scope).Inc()
+	zerolog.Ctx(ctx).Warn().Str("event", "login_lockout").Str("scope", scope).Str("key", lockKey).Msg("login locked after repeated failures")
+	return nil
+}
+
+// resetLoginFailures clears the email counter after a successful login, second factor included.
+// The IP counter is left alone so one valid account cannot be used to reset the budget of an
+// attacking address.
+func resetLoginFailures(authCache *cache.AuthCache, email string) error {
+	return authCache.DeleteAuthCache(fmt.Sprintf("login_fail_email_%s", loginEmailKey(email)))
+}
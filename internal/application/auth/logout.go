@@ -0,0 +1,41 @@
+package authapp
+
+import (
+	"context"
+	"fmt"
+	"go-ai/internal/domain/auth"
+	"go-ai/internal/infra/cache"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LogoutUseCase struct {
+	cache *cache.AuthCache
+}
+
+func NewLogoutUseCase(cache *cache.AuthCache) *LogoutUseCase {
+	return &LogoutUseCase{
+		cache: cache,
+	}
+}
+
+// Execute revokes the access token used for the request and ends the session it belongs to.
+func (uc *LogoutUseCase) Execute(ctx context.Context, userId uuid.UUID, sessionId string, tokenId string, expiresAt time.Time) error {
+	if userId == uuid.Nil || tokenId == "" {
+		return auth.ErrTokenMissing
+	}
+	keyRevokedToken := fmt.Sprintf("revoked_token_%s", tokenId)
+	if err := uc.cache.SetRevokedTokenCache(keyRevokedToken, time.Until(expiresAt)); err != nil {
+		return err
+	}
+	if sessionId == "" {
+		return nil
+	}
+	keySessions := fmt.Sprintf("sessions_%s", userId.String())
+	keySession := fmt.Sprintf("session_%s_%s", userId.String(), sessionId)
+	if err := uc.cache.DeleteSessionCache(keySessions, keySession); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package authapp
+
+import (
+	"context"
+	"fmt"
+	"go-ai/internal/config"
+	"go-ai/internal/domain/auth"
+	"go-ai/internal/infra/cache"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LogoutAllUseCase struct {
+	cache *cache.AuthCache
+}
+
+func NewLogoutAllUseCase(cache *cache.AuthCache) *LogoutAllUseCase {
+	return &LogoutAllUseCase{
+		cache: cache,
+	}
+}
+
+// Execute ends every session of the user on every device.
+func (uc *LogoutAllUseCase) Execute(ctx context.Context, userId uuid.UUID) error {
+	if userId == uuid.Nil {
+		return auth.ErrTokenMissing
+	}
+	return revokeAllSessions(uc.cache, userId)
+}
+
+// revokeAllSessions makes the auth middleware reject every token issued to the user so far and
+// removes the sessions and cached profile of the user.
+func revokeAllSessions(authCache *cache.AuthCache, userId uuid.UUID) error {
+	config, _ := config.LoadConfig()
+	keyRevokedBefore := fmt.Sprintf("revoked_before_%s", userId.String())
+	err := authCache.SetRevokedBeforeCache(keyRevokedBefore, time.Now(), time.Duration(config.JwtRefreshExpiresIn*int(time.Second)))
+	if err != nil {
+		return err
+	}
+	keySessions := fmt.Sprintf("sessions_%s", userId.String())
+	if err := authCache.DeleteSessionsCache(keySessions); err != nil {
+		return err
+	}
+	keyAuthCache := fmt.Sprintf("profile_%s", userId.String())
+	if err := authCache.DeleteAuthCache(keyAuthCache); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package authapp
+
+import (
+	"context"
+	"fmt"
+	"go-ai/internal/domain/auth"
+	"go-ai/internal/infra/cache"
+	"go-ai/internal/infra/oidc"
+	uilts "go-ai/pkg/utils"
+	"time"
+)
+
+// oidcStateTTL is how long the user has to finish the login at the provider.
+const oidcStateTTL = 10 * time.Minute
+
+type OIDCAuthorizeUseCase struct {
+	cache     *cache.AuthCache
+	providers map[string]*oidc.Provider
+}
+
+func NewOIDCAuthorizeUseCase(cache *cache.AuthCache, providers map[string]*oidc.Provider) *OIDCAuthorizeUseCase {
+	return &OIDCAuthorizeUseCase{
+		cache:     cache,
+		providers: providers,
+	}
+}
+
+// Execute starts the authorization code flow and returns the provider URL to send the browser to.
+// The state, nonce and PKCE verifier stay in Redis until the callback consumes them.
+func (uc *OIDCAuthorizeUseCase) Execute(ctx context.Context, providerName string) (string, error) {
+	provider, ok := uc.providers[providerName]
+	if !ok {
+		return "", auth.ErrOIDCProviderNotFound
+	}
+	state, err := uilts.GenerateOpaqueToken(32)
+	if err != nil {
+		return "", auth.ErrTokenGenerateFail
+	}
+	nonce, err := uilts.GenerateOpaqueToken(32)
+	if err != nil {
+		return "", auth.ErrTokenGenerateFail
+	}
+	verifier, err := uilts.GenerateOpaqueToken(32)
+	if err != nil {
+		return "", auth.ErrTokenGenerateFail
+	}
+	authURL, err := provider.AuthCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		return "", err
+	}
+	stateData := &cache.OIDCStateData{
+		Provider:     providerName,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+	}
+	if err := uc.cache.SetOIDCStateCache(fmt.Sprintf("oidc_state_%s", uilts.HashToken(state)), stateData, oidcStateTTL); err != nil {
+		return "", err
+	}
+	return authURL, nil
+}
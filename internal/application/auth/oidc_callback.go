@@ -0,0 +1,157 @@
+package authapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-ai/internal/config"
+	"go-ai/internal/domain/auth"
+	"go-ai/internal/infra/cache"
+	"go-ai/internal/infra/oidc"
+	"go-ai/internal/transport/http/status"
+	uilts "go-ai/pkg/utils"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+)
+
+type OIDCCallbackUseCase struct {
+	repo      auth.Repository
+	cache     *cache.AuthCache
+	keys      *uilts.KeySet
+	providers map[string]*oidc.Provider
+}
+
+func NewOIDCCallbackUseCase(repo auth.Repository, cache *cache.AuthCache, keys *uilts.KeySet, providers map[string]*oidc.Provider) *OIDCCallbackUseCase {
+	return &OIDCCallbackUseCase{
+		repo:      repo,
+		cache:     cache,
+		keys:      keys,
+		providers: providers,
+	}
+}
+
+// Execute finishes the authorization code flow and logs the user in like LoginUseCase does. The
+// external account is matched by its subject first; an unknown subject is linked to the user with
+// the same email when both the provider and this service verified that email, otherwise a new user
+// is created.
+func (uc *OIDCCallbackUseCase) Execute(ctx context.Context, request OIDCCallbackRequest) (*LoginResponse, error) {
+	config, _ := config.LoadConfig()
+	provider, ok := uc.providers[request.Provider]
+	if !ok {
+		return nil, auth.ErrOIDCProviderNotFound
+	}
+	if request.State == "" || request.Code == "" {
+		return nil, auth.ErrOIDCStateInvalid
+	}
+	state, err := uc.cache.TakeOIDCStateCache(fmt.Sprintf("oidc_state_%s", uilts.HashToken(request.State)))
+	if err != nil {
+		return nil, err
+	}
+	if state == nil || state.Provider != request.Provider {
+		return nil, auth.ErrOIDCStateInvalid
+	}
+	rawIDToken, err := provider.Exchange(ctx, request.Code, state.CodeVerifier)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Str("provider", request.Provider).Msg("oidc code exchange failed")
+		return nil, auth.ErrOIDCLoginFailed
+	}
+	claims, err := provider.VerifyIDToken(ctx, rawIDToken, state.Nonce)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Str("provider", request.Provider).Msg("oidc id token rejected")
+		return nil, auth.ErrOIDCLoginFailed
+	}
+
+	storedUser, err := uc.resolveUser(ctx, request.Provider, claims)
+	if err != nil {
+		return nil, err
+	}
+	if !storedUser.IsActive {
+		return nil, status.ErrUserInactive
+	}
+	if config.RequireEmailVerified && storedUser.EmailVerifiedAt == nil {
+		return nil, auth.ErrEmailNotVerified
+	}
+	totp, err := uc.repo.GetTOTP(ctx, storedUser.ID)
+	if err != nil {
+		return nil, err
+	}
+	if totp.EnabledAt != nil {
+		return startMfaChallenge(uc.cache, storedUser, request.UserAgent, request.IpAddress)
+	}
+	return issueTokens(uc.cache, uc.keys, storedUser, request.UserAgent, request.IpAddress)
+}
+
+func (uc *OIDCCallbackUseCase) resolveUser(ctx context.Context, providerName string, claims *oidc.IDTokenClaims) (*auth.Entity, error) {
+	identity, err := uc.repo.GetIdentity(ctx, providerName, claims.Subject)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+	if identity != nil {
+		if err := uc.repo.TouchIdentity(ctx, identity.ID, claims.Email); err != nil {
+			return nil, err
+		}
+		return uc.repo.GetById(ctx, identity.UserID)
+	}
+
+	email := strings.TrimSpace(claims.Email)
+	if email == "" {
+		return nil, auth.ErrOIDCEmailRequired
+	}
+	newIdentity := &auth.Identity{
+		Provider: providerName,
+		Subject:  claims.Subject,
+		Email:    email,
+	}
+	existing, err := uc.repo.GetByEmail(ctx, email)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+	if existing != nil {
+		// linking on an unverified email would let anyone who registers that address at the
+		// provider take over the account
+		if !claims.IsEmailVerified() {
+			return nil, status.ErrEmailAlreadyExists
+		}
+		// nor on an address nobody proved to own here: whoever registered it with a password
+		// before the real owner signs in with the provider would keep a way into the account
+		if existing.EmailVerifiedAt == nil {
+			return nil, auth.ErrOIDCAccountUnverified
+		}
+		newIdentity.UserID = existing.ID
+		if err := uc.repo.LinkIdentity(ctx, newIdentity); err != nil {
+			return nil, err
+		}
+		return existing, nil
+	}
+
+	// the account has no usable password until the user sets one through the reset flow
+	randomPassword, err := uilts.GenerateOpaqueToken(32)
+	if err != nil {
+		return nil, auth.ErrTokenGenerateFail
+	}
+	hashedPassword, err := uilts.HashPassword(randomPassword)
+	if err != nil {
+		return nil, status.ErrInternalServerError
+	}
+	fullName := strings.TrimSpace(claims.Name)
+	if fullName == "" {
+		fullName, _, _ = strings.Cut(email, "@")
+	}
+	newUser := &auth.Entity{
+		FullName: fullName,
+		Email:    email,
+		Password: hashedPassword,
+	}
+	if claims.IsEmailVerified() {
+		now := time.Now()
+		newUser.EmailVerifiedAt = &now
+	}
+	id, err := uc.repo.CreateUserWithIdentity(ctx, newUser, newIdentity)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.GetById(ctx, id)
+}
@@ -0,0 +1,285 @@
+package authapp
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"go-ai/internal/domain/auth"
+	"go-ai/internal/infra/cache"
+	"go-ai/internal/infra/oidc"
+	uilts "go-ai/pkg/utils"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+// memoryRepo keeps the users and identities the OIDC callback reads and writes. The other methods
+// of auth.Repository are not used by the flow and panic.
+type memoryRepo struct {
+	auth.Repository
+	users      map[uuid.UUID]*auth.Entity
+	identities []auth.Identity
+}
+
+func (r *memoryRepo) GetById(ctx context.Context, id uuid.UUID) (*auth.Entity, error) {
+	u, ok := r.users[id]
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+	return u, nil
+}
+
+func (r *memoryRepo) GetByEmail(ctx context.Context, email string) (*auth.Entity, error) {
+	for _, u := range r.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return nil, pgx.ErrNoRows
+}
+
+func (r *memoryRepo) GetIdentity(ctx context.Context, provider, subject string) (*auth.Identity, error) {
+	for i := range r.identities {
+		if r.identities[i].Provider == provider && r.identities[i].Subject == subject {
+			return &r.identities[i], nil
+		}
+	}
+	return nil, pgx.ErrNoRows
+}
+
+func (r *memoryRepo) LinkIdentity(ctx context.Context, identity *auth.Identity) error {
+	r.identities = append(r.identities, *identity)
+	return nil
+}
+
+func (r *memoryRepo) TouchIdentity(ctx context.Context, id int64, email string) error {
+	return nil
+}
+
+func (r *memoryRepo) CreateUserWithIdentity(ctx context.Context, u *auth.Entity, identity *auth.Identity) (uuid.UUID, error) {
+	u.ID = uuid.New()
+	u.Role = "user"
+	u.IsActive = true
+	r.users[u.ID] = u
+	identity.UserID = u.ID
+	r.identities = append(r.identities, *identity)
+	return u.ID, nil
+}
+
+func (r *memoryRepo) GetTOTP(ctx context.Context, userID uuid.UUID) (*auth.TOTP, error) {
+	return &auth.TOTP{}, nil
+}
+
+func (r *memoryRepo) addUser(email string, verified bool) *auth.Entity {
+	u := &auth.Entity{
+		ID:       uuid.New(),
+		FullName: "Local User",
+		Email:    email,
+		Role:     "user",
+		IsActive: true,
+	}
+	if verified {
+		now := time.Now()
+		u.EmailVerifiedAt = &now
+	}
+	r.users[u.ID] = u
+	return u
+}
+
+// fakeProvider is the provider side of the code flow: discovery, keys and a token endpoint that
+// answers the codes handed out by issue with an ID token signed by key.
+type fakeProvider struct {
+	issuer string
+	key    *rsa.PrivateKey
+
+	mu    sync.Mutex
+	codes map[string]jwt.MapClaims
+}
+
+func newFakeProvider(t *testing.T) *fakeProvider {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &fakeProvider{key: key, codes: map[string]jwt.MapClaims{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 p.issuer,
+			"authorization_endpoint": p.issuer + "/authorize",
+			"token_endpoint":         p.issuer + "/token",
+			"jwks_uri":               p.issuer + "/jwks",
+		})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		p.mu.Lock()
+		claims, ok := p.codes[r.FormValue("code")]
+		p.mu.Unlock()
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = "test"
+		idToken, err := token.SignedString(p.key)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"id_token": idToken})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "test",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+			}},
+		})
+	})
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	p.issuer = ts.URL
+	return p
+}
+
+// issue returns a code the token endpoint exchanges for an ID token of subject with a verified
+// email.
+func (p *fakeProvider) issue(subject, email, nonce string) string {
+	now := time.Now()
+	code := uuid.NewString()
+	p.mu.Lock()
+	p.codes[code] = jwt.MapClaims{
+		"iss":            p.issuer,
+		"sub":            subject,
+		"aud":            "go-ai",
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Minute).Unix(),
+		"email":          email,
+		"email_verified": true,
+		"nonce":          nonce,
+	}
+	p.mu.Unlock()
+	return code
+}
+
+type oidcFlow struct {
+	provider  *fakeProvider
+	authorize *OIDCAuthorizeUseCase
+	callback  *OIDCCallbackUseCase
+	repo      *memoryRepo
+}
+
+// newOIDCFlow wires the authorize and callback use cases to a fake provider, with Redis replaced by
+// miniredis and the database by memoryRepo.
+func newOIDCFlow(t *testing.T) *oidcFlow {
+	t.Helper()
+	provider := newFakeProvider(t)
+	providers := map[string]*oidc.Provider{
+		"fake": oidc.NewProvider(oidc.ProviderConfig{
+			Name:        "fake",
+			Issuer:      provider.issuer,
+			ClientID:    "go-ai",
+			RedirectURL: "http://localhost:8080/api/auth/oidc/fake/callback",
+			Scopes:      []string{"openid", "email", "profile"},
+		}),
+	}
+	authCache := cache.NewAuthCache(redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()}))
+	keys, err := uilts.NewEphemeralKeySet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := &memoryRepo{users: map[uuid.UUID]*auth.Entity{}}
+	return &oidcFlow{
+		provider:  provider,
+		authorize: NewOIDCAuthorizeUseCase(authCache, providers),
+		callback:  NewOIDCCallbackUseCase(repo, authCache, keys, providers),
+		repo:      repo,
+	}
+}
+
+// login starts the flow, lets the provider sign in subject with email and hands the code to the
+// callback along with the state of the authorization request.
+func (f *oidcFlow) login(t *testing.T, subject, email string) (*LoginResponse, error) {
+	t.Helper()
+	ctx := context.Background()
+	authURL, err := f.authorize.Execute(ctx, "fake")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := u.Query()
+	return f.callback.Execute(ctx, OIDCCallbackRequest{
+		Provider: "fake",
+		Code:     f.provider.issue(subject, email, q.Get("nonce")),
+		State:    q.Get("state"),
+	})
+}
+
+func TestOIDCCallbackCreatesUser(t *testing.T) {
+	f := newOIDCFlow(t)
+	resp, err := f.login(t, "sub-new", "new.user@example.com")
+	if err != nil {
+		t.Fatalf("callback: %v", err)
+	}
+	if resp.AccessToken == "" || resp.RefreshToken == "" {
+		t.Fatal("callback returned no tokens")
+	}
+	u, err := f.repo.GetByEmail(context.Background(), "new.user@example.com")
+	if err != nil {
+		t.Fatalf("user was not created: %v", err)
+	}
+	if u.EmailVerifiedAt == nil {
+		t.Error("email verified by the provider is not marked verified")
+	}
+}
+
+func TestOIDCCallbackLinksVerifiedAccount(t *testing.T) {
+	f := newOIDCFlow(t)
+	local := f.repo.addUser("owner@example.com", true)
+	resp, err := f.login(t, "sub-owner", "owner@example.com")
+	if err != nil {
+		t.Fatalf("callback: %v", err)
+	}
+	if resp.AccessToken == "" {
+		t.Fatal("callback returned no tokens")
+	}
+	if len(f.repo.identities) != 1 || f.repo.identities[0].UserID != local.ID {
+		t.Fatalf("identities = %+v, want one linked to %s", f.repo.identities, local.ID)
+	}
+}
+
+func TestOIDCCallbackRefusesUnverifiedAccount(t *testing.T) {
+	f := newOIDCFlow(t)
+	// someone registered the address with a password and never confirmed it
+	f.repo.addUser("victim@example.com", false)
+	_, err := f.login(t, "sub-victim", "victim@example.com")
+	if !errors.Is(err, auth.ErrOIDCAccountUnverified) {
+		t.Fatalf("callback error = %v, want %v", err, auth.ErrOIDCAccountUnverified)
+	}
+	if len(f.repo.identities) != 0 {
+		t.Fatalf("identities = %+v, want none linked", f.repo.identities)
+	}
+	if len(f.repo.users) != 1 {
+		t.Fatalf("users = %d, want the existing one only", len(f.repo.users))
+	}
+}
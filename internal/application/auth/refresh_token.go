@@ -2,6 +2,7 @@ package authapp
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"go-ai/internal/config"
 	"go-ai/internal/domain/auth"
@@ -11,17 +12,21 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
 )
 
 type RefreshTokenUseCase struct {
 	repo  auth.Repository
 	cache *cache.AuthCache
+	keys  *uilts.KeySet
 }
 
-func NewRefreshTokenUseCase(repo auth.Repository, cache *cache.AuthCache) *RefreshTokenUseCase {
+func NewRefreshTokenUseCase(repo auth.Repository, cache *cache.AuthCache, keys *uilts.KeySet) *RefreshTokenUseCase {
 	return &RefreshTokenUseCase{
 		repo:  repo,
 		cache: cache,
+		keys:  keys,
 	}
 }
 
@@ -30,7 +35,7 @@ func (uc *RefreshTokenUseCase) Execute(ctx context.Context, request RefreshToken
 		return nil, auth.ErrTokenInvalid
 	}
 	config, _ := config.LoadConfig()
-	claims, err := utils.VerifyToken(request.RefreshToken, config.JwtRefreshSecret)
+	claims, err := utils.VerifyToken(uc.keys, utils.TokenUseRefresh, request.RefreshToken)
 	if err != nil {
 		return nil, auth.ErrTokenNotActive
 	}
@@ -46,25 +51,41 @@ func (uc *RefreshTokenUseCase) Execute(ctx context.Context, request RefreshToken
 	if role == "" {
 		return nil, auth.ErrTokenMissing
 	}
-	keyRefreshToken := fmt.Sprintf("refresh_token_%s", userId)
-	cachedRefreshToken, err := uc.cache.GetRefreshTokenCache(keyRefreshToken)
+	sessionId := claims.SessionId
+	if sessionId == "" {
+		return nil, auth.ErrTokenMissing
+	}
+	keySessions := fmt.Sprintf("sessions_%s", userId.String())
+	keySession := fmt.Sprintf("session_%s_%s", userId.String(), sessionId)
+	session, err := uc.cache.GetSessionCache(keySession)
 	if err != nil {
 		return nil, err
 	}
-	if cachedRefreshToken != request.RefreshToken {
-		return nil, auth.ErrTokenMalformed
+	if session == nil {
+		return nil, auth.ErrSessionNotFound
 	}
-	accessToken, err := uilts.GenerateToken(userId, email, role, config.JwtAccessSecret, config.JwtExpiresIn)
+	// The session is the rotation family of the refresh token. A correctly signed token of the
+	// family that is not the latest one has already been rotated, so someone is replaying it:
+	// revoke the whole family and make both the thief and the legitimate client log in again.
+	tokenHash := uilts.HashToken(request.RefreshToken)
+	if session.RefreshTokenHash != tokenHash {
+		return nil, uc.revokeFamily(ctx, request, claims, keySessions, keySession)
+	}
+	// the email claim goes stale when the user changes their email, the new tokens carry the stored one
+	record, err := uc.repo.GetById(ctx, userId)
 	if err != nil {
-		return nil, auth.ErrTokenGenerateFail
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, auth.ErrSessionNotFound
+		}
+		return nil, err
 	}
-	refreshToken, err := uilts.GenerateToken(userId, email, role, config.JwtRefreshSecret, config.JwtRefreshExpiresIn)
+	accessToken, err := uilts.GenerateToken(uc.keys, uilts.TokenUseAccess, userId, record.Email, record.Role, sessionId, config.JwtExpiresIn)
 	if err != nil {
 		return nil, auth.ErrTokenGenerateFail
 	}
-	record, err := uc.repo.GetByEmail(ctx, email)
+	refreshToken, err := uilts.GenerateToken(uc.keys, uilts.TokenUseRefresh, userId, record.Email, record.Role, sessionId, config.JwtRefreshExpiresIn)
 	if err != nil {
-		return nil, err
+		return nil, auth.ErrTokenGenerateFail
 	}
 	dataCache := &cache.AuthData{
 		UserId:   record.ID,
@@ -75,7 +96,23 @@ func (uc *RefreshTokenUseCase) Execute(ctx context.Context, request RefreshToken
 	}
 	keyAuthCache := fmt.Sprintf("profile_%s", record.ID.String())
 	uc.cache.SetAuthCache(keyAuthCache, dataCache, time.Duration(config.JwtExpiresIn*int(time.Second)))
-	uc.cache.SetRefreshTokenCache(keyRefreshToken, refreshToken, time.Duration(config.JwtRefreshExpiresIn*int(time.Second)))
+	session.RefreshTokenHash = uilts.HashToken(refreshToken)
+	session.LastUsedAt = time.Now()
+	if request.UserAgent != "" {
+		session.UserAgent = request.UserAgent
+	}
+	if request.IpAddress != "" {
+		session.IpAddress = request.IpAddress
+	}
+	// the token is consumed by swapping the hash atomically: of two concurrent refreshes with the
+	// same token only one rotates, the other one is a reuse
+	rotated, err := uc.cache.RotateSessionCache(keySessions, keySession, tokenHash, session, time.Duration(config.JwtRefreshExpiresIn*int(time.Second)))
+	if err != nil {
+		return nil, err
+	}
+	if !rotated {
+		return nil, uc.revokeFamily(ctx, request, claims, keySessions, keySession)
+	}
 	return &RefreshTokenResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
@@ -83,3 +120,19 @@ func (uc *RefreshTokenUseCase) Execute(ctx context.Context, request RefreshToken
 	}, nil
 
 }
+
+// revokeFamily deletes the session a replayed refresh token belongs to and reports the reuse.
+func (uc *RefreshTokenUseCase) revokeFamily(ctx context.Context, request RefreshTokenRequest, claims *uilts.JWTClaims, keySessions string, keySession string) error {
+	if err := uc.cache.DeleteSessionCache(keySessions, keySession); err != nil {
+		return err
+	}
+	zerolog.Ctx(ctx).Warn().
+		Str("event", "refresh_token_reuse").
+		Str("user_id", claims.UserId.String()).
+		Str("session_id", claims.SessionId).
+		Str("token_id", claims.ID).
+		Str("ip_address", request.IpAddress).
+		Str("user_agent", request.UserAgent).
+		Msg("rotated refresh token presented again, session family revoked")
+	return auth.ErrRefreshTokenReused
+}
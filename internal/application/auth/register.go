@@ -6,22 +6,26 @@ import (
 	"errors"
 	"go-ai/internal/domain/auth"
 	"go-ai/internal/infra/cache"
+	"go-ai/internal/infra/mail"
 	"go-ai/internal/transport/http/status"
 	uilts "go-ai/pkg/utils"
 	"strings"
 
 	"github.com/google/uuid"
+	"github.com/rs/zerolog"
 )
 
 type RegisterUseCase struct {
-	repo  auth.Repository
-	cache *cache.AuthCache
+	repo   auth.Repository
+	cache  *cache.AuthCache
+	mailer mail.Mailer
 }
 
-func NewRegisterUseCase(repo auth.Repository, cache *cache.AuthCache) *RegisterUseCase {
+func NewRegisterUseCase(repo auth.Repository, cache *cache.AuthCache, mailer mail.Mailer) *RegisterUseCase {
 	return &RegisterUseCase{
-		repo:  repo,
-		cache: cache,
+		repo:   repo,
+		cache:  cache,
+		mailer: mailer,
 	}
 }
 
@@ -58,9 +62,18 @@ func (s *RegisterUseCase) Execute(ctx context.Context, request RegisterRequest)
 	if err != nil {
 		return uuid.Nil, status.ErrInternalServerError
 	}
-	return s.repo.CreateUser(ctx, &auth.Entity{
+	id, err := s.repo.CreateUser(ctx, &auth.Entity{
 		FullName: request.FullName,
 		Email:    request.Email,
 		Password: hasedPassword,
 	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	// The account exists at this point; a mail failure must not fail the registration because the
+	// user can ask for the link again.
+	if err := sendVerificationEmail(ctx, s.repo, s.mailer, id, request.Email, request.FullName); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Str("user_id", id.String()).Msg("failed to send verification email")
+	}
+	return id, nil
 }
@@ -0,0 +1,43 @@
+package authapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/domain/auth"
+	"go-ai/internal/infra/mail"
+	"go-ai/internal/transport/http/status"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type ResendVerificationUseCase struct {
+	repo   auth.Repository
+	mailer mail.Mailer
+}
+
+func NewResendVerificationUseCase(repo auth.Repository, mailer mail.Mailer) *ResendVerificationUseCase {
+	return &ResendVerificationUseCase{
+		repo:   repo,
+		mailer: mailer,
+	}
+}
+
+// Execute sends a fresh verification link. Like the forgot password flow it does not reveal
+// whether the email is registered or already verified.
+func (uc *ResendVerificationUseCase) Execute(ctx context.Context, request ResendVerificationRequest) error {
+	if !strings.Contains(request.Email, "@") {
+		return status.ErrInvalidEmail
+	}
+	record, err := uc.repo.GetByEmail(ctx, request.Email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	if record.EmailVerifiedAt != nil {
+		return nil
+	}
+	return sendVerificationEmail(ctx, uc.repo, uc.mailer, record.ID, record.Email, record.FullName)
+}
@@ -0,0 +1,54 @@
+package authapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/domain/auth"
+	"go-ai/internal/infra/cache"
+	"go-ai/internal/transport/http/status"
+	uilts "go-ai/pkg/utils"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type ResetPasswordUseCase struct {
+	repo  auth.Repository
+	cache *cache.AuthCache
+}
+
+func NewResetPasswordUseCase(repo auth.Repository, cache *cache.AuthCache) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// Execute sets a new password with a token from ForgotPasswordUseCase and logs the user out of
+// every device.
+func (uc *ResetPasswordUseCase) Execute(ctx context.Context, request ResetPasswordRequest) error {
+	if request.Token == "" {
+		return auth.ErrResetTokenInvalid
+	}
+	if request.NewPassword == "" {
+		return status.ErrInvalidPassword
+	}
+	record, err := uc.repo.GetPasswordResetToken(ctx, uilts.HashToken(request.Token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return auth.ErrResetTokenInvalid
+		}
+		return err
+	}
+	if record.UsedAt != nil || time.Now().After(record.ExpiresAt) {
+		return auth.ErrResetTokenInvalid
+	}
+	hashedPassword, err := uilts.HashPassword(request.NewPassword)
+	if err != nil {
+		return status.ErrInternalServerError
+	}
+	if err := uc.repo.ResetPassword(ctx, record.ID, record.UserID, hashedPassword); err != nil {
+		return err
+	}
+	return revokeAllSessions(uc.cache, record.UserID)
+}
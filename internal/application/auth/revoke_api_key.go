@@ -0,0 +1,23 @@
+package authapp
+
+import (
+	"context"
+	"go-ai/internal/domain/auth"
+
+	"github.com/google/uuid"
+)
+
+type RevokeAPIKeyUseCase struct {
+	repo auth.Repository
+}
+
+func NewRevokeAPIKeyUseCase(repo auth.Repository) *RevokeAPIKeyUseCase {
+	return &RevokeAPIKeyUseCase{
+		repo: repo,
+	}
+}
+
+// Execute revokes a key of the user. The row is kept so the key still shows up in the list.
+func (uc *RevokeAPIKeyUseCase) Execute(ctx context.Context, userId uuid.UUID, keyId uuid.UUID) error {
+	return uc.repo.RevokeAPIKey(ctx, keyId, userId)
+}
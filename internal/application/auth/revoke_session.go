@@ -0,0 +1,44 @@
+package authapp
+
+import (
+	"context"
+	"fmt"
+	"go-ai/internal/domain/auth"
+	"go-ai/internal/infra/cache"
+
+	"github.com/google/uuid"
+)
+
+type RevokeSessionUseCase struct {
+	cache *cache.AuthCache
+}
+
+func NewRevokeSessionUseCase(cache *cache.AuthCache) *RevokeSessionUseCase {
+	return &RevokeSessionUseCase{
+		cache: cache,
+	}
+}
+
+// Execute ends one session of the user. Access tokens bound to it are rejected by the auth
+// middleware as soon as the session is gone.
+func (uc *RevokeSessionUseCase) Execute(ctx context.Context, userId uuid.UUID, sessionId string) error {
+	if userId == uuid.Nil {
+		return auth.ErrTokenMissing
+	}
+	if sessionId == "" {
+		return auth.ErrSessionNotFound
+	}
+	keySessions := fmt.Sprintf("sessions_%s", userId.String())
+	keySession := fmt.Sprintf("session_%s_%s", userId.String(), sessionId)
+	session, err := uc.cache.GetSessionCache(keySession)
+	if err != nil {
+		return err
+	}
+	if session == nil {
+		return auth.ErrSessionNotFound
+	}
+	if err := uc.cache.DeleteSessionCache(keySessions, keySession); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package authapp
+
+import (
+	"context"
+	"go-ai/internal/config"
+	"go-ai/internal/domain/auth"
+	uilts "go-ai/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+type SetupTOTPUseCase struct {
+	repo auth.Repository
+}
+
+func NewSetupTOTPUseCase(repo auth.Repository) *SetupTOTPUseCase {
+	return &SetupTOTPUseCase{
+		repo: repo,
+	}
+}
+
+// Execute starts TOTP enrolment with a fresh secret. Two-factor stays off until the secret is
+// confirmed through ConfirmTOTPUseCase, so calling it again simply replaces the pending secret.
+func (uc *SetupTOTPUseCase) Execute(ctx context.Context, userId uuid.UUID) (*SetupTOTPResponse, error) {
+	config, _ := config.LoadConfig()
+	record, err := uc.repo.GetById(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	totp, err := uc.repo.GetTOTP(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if totp.EnabledAt != nil {
+		return nil, auth.ErrMfaAlreadyEnabled
+	}
+	secret, err := uilts.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.SetTOTPSecret(ctx, userId, secret); err != nil {
+		return nil, err
+	}
+	return &SetupTOTPResponse{
+		Secret:     secret,
+		OtpauthUri: uilts.TOTPURI(config.MfaIssuer, record.Email, secret),
+	}, nil
+}
@@ -0,0 +1,96 @@
+package authapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-ai/internal/config"
+	"go-ai/internal/domain/auth"
+	"go-ai/internal/infra/cache"
+	"go-ai/internal/infra/mail"
+	"go-ai/internal/transport/http/status"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+)
+
+type UpdateProfileUseCase struct {
+	repo   auth.Repository
+	cache  *cache.AuthCache
+	mailer mail.Mailer
+}
+
+func NewUpdateProfileUseCase(repo auth.Repository, cache *cache.AuthCache, mailer mail.Mailer) *UpdateProfileUseCase {
+	return &UpdateProfileUseCase{
+		repo:   repo,
+		cache:  cache,
+		mailer: mailer,
+	}
+}
+
+func (uc *UpdateProfileUseCase) Execute(ctx context.Context, userId uuid.UUID, request UpdateProfileRequest) (*GetProfileResponse, error) {
+	config, _ := config.LoadConfig()
+	if !strings.Contains(request.Email, "@") {
+		return nil, status.ErrInvalidEmail
+	}
+	if request.FullName == "" {
+		return nil, status.ErrInvalidName
+	}
+	record, err := uc.repo.GetById(ctx, userId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.ErrNotFound
+		}
+		return nil, err
+	}
+	// email is CITEXT, so changing only the case keeps the address and its verification
+	emailChanged := !strings.EqualFold(request.Email, record.Email)
+	if emailChanged {
+		other, err := uc.repo.GetByEmail(ctx, request.Email)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		if other != nil && other.ID != record.ID {
+			return nil, status.ErrEmailAlreadyExists
+		}
+	}
+	if request.FullName != record.FullName {
+		other, err := uc.repo.GetByName(ctx, request.FullName)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		if other != nil && other.ID != record.ID {
+			return nil, status.ErrNameAlreadyExists
+		}
+	}
+	record.Email = request.Email
+	record.FullName = request.FullName
+	if err := uc.repo.UpdateUser(ctx, record); err != nil {
+		return nil, err
+	}
+	// UpdateUser marked the new address unverified; as on registration a mail failure does not fail
+	// the update, the user can ask for the link again.
+	if emailChanged {
+		if err := sendVerificationEmail(ctx, uc.repo, uc.mailer, record.ID, record.Email, record.FullName); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Str("user_id", record.ID.String()).Msg("failed to send verification email")
+		}
+	}
+	dataCache := &cache.AuthData{
+		UserId:   record.ID,
+		Role:     record.Role,
+		Email:    record.Email,
+		IsActive: record.IsActive,
+		FullName: record.FullName,
+	}
+	keyAuthCache := fmt.Sprintf("profile_%s", record.ID.String())
+	uc.cache.SetAuthCache(keyAuthCache, dataCache, time.Duration(config.JwtExpiresIn*int(time.Second)))
+	return &GetProfileResponse{
+		Email:    record.Email,
+		FullName: record.FullName,
+		Role:     record.Role,
+		IsActive: record.IsActive,
+	}, nil
+}
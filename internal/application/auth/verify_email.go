@@ -0,0 +1,66 @@
+package authapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-ai/internal/config"
+	"go-ai/internal/domain/auth"
+	"go-ai/internal/infra/mail"
+	uilts "go-ai/pkg/utils"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type VerifyEmailUseCase struct {
+	repo auth.Repository
+}
+
+func NewVerifyEmailUseCase(repo auth.Repository) *VerifyEmailUseCase {
+	return &VerifyEmailUseCase{
+		repo: repo,
+	}
+}
+
+func (uc *VerifyEmailUseCase) Execute(ctx context.Context, token string) error {
+	if token == "" {
+		return auth.ErrVerifyTokenInvalid
+	}
+	record, err := uc.repo.GetEmailVerificationToken(ctx, uilts.HashToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return auth.ErrVerifyTokenInvalid
+		}
+		return err
+	}
+	if record.UsedAt != nil || time.Now().After(record.ExpiresAt) {
+		return auth.ErrVerifyTokenInvalid
+	}
+	return uc.repo.VerifyEmail(ctx, record.ID, record.UserID)
+}
+
+// sendVerificationEmail issues a new verification token for the user and mails the link that
+// confirms it.
+func sendVerificationEmail(ctx context.Context, repo auth.Repository, mailer mail.Mailer, userID uuid.UUID, email, fullName string) error {
+	config, _ := config.LoadConfig()
+	token, err := uilts.GenerateOpaqueToken(32)
+	if err != nil {
+		return auth.ErrTokenGenerateFail
+	}
+	expiresIn := time.Duration(config.EmailVerifyExpiresIn * int(time.Second))
+	err = repo.CreateEmailVerificationToken(ctx, userID, uilts.HashToken(token), time.Now().Add(expiresIn))
+	if err != nil {
+		return err
+	}
+	link := fmt.Sprintf("%s/api/auth/verify-email?token=%s", strings.TrimRight(config.ApiBaseUrl, "/"), url.QueryEscape(token))
+	return mailer.Send(ctx, mail.Message{
+		To:      email,
+		Subject: "Verify your email",
+		Body: fmt.Sprintf("Hi %s,\n\nPlease confirm your email address by opening the link below. It expires in %d hours.\n\n%s\n",
+			fullName, int(expiresIn.Hours()), link),
+	})
+}
@@ -0,0 +1,89 @@
+package authapp
+
+import (
+	"context"
+	"fmt"
+	"go-ai/internal/domain/auth"
+	"go-ai/internal/infra/cache"
+	"go-ai/internal/transport/http/status"
+	uilts "go-ai/pkg/utils"
+)
+
+// maxMfaAttempts bounds how many codes can be tried against one challenge before the user has to
+// log in with the password again.
+const maxMfaAttempts = 5
+
+type VerifyMfaUseCase struct {
+	repo  auth.Repository
+	cache *cache.AuthCache
+	keys  *uilts.KeySet
+}
+
+func NewVerifyMfaUseCase(repo auth.Repository, cache *cache.AuthCache, keys *uilts.KeySet) *VerifyMfaUseCase {
+	return &VerifyMfaUseCase{
+		repo:  repo,
+		cache: cache,
+		keys:  keys,
+	}
+}
+
+// Execute exchanges the challenge token returned by LoginUseCase and a TOTP or recovery code for
+// the real token pair.
+func (uc *VerifyMfaUseCase) Execute(ctx context.Context, request VerifyMfaRequest) (*LoginResponse, error) {
+	if request.MfaToken == "" {
+		return nil, auth.ErrMfaChallengeInvalid
+	}
+	key := fmt.Sprintf("mfa_challenge_%s", uilts.HashToken(request.MfaToken))
+	challenge, err := uc.cache.GetMfaChallengeCache(key)
+	if err != nil {
+		return nil, err
+	}
+	if challenge == nil {
+		return nil, auth.ErrMfaChallengeInvalid
+	}
+	// a wrong code is a failed login: without this, knowing the password opens challenge after
+	// challenge and the code space can be searched
+	if err := checkLoginLockout(uc.cache, challenge.Email, request.IpAddress); err != nil {
+		uc.cache.DeleteAuthCache(key)
+		return nil, err
+	}
+	totp, err := uc.repo.GetTOTP(ctx, challenge.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if totp.EnabledAt == nil {
+		uc.cache.DeleteAuthCache(key)
+		return nil, auth.ErrMfaChallengeInvalid
+	}
+	ok, err := verifySecondFactor(ctx, uc.repo, challenge.UserId, totp.Secret, request.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		challenge.Attempts++
+		if challenge.Attempts >= maxMfaAttempts {
+			uc.cache.DeleteAuthCache(key)
+		} else {
+			uc.cache.UpdateMfaChallengeCache(key, challenge)
+		}
+		if err := recordLoginFailure(ctx, uc.cache, challenge.Email, request.IpAddress); err != nil {
+			uc.cache.DeleteAuthCache(key)
+			return nil, err
+		}
+		return nil, auth.ErrMfaCodeInvalid
+	}
+	if err := uc.cache.DeleteAuthCache(key); err != nil {
+		return nil, err
+	}
+	if err := resetLoginFailures(uc.cache, challenge.Email); err != nil {
+		return nil, err
+	}
+	storedUser, err := uc.repo.GetById(ctx, challenge.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if !storedUser.IsActive {
+		return nil, status.ErrUserInactive
+	}
+	return issueTokens(uc.cache, uc.keys, storedUser, request.UserAgent, request.IpAddress)
+}
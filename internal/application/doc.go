@@ -2,7 +2,10 @@ package app
 
 import (
 	authapp "go-ai/internal/application/auth"
+	menuapp "go-ai/internal/application/menu"
+	orderapp "go-ai/internal/application/order"
 	restaurantapp "go-ai/internal/application/restaurant"
+	tableapp "go-ai/internal/application/table"
 	uploadapp "go-ai/internal/application/upload"
 	"go-ai/internal/transport/http/response"
 )
@@ -38,11 +41,60 @@ type LoginSuccessResponseDoc struct {
 	Data *authapp.LoginResponse `json:"data,omitempty"`
 }
 
+type SetupTOTPSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *authapp.SetupTOTPResponse `json:"data,omitempty"`
+}
+
+type ConfirmTOTPSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *authapp.ConfirmTOTPResponse `json:"data,omitempty"`
+}
+
+type CreateAPIKeySuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *authapp.CreateAPIKeyResponse `json:"data,omitempty"`
+}
+
+type ListAPIKeysSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *authapp.ListAPIKeysResponse `json:"data,omitempty"`
+}
+
+type ListUsersSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *authapp.ListUsersResponse `json:"data,omitempty"`
+}
+
+type AdminUserSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *authapp.AdminUserResponse `json:"data,omitempty"`
+}
+
+type ListRolesSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *authapp.ListRolesResponse `json:"data,omitempty"`
+}
+
+type LogoutSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+}
+
+type ListSessionsSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *authapp.ListSessionsResponse `json:"data,omitempty"`
+}
+
 type UploadLogoSuccessResponseDoc struct {
 	SuccecssResponseBaseDoc
 	Data *uploadapp.UploadLogoResponse `json:"data,omitempty"`
 }
 
+type UploadImageSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *uploadapp.UploadImageResponse `json:"data,omitempty"`
+}
+
 type CreateRestaurantSuccessResponseDoc struct {
 	SuccecssResponseBaseDoc
 	Data *restaurantapp.CreateRestaurantResponse `json:"data,omitempty"`
@@ -53,6 +105,34 @@ type GetRestaurantByIDSuccessResponseDoc struct {
 	Data *restaurantapp.GetRestaurantByIDResponse `json:"data,omitempty"`
 }
 
+type ListRestaurantsSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *restaurantapp.ListRestaurantsResponse `json:"data,omitempty"`
+	Meta *response.PageMeta                     `json:"meta,omitempty"`
+}
+
+type SearchRestaurantsSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *restaurantapp.SearchRestaurantsResponse `json:"data,omitempty"`
+	Meta *response.PageMeta                       `json:"meta,omitempty"`
+}
+
+type NearbyRestaurantsSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *restaurantapp.NearbyRestaurantsResponse `json:"data,omitempty"`
+	Meta *response.PageMeta                       `json:"meta,omitempty"`
+}
+
+type ListSpecialHoursSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *restaurantapp.ListSpecialHoursResponse `json:"data,omitempty"`
+}
+
+type CreateSpecialHoursSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *restaurantapp.CreateSpecialHoursResponse `json:"data,omitempty"`
+}
+
 type UpdateRestaurantSuccessResponseDoc struct {
 	SuccecssResponseBaseDoc
 }
@@ -60,3 +140,79 @@ type UpdateRestaurantSuccessResponseDoc struct {
 type DeleteRestaurantSuccessResponseDoc struct {
 	SuccecssResponseBaseDoc
 }
+
+type ListMembersSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *restaurantapp.ListMembersResponse `json:"data,omitempty"`
+}
+
+type GetMenuSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *menuapp.MenuResponse `json:"data,omitempty"`
+}
+
+type CreateMenuCategorySuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *menuapp.CreateCategoryResponse `json:"data,omitempty"`
+}
+
+type CreateMenuItemSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *menuapp.CreateItemResponse `json:"data,omitempty"`
+}
+
+type GetMenuItemSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *menuapp.ItemResponse `json:"data,omitempty"`
+}
+
+type ListOptionGroupsSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *menuapp.ListOptionGroupsResponse `json:"data,omitempty"`
+}
+
+type CreateOptionGroupSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *menuapp.CreateOptionGroupResponse `json:"data,omitempty"`
+}
+
+type PriceMenuItemSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *menuapp.PriceItemResponse `json:"data,omitempty"`
+}
+
+type GetPublicRestaurantSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *restaurantapp.PublicRestaurantResponse `json:"data,omitempty"`
+}
+
+type GetPublicMenuSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *menuapp.PublicMenuResponse `json:"data,omitempty"`
+}
+
+type ListTablesSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *tableapp.ListTablesResponse `json:"data,omitempty"`
+}
+
+type CreateTableSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *tableapp.CreateTableResponse `json:"data,omitempty"`
+}
+
+type TableQRSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *tableapp.TableQRResponse `json:"data,omitempty"`
+}
+
+type OrderSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *orderapp.OrderResponse `json:"data,omitempty"`
+}
+
+type ListOrdersSuccessResponseDoc struct {
+	SuccecssResponseBaseDoc
+	Data *orderapp.ListOrdersResponse `json:"data,omitempty"`
+	Meta *response.PageMeta           `json:"meta,omitempty"`
+}
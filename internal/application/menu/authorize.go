@@ -0,0 +1,67 @@
+package menuapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// authorizeEditor returns restaurant.ErrRestaurantForbidden unless userID may edit the restaurant,
+// the same members that may edit its profile and hours.
+func authorizeEditor(ctx context.Context, restaurants restaurant.Repository, restaurantID int32, userID uuid.UUID) error {
+	member, err := restaurants.GetMember(ctx, restaurantID, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return restaurant.ErrRestaurantForbidden
+		}
+		return err
+	}
+	if !member.Role.CanUpdate() {
+		return restaurant.ErrRestaurantForbidden
+	}
+	return nil
+}
+
+// authorizeReader tells whether userID is a member of the restaurant. The menu of a restaurant that
+// is not active is only shown to its members, to others it reads as restaurant.ErrRestaurantNoExitis.
+func authorizeReader(ctx context.Context, restaurants restaurant.Repository, restaurantID int32, userID uuid.UUID) (bool, error) {
+	record, err := restaurants.GetById(ctx, restaurantID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, restaurant.ErrRestaurantNoExitis
+		}
+		return false, err
+	}
+	_, err = restaurants.GetMember(ctx, restaurantID, userID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return false, err
+	}
+	isMember := err == nil
+	if !isMember && !record.Status.IsPublic() {
+		return false, restaurant.ErrRestaurantNoExitis
+	}
+	return isMember, nil
+}
+
+// publicRestaurant loads the restaurant a slug points to, when it is active. Anything else reads as
+// restaurant.ErrRestaurantNoExitis.
+func publicRestaurant(ctx context.Context, restaurants restaurant.Repository, slug string) (*restaurant.Entity, error) {
+	id, ok := restaurant.ParseSlug(slug)
+	if !ok {
+		return nil, restaurant.ErrRestaurantNoExitis
+	}
+	record, err := restaurants.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, restaurant.ErrRestaurantNoExitis
+		}
+		return nil, err
+	}
+	if !record.Status.IsPublic() {
+		return nil, restaurant.ErrRestaurantNoExitis
+	}
+	return record, nil
+}
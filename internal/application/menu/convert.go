@@ -0,0 +1,157 @@
+package menuapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/pkg/utils"
+	"strings"
+)
+
+func toCategory(request CategoryRequest, restaurantID int32) (*menu.Category, error) {
+	name := strings.TrimSpace(request.Name)
+	category := &menu.Category{
+		RestaurantID: restaurantID,
+		Name:         name,
+		Slug:         utils.Slugify(name),
+		SortOrder:    request.SortOrder,
+		IsActive:     request.IsActive == nil || *request.IsActive,
+	}
+	if err := category.Validate(); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// toItem validates the request, including that its category belongs to the same restaurant.
+func toItem(ctx context.Context, repo menu.Repository, request ItemRequest, restaurantID int32) (*menu.Item, error) {
+	itemType, err := menu.ParseItemType(request.Type)
+	if err != nil {
+		return nil, err
+	}
+	item := &menu.Item{
+		RestaurantID: restaurantID,
+		CategoryID:   request.CategoryId,
+		Type:         itemType,
+		Name:         strings.TrimSpace(request.Name),
+		Description:  strings.TrimSpace(request.Description),
+		ImageUrl:     strings.TrimSpace(request.ImageUrl),
+		Price:        menu.Price(request.Price),
+		IsAvailable:  request.IsAvailable == nil || *request.IsAvailable,
+		SortOrder:    request.SortOrder,
+	}
+	if err := item.Validate(); err != nil {
+		return nil, err
+	}
+	if item.CategoryID != nil {
+		if _, err := repo.GetCategory(ctx, restaurantID, *item.CategoryID); err != nil {
+			return nil, err
+		}
+	}
+	return item, nil
+}
+
+func toItemResponse(i *menu.Item, groups []menu.OptionGroup) ItemResponse {
+	optionGroups := make([]OptionGroupResponse, 0, len(groups))
+	for _, g := range groups {
+		optionGroups = append(optionGroups, toOptionGroupResponse(&g))
+	}
+	return ItemResponse{
+		Id:           i.ID,
+		CategoryId:   i.CategoryID,
+		Type:         string(i.Type),
+		Name:         i.Name,
+		Description:  i.Description,
+		ImageUrl:     i.ImageUrl,
+		Price:        int64(i.Price),
+		IsAvailable:  i.IsAvailable,
+		SortOrder:    i.SortOrder,
+		OptionGroups: optionGroups,
+	}
+}
+
+func toOptionGroup(request OptionGroupRequest, restaurantID int32) (*menu.OptionGroup, error) {
+	group := &menu.OptionGroup{
+		RestaurantID: restaurantID,
+		Name:         strings.TrimSpace(request.Name),
+		MinSelect:    request.MinSelect,
+		MaxSelect:    request.MaxSelect,
+		SortOrder:    request.SortOrder,
+		Options:      make([]menu.Option, 0, len(request.Options)),
+	}
+	for n, o := range request.Options {
+		maxQuantity := o.MaxQuantity
+		if maxQuantity == 0 {
+			maxQuantity = 1
+		}
+		group.Options = append(group.Options, menu.Option{
+			Name:        strings.TrimSpace(o.Name),
+			PriceDelta:  menu.Price(o.PriceDelta),
+			MaxQuantity: maxQuantity,
+			IsAvailable: o.IsAvailable == nil || *o.IsAvailable,
+			SortOrder:   int32(n),
+		})
+	}
+	if err := group.Validate(); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func toOptionGroupResponse(g *menu.OptionGroup) OptionGroupResponse {
+	options := make([]OptionResponse, 0, len(g.Options))
+	for _, o := range g.Options {
+		options = append(options, OptionResponse{
+			Id:          o.ID,
+			Name:        o.Name,
+			PriceDelta:  int64(o.PriceDelta),
+			MaxQuantity: o.MaxQuantity,
+			IsAvailable: o.IsAvailable,
+		})
+	}
+	return OptionGroupResponse{
+		Id:        g.ID,
+		Name:      g.Name,
+		MinSelect: g.MinSelect,
+		MaxSelect: g.MaxSelect,
+		Required:  g.Required(),
+		SortOrder: g.SortOrder,
+		Options:   options,
+	}
+}
+
+// optionIndex resolves the option groups attached to the items of a restaurant.
+type optionIndex struct {
+	groups map[int64]menu.OptionGroup
+	items  map[int64][]int64
+}
+
+func loadOptionIndex(ctx context.Context, repo menu.Repository, restaurantID int32) (*optionIndex, error) {
+	groups, err := repo.ListOptionGroups(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := repo.ListItemOptionGroups(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	index := &optionIndex{
+		groups: make(map[int64]menu.OptionGroup, len(groups)),
+		items:  items,
+	}
+	for _, g := range groups {
+		index.groups[g.ID] = g
+	}
+	return index, nil
+}
+
+// forItem returns the groups attached to the item, in the order they were attached.
+func (x *optionIndex) forItem(itemID int64) []menu.OptionGroup {
+	ids := x.items[itemID]
+	groups := make([]menu.OptionGroup, 0, len(ids))
+	for _, id := range ids {
+		if g, ok := x.groups[id]; ok {
+			groups = append(groups, g)
+		}
+	}
+	return groups
+}
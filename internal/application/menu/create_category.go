@@ -0,0 +1,38 @@
+package menuapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type CreateCategoryUseCase struct {
+	repo        menu.Repository
+	restaurants restaurant.Repository
+}
+
+func NewCreateCategoryUseCase(repo menu.Repository, restaurants restaurant.Repository) *CreateCategoryUseCase {
+	return &CreateCategoryUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *CreateCategoryUseCase) Execute(ctx context.Context, request CategoryRequest, userID uuid.UUID, restaurantID int32) (*CreateCategoryResponse, error) {
+	category, err := toCategory(request, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeEditor(ctx, uc.restaurants, restaurantID, userID); err != nil {
+		return nil, err
+	}
+	id, err := uc.repo.CreateCategory(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateCategoryResponse{
+		Id: id,
+	}, nil
+}
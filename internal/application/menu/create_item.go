@@ -0,0 +1,38 @@
+package menuapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type CreateItemUseCase struct {
+	repo        menu.Repository
+	restaurants restaurant.Repository
+}
+
+func NewCreateItemUseCase(repo menu.Repository, restaurants restaurant.Repository) *CreateItemUseCase {
+	return &CreateItemUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *CreateItemUseCase) Execute(ctx context.Context, request ItemRequest, userID uuid.UUID, restaurantID int32) (*CreateItemResponse, error) {
+	if err := authorizeEditor(ctx, uc.restaurants, restaurantID, userID); err != nil {
+		return nil, err
+	}
+	item, err := toItem(ctx, uc.repo, request, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uc.repo.CreateItem(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateItemResponse{
+		Id: id,
+	}, nil
+}
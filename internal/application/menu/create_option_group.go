@@ -0,0 +1,38 @@
+package menuapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type CreateOptionGroupUseCase struct {
+	repo        menu.Repository
+	restaurants restaurant.Repository
+}
+
+func NewCreateOptionGroupUseCase(repo menu.Repository, restaurants restaurant.Repository) *CreateOptionGroupUseCase {
+	return &CreateOptionGroupUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *CreateOptionGroupUseCase) Execute(ctx context.Context, request OptionGroupRequest, userID uuid.UUID, restaurantID int32) (*CreateOptionGroupResponse, error) {
+	group, err := toOptionGroup(request, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeEditor(ctx, uc.restaurants, restaurantID, userID); err != nil {
+		return nil, err
+	}
+	id, err := uc.repo.CreateOptionGroup(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateOptionGroupResponse{
+		Id: id,
+	}, nil
+}
@@ -0,0 +1,29 @@
+package menuapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type DeleteCategoryUseCase struct {
+	repo        menu.Repository
+	restaurants restaurant.Repository
+}
+
+func NewDeleteCategoryUseCase(repo menu.Repository, restaurants restaurant.Repository) *DeleteCategoryUseCase {
+	return &DeleteCategoryUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+// Execute deletes a category. Its items stay on the menu without a category.
+func (uc *DeleteCategoryUseCase) Execute(ctx context.Context, userID uuid.UUID, restaurantID int32, id int64) error {
+	if err := authorizeEditor(ctx, uc.restaurants, restaurantID, userID); err != nil {
+		return err
+	}
+	return uc.repo.DeleteCategory(ctx, restaurantID, id)
+}
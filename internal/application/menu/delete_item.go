@@ -0,0 +1,28 @@
+package menuapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type DeleteItemUseCase struct {
+	repo        menu.Repository
+	restaurants restaurant.Repository
+}
+
+func NewDeleteItemUseCase(repo menu.Repository, restaurants restaurant.Repository) *DeleteItemUseCase {
+	return &DeleteItemUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *DeleteItemUseCase) Execute(ctx context.Context, userID uuid.UUID, restaurantID int32, id int64) error {
+	if err := authorizeEditor(ctx, uc.restaurants, restaurantID, userID); err != nil {
+		return err
+	}
+	return uc.repo.DeleteItem(ctx, restaurantID, id)
+}
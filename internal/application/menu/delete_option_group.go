@@ -0,0 +1,29 @@
+package menuapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type DeleteOptionGroupUseCase struct {
+	repo        menu.Repository
+	restaurants restaurant.Repository
+}
+
+func NewDeleteOptionGroupUseCase(repo menu.Repository, restaurants restaurant.Repository) *DeleteOptionGroupUseCase {
+	return &DeleteOptionGroupUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+// Execute deletes a group and its options, detaching it from every item.
+func (uc *DeleteOptionGroupUseCase) Execute(ctx context.Context, userID uuid.UUID, restaurantID int32, id int64) error {
+	if err := authorizeEditor(ctx, uc.restaurants, restaurantID, userID); err != nil {
+		return err
+	}
+	return uc.repo.DeleteOptionGroup(ctx, restaurantID, id)
+}
@@ -0,0 +1,152 @@
+package menuapp
+
+import "time"
+
+type CategoryRequest struct {
+	Name      string `json:"name"`
+	SortOrder int32  `json:"sort_order"`
+	// IsActive defaults to true; inactive categories are hidden from diners with their items
+	IsActive *bool `json:"is_active"`
+}
+
+type CreateCategoryResponse struct {
+	Id int64 `json:"id"`
+}
+
+// ItemRequest is a menu item. Price is in whole dong. Type is dish (default), extra, beverage or
+// combo. ImageUrl comes from the image upload endpoint.
+type ItemRequest struct {
+	CategoryId  *int64 `json:"category_id"`
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ImageUrl    string `json:"image_url"`
+	Price       int64  `json:"price"`
+	// IsAvailable defaults to true; clear it when the item is sold out
+	IsAvailable *bool `json:"is_available"`
+	SortOrder   int32 `json:"sort_order"`
+}
+
+type CreateItemResponse struct {
+	Id int64 `json:"id"`
+}
+
+type SetItemAvailabilityRequest struct {
+	IsAvailable bool `json:"is_available"`
+}
+
+type ItemResponse struct {
+	Id          int64  `json:"id"`
+	CategoryId  *int64 `json:"category_id"`
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ImageUrl    string `json:"image_url"`
+	Price       int64  `json:"price"`
+	IsAvailable bool   `json:"is_available"`
+	SortOrder   int32  `json:"sort_order"`
+	// OptionGroups are the modifiers a diner picks from when ordering the item
+	OptionGroups []OptionGroupResponse `json:"option_groups"`
+}
+
+type CategoryResponse struct {
+	Id        int64          `json:"id"`
+	Name      string         `json:"name"`
+	Slug      string         `json:"slug"`
+	SortOrder int32          `json:"sort_order"`
+	IsActive  bool           `json:"is_active"`
+	Items     []ItemResponse `json:"items"`
+}
+
+// MenuResponse lists the categories in menu order with their items, then the items without a
+// category.
+type MenuResponse struct {
+	Categories    []CategoryResponse `json:"categories"`
+	Uncategorized []ItemResponse     `json:"uncategorized"`
+}
+
+type PublicTableResponse struct {
+	Id   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+// PublicMenuResponse is the menu of an active restaurant as diners see it. LastModified is when
+// the restaurant or its menu last changed.
+type PublicMenuResponse struct {
+	Slug string `json:"slug"`
+	// Table is the table selected by the QR code the menu was opened from
+	Table *PublicTableResponse `json:"table,omitempty"`
+	MenuResponse
+	LastModified time.Time `json:"last_modified"`
+}
+
+// OptionGroupRequest is a set of modifiers. MinSelect and MaxSelect bound the total quantity picked
+// across the group, MaxSelect null means no upper bound. A group with min_select above zero is
+// required. Saving a group replaces all of its options.
+type OptionGroupRequest struct {
+	Name      string          `json:"name"`
+	MinSelect int32           `json:"min_select"`
+	MaxSelect *int32          `json:"max_select"`
+	SortOrder int32           `json:"sort_order"`
+	Options   []OptionRequest `json:"options"`
+}
+
+// OptionRequest is one choice of a group. PriceDelta is in whole dong and added once per unit
+// picked. MaxQuantity defaults to 1.
+type OptionRequest struct {
+	Name        string `json:"name"`
+	PriceDelta  int64  `json:"price_delta"`
+	MaxQuantity int32  `json:"max_quantity"`
+	// IsAvailable defaults to true
+	IsAvailable *bool `json:"is_available"`
+}
+
+type CreateOptionGroupResponse struct {
+	Id int64 `json:"id"`
+}
+
+type OptionResponse struct {
+	Id          int64  `json:"id"`
+	Name        string `json:"name"`
+	PriceDelta  int64  `json:"price_delta"`
+	MaxQuantity int32  `json:"max_quantity"`
+	IsAvailable bool   `json:"is_available"`
+}
+
+type OptionGroupResponse struct {
+	Id        int64            `json:"id"`
+	Name      string           `json:"name"`
+	MinSelect int32            `json:"min_select"`
+	MaxSelect *int32           `json:"max_select"`
+	Required  bool             `json:"required"`
+	SortOrder int32            `json:"sort_order"`
+	Options   []OptionResponse `json:"options"`
+}
+
+type ListOptionGroupsResponse struct {
+	OptionGroups []OptionGroupResponse `json:"option_groups"`
+}
+
+// SetItemOptionGroupsRequest lists the groups attached to an item, in display order. An empty list
+// detaches all of them.
+type SetItemOptionGroupsRequest struct {
+	OptionGroupIds []int64 `json:"option_group_ids"`
+}
+
+type SelectionRequest struct {
+	OptionId int64 `json:"option_id"`
+	Quantity int32 `json:"quantity"`
+}
+
+// PriceItemRequest is a configuration of an item, the options picked from its groups.
+type PriceItemRequest struct {
+	Options []SelectionRequest `json:"options"`
+}
+
+// PriceItemResponse is the unit price of a configured item, in whole dong: the item price plus the
+// price deltas of the options picked.
+type PriceItemResponse struct {
+	BasePrice    int64 `json:"base_price"`
+	OptionsPrice int64 `json:"options_price"`
+	UnitPrice    int64 `json:"unit_price"`
+}
@@ -0,0 +1,57 @@
+package menuapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type GetItemUseCase struct {
+	repo        menu.Repository
+	restaurants restaurant.Repository
+}
+
+func NewGetItemUseCase(repo menu.Repository, restaurants restaurant.Repository) *GetItemUseCase {
+	return &GetItemUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *GetItemUseCase) Execute(ctx context.Context, userID uuid.UUID, restaurantID int32, id int64) (*ItemResponse, error) {
+	isMember, err := authorizeReader(ctx, uc.restaurants, restaurantID, userID)
+	if err != nil {
+		return nil, err
+	}
+	item, err := visibleItem(ctx, uc.repo, restaurantID, id, isMember)
+	if err != nil {
+		return nil, err
+	}
+	options, err := loadOptionIndex(ctx, uc.repo, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	resp := toItemResponse(item, options.forItem(item.ID))
+	return &resp, nil
+}
+
+// visibleItem loads an item, which reads as menu.ErrItemNotFound to non-members when its category
+// is hidden.
+func visibleItem(ctx context.Context, repo menu.Repository, restaurantID int32, id int64, isMember bool) (*menu.Item, error) {
+	item, err := repo.GetItem(ctx, restaurantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if item.CategoryID != nil && !isMember {
+		category, err := repo.GetCategory(ctx, restaurantID, *item.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+		if !category.IsActive {
+			return nil, menu.ErrItemNotFound
+		}
+	}
+	return item, nil
+}
@@ -0,0 +1,86 @@
+package menuapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type GetMenuUseCase struct {
+	repo        menu.Repository
+	restaurants restaurant.Repository
+}
+
+func NewGetMenuUseCase(repo menu.Repository, restaurants restaurant.Repository) *GetMenuUseCase {
+	return &GetMenuUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+// Execute returns the whole menu of a restaurant. Members see the inactive categories too, other
+// users only the active ones. Sold out items are listed with is_available false.
+func (uc *GetMenuUseCase) Execute(ctx context.Context, restaurantID int32, userID uuid.UUID) (*MenuResponse, error) {
+	isMember, err := authorizeReader(ctx, uc.restaurants, restaurantID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return loadMenu(ctx, uc.repo, restaurantID, isMember)
+}
+
+func loadMenu(ctx context.Context, repo menu.Repository, restaurantID int32, showInactive bool) (*MenuResponse, error) {
+	categories, err := repo.ListCategories(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := repo.ListItems(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	options, err := loadOptionIndex(ctx, repo, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	return buildMenu(categories, items, options, showInactive), nil
+}
+
+// buildMenu groups items, already in menu order, under their categories. Items of a hidden
+// category are left out rather than shown as uncategorized. Each item carries its option groups.
+func buildMenu(categories []menu.Category, items []menu.Item, options *optionIndex, showInactive bool) *MenuResponse {
+	resp := &MenuResponse{
+		Categories:    make([]CategoryResponse, 0, len(categories)),
+		Uncategorized: []ItemResponse{},
+	}
+	index := make(map[int64]int, len(categories))
+	hidden := make(map[int64]bool)
+	for _, c := range categories {
+		if !c.IsActive && !showInactive {
+			hidden[c.ID] = true
+			continue
+		}
+		index[c.ID] = len(resp.Categories)
+		resp.Categories = append(resp.Categories, CategoryResponse{
+			Id:        c.ID,
+			Name:      c.Name,
+			Slug:      c.Slug,
+			SortOrder: c.SortOrder,
+			IsActive:  c.IsActive,
+			Items:     []ItemResponse{},
+		})
+	}
+	for _, i := range items {
+		if i.CategoryID == nil {
+			resp.Uncategorized = append(resp.Uncategorized, toItemResponse(&i, options.forItem(i.ID)))
+			continue
+		}
+		if hidden[*i.CategoryID] {
+			continue
+		}
+		if n, ok := index[*i.CategoryID]; ok {
+			resp.Categories[n].Items = append(resp.Categories[n].Items, toItemResponse(&i, options.forItem(i.ID)))
+		}
+	}
+	return resp
+}
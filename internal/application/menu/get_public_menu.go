@@ -0,0 +1,61 @@
+package menuapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/restaurant"
+	"go-ai/internal/domain/table"
+)
+
+type GetPublicMenuUseCase struct {
+	repo        menu.Repository
+	restaurants restaurant.Repository
+	tables      table.Repository
+	signer      *table.Signer
+}
+
+func NewGetPublicMenuUseCase(repo menu.Repository, restaurants restaurant.Repository, tables table.Repository, signer *table.Signer) *GetPublicMenuUseCase {
+	return &GetPublicMenuUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+		tables:      tables,
+		signer:      signer,
+	}
+}
+
+// Execute returns the menu of the restaurant a slug points to, without the inactive categories.
+// The slug in the response is the canonical one. A tableID, read from the QR code of a table,
+// selects that table when signature is right; a table since deleted or deactivated is left out
+// rather than failing the menu.
+func (uc *GetPublicMenuUseCase) Execute(ctx context.Context, slug string, tableID int32, signature string) (*PublicMenuResponse, error) {
+	record, err := publicRestaurant(ctx, uc.restaurants, slug)
+	if err != nil {
+		return nil, err
+	}
+	var tableResp *PublicTableResponse
+	if tableID != 0 {
+		if !uc.signer.Verify(record.ID, tableID, signature) {
+			return nil, table.ErrInvalidTableLink
+		}
+		t, err := uc.tables.Get(ctx, record.ID, tableID)
+		if err != nil && err != table.ErrTableNotFound {
+			return nil, err
+		}
+		if err == nil && t.IsActive {
+			tableResp = &PublicTableResponse{
+				Id:   t.ID,
+				Name: t.Name,
+			}
+		}
+	}
+	menuResp, err := loadMenu(ctx, uc.repo, record.ID, false)
+	if err != nil {
+		return nil, err
+	}
+	return &PublicMenuResponse{
+		Slug:         restaurant.Slug(record.ID, record.Name),
+		Table:        tableResp,
+		MenuResponse: *menuResp,
+		LastModified: record.UpdatedAt,
+	}, nil
+}
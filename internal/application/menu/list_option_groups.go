@@ -0,0 +1,40 @@
+package menuapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type ListOptionGroupsUseCase struct {
+	repo        menu.Repository
+	restaurants restaurant.Repository
+}
+
+func NewListOptionGroupsUseCase(repo menu.Repository, restaurants restaurant.Repository) *ListOptionGroupsUseCase {
+	return &ListOptionGroupsUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+// Execute lists the option groups of a restaurant with their options, whether attached to an item
+// or not.
+func (uc *ListOptionGroupsUseCase) Execute(ctx context.Context, userID uuid.UUID, restaurantID int32) (*ListOptionGroupsResponse, error) {
+	if _, err := authorizeReader(ctx, uc.restaurants, restaurantID, userID); err != nil {
+		return nil, err
+	}
+	groups, err := uc.repo.ListOptionGroups(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ListOptionGroupsResponse{
+		OptionGroups: make([]OptionGroupResponse, 0, len(groups)),
+	}
+	for _, g := range groups {
+		resp.OptionGroups = append(resp.OptionGroups, toOptionGroupResponse(&g))
+	}
+	return resp, nil
+}
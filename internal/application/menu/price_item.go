@@ -0,0 +1,54 @@
+package menuapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type PriceItemUseCase struct {
+	repo        menu.Repository
+	restaurants restaurant.Repository
+}
+
+func NewPriceItemUseCase(repo menu.Repository, restaurants restaurant.Repository) *PriceItemUseCase {
+	return &PriceItemUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+// Execute checks a configuration of an item against the rules of its option groups and prices
+// it. Sold out items are priced too, the quote does not reserve anything.
+func (uc *PriceItemUseCase) Execute(ctx context.Context, request PriceItemRequest, userID uuid.UUID, restaurantID int32, itemID int64) (*PriceItemResponse, error) {
+	isMember, err := authorizeReader(ctx, uc.restaurants, restaurantID, userID)
+	if err != nil {
+		return nil, err
+	}
+	item, err := visibleItem(ctx, uc.repo, restaurantID, itemID, isMember)
+	if err != nil {
+		return nil, err
+	}
+	options, err := loadOptionIndex(ctx, uc.repo, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	selections := make([]menu.Selection, 0, len(request.Options))
+	for _, o := range request.Options {
+		selections = append(selections, menu.Selection{
+			OptionID: o.OptionId,
+			Quantity: o.Quantity,
+		})
+	}
+	optionsPrice, err := menu.PriceSelection(options.forItem(item.ID), selections)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceItemResponse{
+		BasePrice:    int64(item.Price),
+		OptionsPrice: int64(optionsPrice),
+		UnitPrice:    int64(item.Price + optionsPrice),
+	}, nil
+}
@@ -0,0 +1,34 @@
+package menuapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type SetItemAvailabilityUseCase struct {
+	repo        menu.Repository
+	restaurants restaurant.Repository
+}
+
+func NewSetItemAvailabilityUseCase(repo menu.Repository, restaurants restaurant.Repository) *SetItemAvailabilityUseCase {
+	return &SetItemAvailabilityUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+// Execute marks an item sold out or available again. Any member may do it, staff included, since
+// it is part of running the service rather than editing the menu.
+func (uc *SetItemAvailabilityUseCase) Execute(ctx context.Context, request SetItemAvailabilityRequest, userID uuid.UUID, restaurantID int32, id int64) error {
+	isMember, err := authorizeReader(ctx, uc.restaurants, restaurantID, userID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return restaurant.ErrRestaurantForbidden
+	}
+	return uc.repo.SetItemAvailability(ctx, restaurantID, id, request.IsAvailable)
+}
@@ -0,0 +1,50 @@
+package menuapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type SetItemOptionGroupsUseCase struct {
+	repo        menu.Repository
+	restaurants restaurant.Repository
+}
+
+func NewSetItemOptionGroupsUseCase(repo menu.Repository, restaurants restaurant.Repository) *SetItemOptionGroupsUseCase {
+	return &SetItemOptionGroupsUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+// Execute attaches the listed groups to an item in place of its current ones. Every group must
+// belong to the restaurant of the item.
+func (uc *SetItemOptionGroupsUseCase) Execute(ctx context.Context, request SetItemOptionGroupsRequest, userID uuid.UUID, restaurantID int32, itemID int64) error {
+	if err := authorizeEditor(ctx, uc.restaurants, restaurantID, userID); err != nil {
+		return err
+	}
+	groups, err := uc.repo.ListOptionGroups(ctx, restaurantID)
+	if err != nil {
+		return err
+	}
+	known := make(map[int64]bool, len(groups))
+	for _, g := range groups {
+		known[g.ID] = true
+	}
+	ids := make([]int64, 0, len(request.OptionGroupIds))
+	seen := make(map[int64]bool, len(request.OptionGroupIds))
+	for _, id := range request.OptionGroupIds {
+		if !known[id] {
+			return menu.ErrOptionGroupNotFound
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return uc.repo.SetItemOptionGroups(ctx, restaurantID, itemID, ids)
+}
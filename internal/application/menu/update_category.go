@@ -0,0 +1,33 @@
+package menuapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type UpdateCategoryUseCase struct {
+	repo        menu.Repository
+	restaurants restaurant.Repository
+}
+
+func NewUpdateCategoryUseCase(repo menu.Repository, restaurants restaurant.Repository) *UpdateCategoryUseCase {
+	return &UpdateCategoryUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *UpdateCategoryUseCase) Execute(ctx context.Context, request CategoryRequest, userID uuid.UUID, restaurantID int32, id int64) error {
+	category, err := toCategory(request, restaurantID)
+	if err != nil {
+		return err
+	}
+	if err := authorizeEditor(ctx, uc.restaurants, restaurantID, userID); err != nil {
+		return err
+	}
+	category.ID = id
+	return uc.repo.UpdateCategory(ctx, category)
+}
@@ -0,0 +1,33 @@
+package menuapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type UpdateItemUseCase struct {
+	repo        menu.Repository
+	restaurants restaurant.Repository
+}
+
+func NewUpdateItemUseCase(repo menu.Repository, restaurants restaurant.Repository) *UpdateItemUseCase {
+	return &UpdateItemUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *UpdateItemUseCase) Execute(ctx context.Context, request ItemRequest, userID uuid.UUID, restaurantID int32, id int64) error {
+	if err := authorizeEditor(ctx, uc.restaurants, restaurantID, userID); err != nil {
+		return err
+	}
+	item, err := toItem(ctx, uc.repo, request, restaurantID)
+	if err != nil {
+		return err
+	}
+	item.ID = id
+	return uc.repo.UpdateItem(ctx, item)
+}
@@ -0,0 +1,34 @@
+package menuapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type UpdateOptionGroupUseCase struct {
+	repo        menu.Repository
+	restaurants restaurant.Repository
+}
+
+func NewUpdateOptionGroupUseCase(repo menu.Repository, restaurants restaurant.Repository) *UpdateOptionGroupUseCase {
+	return &UpdateOptionGroupUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+// Execute replaces the group along with all of its options.
+func (uc *UpdateOptionGroupUseCase) Execute(ctx context.Context, request OptionGroupRequest, userID uuid.UUID, restaurantID int32, id int64) error {
+	group, err := toOptionGroup(request, restaurantID)
+	if err != nil {
+		return err
+	}
+	if err := authorizeEditor(ctx, uc.restaurants, restaurantID, userID); err != nil {
+		return err
+	}
+	group.ID = id
+	return uc.repo.UpdateOptionGroup(ctx, group)
+}
@@ -0,0 +1,48 @@
+package orderapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/order"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type AddCartItemUseCase struct {
+	repo        order.Repository
+	menus       menu.Repository
+	restaurants restaurant.Repository
+}
+
+func NewAddCartItemUseCase(repo order.Repository, menus menu.Repository, restaurants restaurant.Repository) *AddCartItemUseCase {
+	return &AddCartItemUseCase{
+		repo:        repo,
+		menus:       menus,
+		restaurants: restaurants,
+	}
+}
+
+// Execute prices a line from the menu and adds it to the cart of the customer at the restaurant,
+// starting the cart when there is none. It returns the updated cart.
+func (uc *AddCartItemUseCase) Execute(ctx context.Context, request CartItemRequest, userID uuid.UUID, restaurantID int32) (*OrderResponse, error) {
+	if _, err := orderableRestaurant(ctx, uc.restaurants, restaurantID); err != nil {
+		return nil, err
+	}
+	m, err := loadMenu(ctx, uc.menus, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	line, err := m.line(request.MenuItemId, toSelections(request.Options), request.Quantity, request.Note)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.repo.AddCartItem(ctx, restaurantID, userID, &line); err != nil {
+		return nil, err
+	}
+	cart, err := uc.repo.GetCart(ctx, restaurantID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toOrderResponse(cart), nil
+}
@@ -0,0 +1,68 @@
+package orderapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/config"
+	"go-ai/internal/domain/restaurant"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// orderableRestaurant loads a restaurant customers may order from, an active one. Anything else
+// reads as restaurant.ErrRestaurantNoExitis.
+func orderableRestaurant(ctx context.Context, restaurants restaurant.Repository, id int32) (*restaurant.Entity, error) {
+	record, err := restaurants.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, restaurant.ErrRestaurantNoExitis
+		}
+		return nil, err
+	}
+	if !record.Status.IsPublic() {
+		return nil, restaurant.ErrRestaurantNoExitis
+	}
+	return record, nil
+}
+
+// openNow reports whether the weekly hours of the restaurant, with its special hours, cover the
+// current moment.
+func openNow(ctx context.Context, restaurants restaurant.Repository, r *restaurant.Entity) (bool, error) {
+	loc, err := restaurantLocation(r)
+	if err != nil {
+		return false, err
+	}
+	now := time.Now().In(loc)
+	// from yesterday, its overnight shift may still be running
+	from := restaurant.DateOf(now).AddDate(0, 0, -1)
+	special, err := restaurants.ListSpecialHours(ctx, r.ID, &from)
+	if err != nil {
+		return false, err
+	}
+	return restaurant.NewSchedule(r.Hours, special, loc).IsOpenAt(now), nil
+}
+
+// restaurantLocation is the timezone the hours of a restaurant are read in, DEFAULT_TIMEZONE for a
+// restaurant saved with one this server does not know.
+func restaurantLocation(r *restaurant.Entity) (*time.Location, error) {
+	if loc, err := time.LoadLocation(r.Timezone); err == nil && r.Timezone != "" {
+		return loc, nil
+	}
+	config, _ := config.LoadConfig()
+	return time.LoadLocation(config.DefaultTimezone)
+}
+
+// authorizeStaff returns restaurant.ErrRestaurantForbidden unless userID is a member of the
+// restaurant. Every member, staff included, handles its orders.
+func authorizeStaff(ctx context.Context, restaurants restaurant.Repository, restaurantID int32, userID uuid.UUID) error {
+	_, err := restaurants.GetMember(ctx, restaurantID, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return restaurant.ErrRestaurantForbidden
+		}
+		return err
+	}
+	return nil
+}
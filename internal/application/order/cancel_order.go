@@ -0,0 +1,41 @@
+package orderapp
+
+import (
+	"context"
+	"go-ai/internal/domain/order"
+
+	"github.com/google/uuid"
+)
+
+type CancelOrderUseCase struct {
+	repo order.Repository
+}
+
+func NewCancelOrderUseCase(repo order.Repository) *CancelOrderUseCase {
+	return &CancelOrderUseCase{
+		repo: repo,
+	}
+}
+
+// Execute lets a customer take back an order the restaurant has not accepted yet.
+func (uc *CancelOrderUseCase) Execute(ctx context.Context, request CancelOrderRequest, userID uuid.UUID, id int64) (*OrderResponse, error) {
+	o, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if o.CustomerID != userID || o.Status == order.StatusCart {
+		return nil, order.ErrOrderNotFound
+	}
+	from := o.Status
+	if err := o.Move(order.StatusCancelled, order.ActorCustomer, request.Reason); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.UpdateStatus(ctx, o, from); err != nil {
+		return nil, err
+	}
+	cancelled, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toOrderResponse(cancelled), nil
+}
@@ -0,0 +1,58 @@
+package orderapp
+
+import (
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/order"
+)
+
+func toOrderResponse(o *order.Order) *OrderResponse {
+	items := make([]OrderItemResponse, 0, len(o.Items))
+	for _, i := range o.Items {
+		options := make([]OrderItemOptionResponse, 0, len(i.Options))
+		for _, opt := range i.Options {
+			options = append(options, OrderItemOptionResponse{
+				OptionId:   opt.OptionID,
+				GroupName:  opt.GroupName,
+				Name:       opt.Name,
+				PriceDelta: int64(opt.PriceDelta),
+				Quantity:   opt.Quantity,
+			})
+		}
+		items = append(items, OrderItemResponse{
+			Id:         i.ID,
+			MenuItemId: i.MenuItemID,
+			Name:       i.Name,
+			BasePrice:  int64(i.BasePrice),
+			Quantity:   i.Quantity,
+			Note:       i.Note,
+			Options:    options,
+			UnitPrice:  int64(i.UnitPrice),
+			LineTotal:  int64(i.LineTotal),
+		})
+	}
+	return &OrderResponse{
+		Id:           o.ID,
+		RestaurantId: o.RestaurantID,
+		CustomerId:   o.CustomerID,
+		Type:         string(o.Type),
+		TableId:      o.TableID,
+		Status:       string(o.Status),
+		Note:         o.Note,
+		CancelReason: o.CancelReason,
+		Subtotal:     int64(o.Subtotal),
+		Items:        items,
+		PlacedAt:     o.PlacedAt,
+		UpdatedAt:    o.UpdatedAt,
+	}
+}
+
+func toSelections(request []SelectionRequest) []menu.Selection {
+	selections := make([]menu.Selection, 0, len(request))
+	for _, s := range request {
+		selections = append(selections, menu.Selection{
+			OptionID: s.OptionId,
+			Quantity: s.Quantity,
+		})
+	}
+	return selections
+}
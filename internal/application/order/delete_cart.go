@@ -0,0 +1,22 @@
+package orderapp
+
+import (
+	"context"
+	"go-ai/internal/domain/order"
+
+	"github.com/google/uuid"
+)
+
+type DeleteCartUseCase struct {
+	repo order.Repository
+}
+
+func NewDeleteCartUseCase(repo order.Repository) *DeleteCartUseCase {
+	return &DeleteCartUseCase{
+		repo: repo,
+	}
+}
+
+func (uc *DeleteCartUseCase) Execute(ctx context.Context, userID uuid.UUID, restaurantID int32) error {
+	return uc.repo.DeleteCart(ctx, restaurantID, userID)
+}
@@ -0,0 +1,30 @@
+package orderapp
+
+import (
+	"context"
+	"go-ai/internal/domain/order"
+
+	"github.com/google/uuid"
+)
+
+type DeleteCartItemUseCase struct {
+	repo order.Repository
+}
+
+func NewDeleteCartItemUseCase(repo order.Repository) *DeleteCartItemUseCase {
+	return &DeleteCartItemUseCase{
+		repo: repo,
+	}
+}
+
+// Execute removes a line from the cart of the customer and returns the updated cart.
+func (uc *DeleteCartItemUseCase) Execute(ctx context.Context, userID uuid.UUID, restaurantID int32, itemID int64) (*OrderResponse, error) {
+	if err := uc.repo.DeleteCartItem(ctx, restaurantID, userID, itemID); err != nil {
+		return nil, err
+	}
+	cart, err := uc.repo.GetCart(ctx, restaurantID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toOrderResponse(cart), nil
+}
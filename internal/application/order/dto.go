@@ -0,0 +1,90 @@
+package orderapp
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SelectionRequest struct {
+	OptionId int64 `json:"option_id"`
+	Quantity int32 `json:"quantity"`
+}
+
+// CartItemRequest is a line of a cart: quantity servings of a menu item with the options picked
+// from its groups. Prices are always taken from the menu.
+type CartItemRequest struct {
+	MenuItemId int64              `json:"menu_item_id"`
+	Quantity   int32              `json:"quantity"`
+	Options    []SelectionRequest `json:"options"`
+	Note       string             `json:"note"`
+}
+
+// PlaceOrderRequest places the cart. A dine-in order placed from the QR code of a table passes the
+// table and sig of its link; takeaway orders have no table.
+type PlaceOrderRequest struct {
+	Type      string `json:"type"`
+	TableId   *int32 `json:"table_id"`
+	Signature string `json:"sig"`
+	Note      string `json:"note"`
+}
+
+type UpdateOrderStatusRequest struct {
+	Status string `json:"status"`
+	// Reason is kept when cancelling, and shown to the customer
+	Reason string `json:"reason"`
+}
+
+type CancelOrderRequest struct {
+	Reason string `json:"reason"`
+}
+
+type ListOrdersRequest struct {
+	Status string
+	Cursor string
+	Limit  int
+}
+
+// OrderResponse is an order or a cart. Prices are in whole dong, recomputed by the server from
+// the menu prices copied when each line was last priced.
+type OrderResponse struct {
+	Id           int64               `json:"id"`
+	RestaurantId int32               `json:"restaurant_id"`
+	CustomerId   uuid.UUID           `json:"customer_id"`
+	Type         string              `json:"type,omitempty"`
+	TableId      *int32              `json:"table_id,omitempty"`
+	Status       string              `json:"status"`
+	Note         string              `json:"note,omitempty"`
+	CancelReason string              `json:"cancel_reason,omitempty"`
+	Subtotal     int64               `json:"subtotal"`
+	Items        []OrderItemResponse `json:"items"`
+	PlacedAt     *time.Time          `json:"placed_at,omitempty"`
+	UpdatedAt    time.Time           `json:"updated_at"`
+}
+
+type OrderItemResponse struct {
+	Id         int64                     `json:"id"`
+	MenuItemId *int64                    `json:"menu_item_id,omitempty"`
+	Name       string                    `json:"name"`
+	BasePrice  int64                     `json:"base_price"`
+	Quantity   int32                     `json:"quantity"`
+	Note       string                    `json:"note,omitempty"`
+	Options    []OrderItemOptionResponse `json:"options"`
+	UnitPrice  int64                     `json:"unit_price"`
+	LineTotal  int64                     `json:"line_total"`
+}
+
+type OrderItemOptionResponse struct {
+	OptionId   *int64 `json:"option_id,omitempty"`
+	GroupName  string `json:"group_name"`
+	Name       string `json:"name"`
+	PriceDelta int64  `json:"price_delta"`
+	Quantity   int32  `json:"quantity"`
+}
+
+type ListOrdersResponse struct {
+	Orders     []OrderResponse `json:"orders"`
+	Limit      int             `json:"-"`
+	NextCursor string          `json:"-"`
+	HasMore    bool            `json:"-"`
+}
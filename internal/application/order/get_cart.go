@@ -0,0 +1,26 @@
+package orderapp
+
+import (
+	"context"
+	"go-ai/internal/domain/order"
+
+	"github.com/google/uuid"
+)
+
+type GetCartUseCase struct {
+	repo order.Repository
+}
+
+func NewGetCartUseCase(repo order.Repository) *GetCartUseCase {
+	return &GetCartUseCase{
+		repo: repo,
+	}
+}
+
+func (uc *GetCartUseCase) Execute(ctx context.Context, userID uuid.UUID, restaurantID int32) (*OrderResponse, error) {
+	cart, err := uc.repo.GetCart(ctx, restaurantID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toOrderResponse(cart), nil
+}
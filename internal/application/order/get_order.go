@@ -0,0 +1,42 @@
+package orderapp
+
+import (
+	"context"
+	"go-ai/internal/domain/order"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type GetOrderUseCase struct {
+	repo        order.Repository
+	restaurants restaurant.Repository
+}
+
+func NewGetOrderUseCase(repo order.Repository, restaurants restaurant.Repository) *GetOrderUseCase {
+	return &GetOrderUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+// Execute returns a placed order to its customer or to the members of its restaurant. To anyone
+// else, and for carts, it reads as order.ErrOrderNotFound.
+func (uc *GetOrderUseCase) Execute(ctx context.Context, userID uuid.UUID, id int64) (*OrderResponse, error) {
+	o, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if o.Status == order.StatusCart {
+		return nil, order.ErrOrderNotFound
+	}
+	if o.CustomerID != userID {
+		if err := authorizeStaff(ctx, uc.restaurants, o.RestaurantID, userID); err != nil {
+			if err == restaurant.ErrRestaurantForbidden {
+				return nil, order.ErrOrderNotFound
+			}
+			return nil, err
+		}
+	}
+	return toOrderResponse(o), nil
+}
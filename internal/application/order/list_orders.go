@@ -0,0 +1,116 @@
+package orderapp
+
+import (
+	"context"
+	"go-ai/internal/domain/order"
+	"go-ai/internal/domain/restaurant"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+type ListOrdersUseCase struct {
+	repo order.Repository
+}
+
+func NewListOrdersUseCase(repo order.Repository) *ListOrdersUseCase {
+	return &ListOrdersUseCase{
+		repo: repo,
+	}
+}
+
+// Execute returns a page of the orders the customer placed, at any restaurant, newest first.
+func (uc *ListOrdersUseCase) Execute(ctx context.Context, request ListOrdersRequest, userID uuid.UUID) (*ListOrdersResponse, error) {
+	filter, limit, err := listFilter(request)
+	if err != nil {
+		return nil, err
+	}
+	orders, err := uc.repo.ListByCustomer(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+	return listResponse(orders, limit), nil
+}
+
+type ListRestaurantOrdersUseCase struct {
+	repo        order.Repository
+	restaurants restaurant.Repository
+}
+
+func NewListRestaurantOrdersUseCase(repo order.Repository, restaurants restaurant.Repository) *ListRestaurantOrdersUseCase {
+	return &ListRestaurantOrdersUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+// Execute returns a page of the orders placed at the restaurant, newest first, to its members.
+// Pass status=placed for the orders waiting to be accepted.
+func (uc *ListRestaurantOrdersUseCase) Execute(ctx context.Context, request ListOrdersRequest, userID uuid.UUID, restaurantID int32) (*ListOrdersResponse, error) {
+	if err := authorizeStaff(ctx, uc.restaurants, restaurantID, userID); err != nil {
+		return nil, err
+	}
+	filter, limit, err := listFilter(request)
+	if err != nil {
+		return nil, err
+	}
+	orders, err := uc.repo.ListByRestaurant(ctx, restaurantID, filter)
+	if err != nil {
+		return nil, err
+	}
+	return listResponse(orders, limit), nil
+}
+
+// listFilter reads a list request. The cursor is the id of the last order of the previous page.
+func listFilter(request ListOrdersRequest) (order.ListFilter, int, error) {
+	limit := request.Limit
+	if limit < 1 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+	filter := order.ListFilter{
+		// one extra row tells whether there is a next page
+		Limit: int32(limit + 1),
+	}
+	if request.Status != "" {
+		status, err := order.ParseStatus(request.Status)
+		if err != nil || status == order.StatusCart {
+			return order.ListFilter{}, 0, order.ErrInvalidStatus
+		}
+		filter.Status = &status
+	}
+	if request.Cursor != "" {
+		before, err := strconv.ParseInt(request.Cursor, 10, 64)
+		if err != nil || before <= 0 {
+			return order.ListFilter{}, 0, order.ErrInvalidCursor
+		}
+		filter.BeforeID = before
+	}
+	return filter, limit, nil
+}
+
+func listResponse(orders []order.Order, limit int) *ListOrdersResponse {
+	hasMore := len(orders) > limit
+	if hasMore {
+		orders = orders[:limit]
+	}
+	resp := &ListOrdersResponse{
+		Orders:  make([]OrderResponse, 0, len(orders)),
+		Limit:   limit,
+		HasMore: hasMore,
+	}
+	for _, o := range orders {
+		resp.Orders = append(resp.Orders, *toOrderResponse(&o))
+	}
+	if hasMore {
+		resp.NextCursor = strconv.FormatInt(orders[len(orders)-1].ID, 10)
+	}
+	return resp
+}
@@ -0,0 +1,88 @@
+package orderapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/order"
+)
+
+// menuIndex prices cart lines against the current menu of a restaurant, read once per request.
+type menuIndex struct {
+	items      map[int64]menu.Item
+	hidden     map[int64]bool
+	groups     map[int64]menu.OptionGroup
+	itemGroups map[int64][]int64
+}
+
+func loadMenu(ctx context.Context, menus menu.Repository, restaurantID int32) (*menuIndex, error) {
+	categories, err := menus.ListCategories(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := menus.ListItems(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := menus.ListOptionGroups(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	itemGroups, err := menus.ListItemOptionGroups(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	index := &menuIndex{
+		items:      make(map[int64]menu.Item, len(items)),
+		hidden:     make(map[int64]bool),
+		groups:     make(map[int64]menu.OptionGroup, len(groups)),
+		itemGroups: itemGroups,
+	}
+	for _, c := range categories {
+		if !c.IsActive {
+			index.hidden[c.ID] = true
+		}
+	}
+	for _, i := range items {
+		index.items[i.ID] = i
+	}
+	for _, g := range groups {
+		index.groups[g.ID] = g
+	}
+	return index, nil
+}
+
+// line prices a line of a menu item. Items of hidden categories read as menu.ErrItemNotFound, as
+// they do on the public menu.
+func (m *menuIndex) line(menuItemID int64, selections []menu.Selection, quantity int32, note string) (order.Item, error) {
+	item, ok := m.items[menuItemID]
+	if !ok || (item.CategoryID != nil && m.hidden[*item.CategoryID]) {
+		return order.Item{}, menu.ErrItemNotFound
+	}
+	ids := m.itemGroups[menuItemID]
+	groups := make([]menu.OptionGroup, 0, len(ids))
+	for _, id := range ids {
+		if g, ok := m.groups[id]; ok {
+			groups = append(groups, g)
+		}
+	}
+	return order.NewItem(&item, groups, selections, quantity, note)
+}
+
+// reprice prices a cart line again from the current menu, configured as it was. A line whose item
+// or options were deleted from the menu fails.
+func (m *menuIndex) reprice(line order.Item) (order.Item, error) {
+	if line.MenuItemID == nil {
+		return order.Item{}, menu.ErrItemNotFound
+	}
+	selections := make([]menu.Selection, 0, len(line.Options))
+	for _, o := range line.Options {
+		if o.OptionID == nil {
+			return order.Item{}, menu.ErrUnknownOption
+		}
+		selections = append(selections, menu.Selection{
+			OptionID: *o.OptionID,
+			Quantity: o.Quantity,
+		})
+	}
+	return m.line(*line.MenuItemID, selections, line.Quantity, line.Note)
+}
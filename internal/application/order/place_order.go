@@ -0,0 +1,107 @@
+package orderapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/order"
+	"go-ai/internal/domain/restaurant"
+	"go-ai/internal/domain/table"
+
+	"github.com/google/uuid"
+)
+
+type PlaceOrderUseCase struct {
+	repo        order.Repository
+	menus       menu.Repository
+	restaurants restaurant.Repository
+	tables      table.Repository
+	signer      *table.Signer
+}
+
+func NewPlaceOrderUseCase(repo order.Repository, menus menu.Repository, restaurants restaurant.Repository, tables table.Repository, signer *table.Signer) *PlaceOrderUseCase {
+	return &PlaceOrderUseCase{
+		repo:        repo,
+		menus:       menus,
+		restaurants: restaurants,
+		tables:      tables,
+		signer:      signer,
+	}
+}
+
+// Execute places the cart of the customer while the restaurant is open. Every line is priced
+// again from the current menu, so the order is placed at the prices of the moment and fails when
+// an item sold out or a choice left the menu since it was added.
+func (uc *PlaceOrderUseCase) Execute(ctx context.Context, request PlaceOrderRequest, userID uuid.UUID, restaurantID int32) (*OrderResponse, error) {
+	orderType, err := order.ParseType(request.Type)
+	if err != nil {
+		return nil, err
+	}
+	r, err := orderableRestaurant(ctx, uc.restaurants, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	open, err := openNow(ctx, uc.restaurants, r)
+	if err != nil {
+		return nil, err
+	}
+	if !open {
+		return nil, order.ErrRestaurantClosed
+	}
+	cart, err := uc.repo.GetCart(ctx, restaurantID, userID)
+	if err != nil {
+		if errors.Is(err, order.ErrCartNotFound) {
+			return nil, order.ErrEmptyCart
+		}
+		return nil, err
+	}
+	if orderType == order.TypeDineIn && request.TableId != nil {
+		if err := uc.checkTable(ctx, restaurantID, *request.TableId, request.Signature); err != nil {
+			return nil, err
+		}
+	}
+
+	m, err := loadMenu(ctx, uc.menus, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]order.Item, 0, len(cart.Items))
+	for _, line := range cart.Items {
+		priced, err := m.reprice(line)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, priced)
+	}
+	cart.Items = items
+	if err := cart.Place(orderType, request.TableId, request.Note); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Place(ctx, cart); err != nil {
+		return nil, err
+	}
+	placed, err := uc.repo.Get(ctx, cart.ID)
+	if err != nil {
+		return nil, err
+	}
+	return toOrderResponse(placed), nil
+}
+
+// checkTable verifies the table of a dine-in order against the signature of its QR code link. A
+// deleted or inactive table reads as table.ErrInvalidTableLink too.
+func (uc *PlaceOrderUseCase) checkTable(ctx context.Context, restaurantID, tableID int32, signature string) error {
+	if !uc.signer.Verify(restaurantID, tableID, signature) {
+		return table.ErrInvalidTableLink
+	}
+	t, err := uc.tables.Get(ctx, restaurantID, tableID)
+	if err != nil {
+		if errors.Is(err, table.ErrTableNotFound) {
+			return table.ErrInvalidTableLink
+		}
+		return err
+	}
+	if !t.IsActive {
+		return table.ErrInvalidTableLink
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package orderapp
+
+import (
+	"context"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/order"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type UpdateCartItemUseCase struct {
+	repo        order.Repository
+	menus       menu.Repository
+	restaurants restaurant.Repository
+}
+
+func NewUpdateCartItemUseCase(repo order.Repository, menus menu.Repository, restaurants restaurant.Repository) *UpdateCartItemUseCase {
+	return &UpdateCartItemUseCase{
+		repo:        repo,
+		menus:       menus,
+		restaurants: restaurants,
+	}
+}
+
+// Execute replaces a line of the cart of the customer, priced again from the menu. It returns the
+// updated cart.
+func (uc *UpdateCartItemUseCase) Execute(ctx context.Context, request CartItemRequest, userID uuid.UUID, restaurantID int32, itemID int64) (*OrderResponse, error) {
+	if _, err := orderableRestaurant(ctx, uc.restaurants, restaurantID); err != nil {
+		return nil, err
+	}
+	m, err := loadMenu(ctx, uc.menus, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	line, err := m.line(request.MenuItemId, toSelections(request.Options), request.Quantity, request.Note)
+	if err != nil {
+		return nil, err
+	}
+	line.ID = itemID
+	if err := uc.repo.UpdateCartItem(ctx, restaurantID, userID, &line); err != nil {
+		return nil, err
+	}
+	cart, err := uc.repo.GetCart(ctx, restaurantID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toOrderResponse(cart), nil
+}
@@ -0,0 +1,52 @@
+package orderapp
+
+import (
+	"context"
+	"go-ai/internal/domain/order"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type UpdateOrderStatusUseCase struct {
+	repo        order.Repository
+	restaurants restaurant.Repository
+}
+
+func NewUpdateOrderStatusUseCase(repo order.Repository, restaurants restaurant.Repository) *UpdateOrderStatusUseCase {
+	return &UpdateOrderStatusUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+// Execute moves an order of the restaurant along its lifecycle on behalf of a member. Two members
+// moving the same order at once cannot both succeed; the later one gets order.ErrOrderChanged.
+func (uc *UpdateOrderStatusUseCase) Execute(ctx context.Context, request UpdateOrderStatusRequest, userID uuid.UUID, restaurantID int32, id int64) (*OrderResponse, error) {
+	next, err := order.ParseStatus(request.Status)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeStaff(ctx, uc.restaurants, restaurantID, userID); err != nil {
+		return nil, err
+	}
+	o, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if o.RestaurantID != restaurantID || o.Status == order.StatusCart {
+		return nil, order.ErrOrderNotFound
+	}
+	from := o.Status
+	if err := o.Move(next, order.ActorStaff, request.Reason); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.UpdateStatus(ctx, o, from); err != nil {
+		return nil, err
+	}
+	updated, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toOrderResponse(updated), nil
+}
@@ -0,0 +1,43 @@
+package restaurantapp
+
+import (
+	"context"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type AddMemberUseCase struct {
+	repo restaurant.Repository
+}
+
+func NewAddMemberUseCase(repo restaurant.Repository) *AddMemberUseCase {
+	return &AddMemberUseCase{
+		repo: repo,
+	}
+}
+
+// Execute adds a manager or staff member to the restaurant, or changes the role of an existing one.
+func (uc *AddMemberUseCase) Execute(ctx context.Context, request AddMemberRequest, userID uuid.UUID, id int32) error {
+	if request.UserId == uuid.Nil {
+		return restaurant.ErrMemberNotFound
+	}
+	role, err := restaurant.ParseMemberRole(request.Role)
+	if err != nil {
+		return err
+	}
+	if role == restaurant.MemberRoleOwner {
+		return restaurant.ErrInvalidMemberRole
+	}
+	if _, err := authorizeMember(ctx, uc.repo, id, userID, restaurant.MemberRole.CanManageMembers); err != nil {
+		return err
+	}
+	if request.UserId == userID {
+		return restaurant.ErrCannotChangeOwnRole
+	}
+	return uc.repo.UpsertMember(ctx, &restaurant.Member{
+		RestaurantID: id,
+		UserID:       request.UserId,
+		Role:         role,
+	})
+}
@@ -0,0 +1,39 @@
+package restaurantapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// authorizeMember returns the membership of userID in the restaurant when allowed accepts its role,
+// and restaurant.ErrRestaurantForbidden when the user is not a member or the role is not enough.
+func authorizeMember(ctx context.Context, repo restaurant.Repository, restaurantID int32, userID uuid.UUID, allowed func(restaurant.MemberRole) bool) (*restaurant.Member, error) {
+	member, err := repo.GetMember(ctx, restaurantID, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, restaurant.ErrRestaurantForbidden
+		}
+		return nil, err
+	}
+	if !allowed(member.Role) {
+		return nil, restaurant.ErrRestaurantForbidden
+	}
+	return member, nil
+}
+
+// authorizeViewer returns restaurant.ErrRestaurantNoExitis when r is not public and the viewer is
+// neither a reviewer nor a member, so hidden restaurants cannot be told from missing ones.
+func authorizeViewer(ctx context.Context, repo restaurant.Repository, r *restaurant.Entity, viewer Viewer) error {
+	if r.Status.IsPublic() || viewer.Reviewer {
+		return nil
+	}
+	_, err := authorizeMember(ctx, repo, r.ID, viewer.UserID, func(restaurant.MemberRole) bool { return true })
+	if errors.Is(err, restaurant.ErrRestaurantForbidden) {
+		return restaurant.ErrRestaurantNoExitis
+	}
+	return err
+}
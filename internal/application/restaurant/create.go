@@ -3,6 +3,7 @@ package restaurantapp
 import (
 	"context"
 	"errors"
+	"go-ai/internal/config"
 	"go-ai/internal/domain/restaurant"
 	"go-ai/internal/transport/http/status"
 	"strings"
@@ -12,12 +13,14 @@ import (
 )
 
 type CreateRestaurantUseCase struct {
-	repo restaurant.Repository
+	repo     restaurant.Repository
+	geocoder restaurant.Geocoder
 }
 
-func NewCreateRestaurantUseCase(repo restaurant.Repository) *CreateRestaurantUseCase {
+func NewCreateRestaurantUseCase(repo restaurant.Repository, geocoder restaurant.Geocoder) *CreateRestaurantUseCase {
 	return &CreateRestaurantUseCase{
-		repo: repo,
+		repo:     repo,
+		geocoder: geocoder,
 	}
 }
 
@@ -43,6 +46,19 @@ func (uc *CreateRestaurantUseCase) Execute(ctx context.Context, request CreateRe
 	if request.PhoneNumber == "" {
 		return 0, restaurant.ErrInvalidPhoneNumber
 	}
+	location, err := requestLocation(request.RestaurantBase)
+	if err != nil {
+		return 0, err
+	}
+	config, _ := config.LoadConfig()
+	timezone, err := requestTimezone(request.Timezone, config.DefaultTimezone)
+	if err != nil {
+		return 0, err
+	}
+	hours, err := toHours(request.Hours)
+	if err != nil {
+		return 0, err
+	}
 
 	record, err := uc.repo.GetByName(ctx, request.Name)
 	if err != nil {
@@ -53,13 +69,8 @@ func (uc *CreateRestaurantUseCase) Execute(ctx context.Context, request CreateRe
 	if record != nil {
 		return 0, restaurant.ErrRestaurantNameExitis
 	}
-	hours := make([]restaurant.Hours, 0, len(request.Hours))
-	for _, hour := range request.Hours {
-		hours = append(hours, restaurant.Hours{
-			Day:       hour.Day,
-			OpenTime:  hour.OpenTime,
-			CloseTime: hour.CloseTime,
-		})
+	if location == nil {
+		location = geocodeAddress(ctx, uc.geocoder, request.RestaurantBase)
 	}
 	id, err := uc.repo.Create(ctx, &restaurant.Entity{
 		Email:       request.Email,
@@ -70,10 +81,12 @@ func (uc *CreateRestaurantUseCase) Execute(ctx context.Context, request CreateRe
 		LogoUrl:     request.LogoUrl,
 		BannerUrl:   request.BannerUrl,
 		PhoneNumber: request.PhoneNumber,
-		Address:     request.PhoneNumber,
+		Address:     request.Address,
 		City:        request.City,
 		District:    request.District,
 		UserID:      userID,
+		Location:    location,
+		Timezone:    timezone,
 		Hours:       hours,
 	})
 	if err != nil {
@@ -0,0 +1,35 @@
+package restaurantapp
+
+import (
+	"context"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type CreateSpecialHoursUseCase struct {
+	repo restaurant.Repository
+}
+
+func NewCreateSpecialHoursUseCase(repo restaurant.Repository) *CreateSpecialHoursUseCase {
+	return &CreateSpecialHoursUseCase{
+		repo: repo,
+	}
+}
+
+func (uc *CreateSpecialHoursUseCase) Execute(ctx context.Context, request SpecialHoursRequest, userID uuid.UUID, id int32) (*CreateSpecialHoursResponse, error) {
+	special, err := toSpecialHours(request, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := authorizeMember(ctx, uc.repo, id, userID, restaurant.MemberRole.CanUpdate); err != nil {
+		return nil, err
+	}
+	specialID, err := uc.repo.CreateSpecialHours(ctx, special)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateSpecialHoursResponse{
+		Id: specialID,
+	}, nil
+}
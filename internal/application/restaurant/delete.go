@@ -2,7 +2,11 @@ package restaurantapp
 
 import (
 	"context"
+	"errors"
 	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type DeleteUseCase struct {
@@ -15,7 +19,16 @@ func NewDeleteUseCase(repo restaurant.Repository) *DeleteUseCase {
 	}
 }
 
-func (uc *DeleteUseCase) Execute(ctx context.Context, id int32) error {
+func (uc *DeleteUseCase) Execute(ctx context.Context, id int32, userID uuid.UUID) error {
+	if _, err := uc.repo.GetById(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return restaurant.ErrRestaurantNoExitis
+		}
+		return err
+	}
+	if _, err := authorizeMember(ctx, uc.repo, id, userID, restaurant.MemberRole.CanDelete); err != nil {
+		return err
+	}
 	err := uc.repo.Delete(ctx, id)
 	if err != nil {
 		return err
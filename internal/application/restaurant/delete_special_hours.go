@@ -0,0 +1,25 @@
+package restaurantapp
+
+import (
+	"context"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type DeleteSpecialHoursUseCase struct {
+	repo restaurant.Repository
+}
+
+func NewDeleteSpecialHoursUseCase(repo restaurant.Repository) *DeleteSpecialHoursUseCase {
+	return &DeleteSpecialHoursUseCase{
+		repo: repo,
+	}
+}
+
+func (uc *DeleteSpecialHoursUseCase) Execute(ctx context.Context, userID uuid.UUID, id, specialID int32) error {
+	if _, err := authorizeMember(ctx, uc.repo, id, userID, restaurant.MemberRole.CanUpdate); err != nil {
+		return err
+	}
+	return uc.repo.DeleteSpecialHours(ctx, id, specialID)
+}
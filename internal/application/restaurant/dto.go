@@ -1,6 +1,11 @@
 package restaurantapp
 
-import "go-ai/internal/domain/restaurant"
+import (
+	"go-ai/internal/domain/restaurant"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type RestaurantBase struct {
 	Name        string `json:"name"`
@@ -14,13 +19,22 @@ type RestaurantBase struct {
 	PhoneNumber string `json:"phone_number"`
 	WebsiteUrl  string `json:"website_url"`
 	Email       string `json:"email"`
+	// Latitude and Longitude locate the restaurant; when omitted the address is geocoded
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+	// Timezone is an IANA name such as Asia/Ho_Chi_Minh the hours are read in, DEFAULT_TIMEZONE
+	// when omitted
+	Timezone string `json:"timezone,omitempty"`
 }
 
+// RestaurantHoursBase is one shift; a day may have several. A close_time at or before open_time
+// closes the next day, NextDay is set on responses to say so and ignored on requests.
 type RestaurantHoursBase struct {
 	Day       restaurant.DayOfWeek `json:"day"`
 	OpenTime  string               `json:"open_time"`
 	CloseTime string               `json:"close_time"`
-	// NextDay   bool                 `json:"next_day"`
+	IsClosed  bool                 `json:"is_closed"`
+	NextDay   bool                 `json:"next_day"`
 }
 
 type CreateRestaurantRequest struct {
@@ -38,12 +52,195 @@ type GetRestaurantByIDRequest struct {
 
 type GetRestaurantByIDResponse struct {
 	RestaurantBase
-	UserName string                `json:"user_name"`
-	IsActive bool                  `json:"is_active"`
-	Hours    []RestaurantHoursBase `json:"hours"`
+	// Slug names the public page of the restaurant, see /api/public/restaurants/{slug}
+	Slug     string `json:"slug"`
+	UserName string `json:"user_name"`
+	Status   string `json:"status"`
+	// StatusReason is the reviewer note on the last rejection or suspension
+	StatusReason string `json:"status_reason,omitempty"`
+	IsOpen       bool   `json:"is_open"`
+	// ClosesAt is set while open, NextOpenAt when another opening is due within a week
+	ClosesAt   *time.Time            `json:"closes_at,omitempty"`
+	NextOpenAt *time.Time            `json:"next_open_at,omitempty"`
+	Hours      []RestaurantHoursBase `json:"hours"`
+	// SpecialHours are the current and upcoming overrides of Hours
+	SpecialHours []SpecialHoursResponse `json:"special_hours"`
+}
+
+// PublicRestaurantResponse is the page of an active restaurant anyone may read. It leaves out the
+// owner and whether the restaurant is open right now so it only changes with the data, and at
+// midnight when past special hours drop off. LastModified is the latest of these times.
+type PublicRestaurantResponse struct {
+	Slug string `json:"slug"`
+	RestaurantBase
+	Hours []RestaurantHoursBase `json:"hours"`
+	// SpecialHours are the current and upcoming overrides of Hours
+	SpecialHours []SpecialHoursResponse `json:"special_hours"`
+	LastModified time.Time              `json:"last_modified"`
 }
 
 type UpdateRestaurantRequest struct {
 	RestaurantBase
 	Hours []RestaurantHoursBase `json:"hours"`
 }
+
+type AddMemberRequest struct {
+	UserId uuid.UUID `json:"user_id"`
+	Role   string    `json:"role"`
+}
+
+type MemberResponse struct {
+	UserId    uuid.UUID `json:"user_id"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ListMembersResponse struct {
+	Members []MemberResponse `json:"members"`
+}
+
+// Viewer is who reads restaurants. Restaurants that are not active are only shown to their members
+// and to reviewers.
+type Viewer struct {
+	UserID   uuid.UUID
+	Reviewer bool
+}
+
+// ListRestaurantsRequest lists active restaurants unless Status says otherwise. Other statuses
+// need the viewer to list their own restaurants through OwnerId, or to be a reviewer; for them an
+// empty Status lists every status.
+type ListRestaurantsRequest struct {
+	Viewer   Viewer
+	Status   string
+	Category string
+	City     string
+	District string
+	OwnerId  *uuid.UUID
+	OpenNow  bool
+	Sort     string
+	Cursor   string
+	Limit    int
+}
+
+type RestaurantSummaryResponse struct {
+	Id          int32     `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Address     string    `json:"address"`
+	Category    string    `json:"category"`
+	City        string    `json:"city"`
+	District    string    `json:"district"`
+	LogoUrl     string    `json:"logo_url"`
+	BannerUrl   string    `json:"banner_url"`
+	Latitude    *float64  `json:"latitude,omitempty"`
+	Longitude   *float64  `json:"longitude,omitempty"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ListRestaurantsResponse is one page of restaurants. The paging fields go to the meta of the
+// response envelope rather than into data.
+type ListRestaurantsResponse struct {
+	Restaurants []RestaurantSummaryResponse `json:"restaurants"`
+	Limit       int                         `json:"-"`
+	NextCursor  string                      `json:"-"`
+	HasMore     bool                        `json:"-"`
+}
+
+type SearchRestaurantsRequest struct {
+	Query    string
+	Category string
+	City     string
+	Cursor   string
+	Limit    int
+}
+
+type RestaurantSearchResult struct {
+	RestaurantSummaryResponse
+	Rank float32 `json:"rank"`
+	// NameHighlight and Snippet are HTML escaped with the matched words wrapped in <mark>
+	NameHighlight string `json:"name_highlight"`
+	Snippet       string `json:"snippet"`
+}
+
+type SearchRestaurantsResponse struct {
+	Results    []RestaurantSearchResult `json:"results"`
+	Limit      int                      `json:"-"`
+	NextCursor string                   `json:"-"`
+	HasMore    bool                     `json:"-"`
+}
+
+type NearbyRestaurantsRequest struct {
+	Latitude     *float64
+	Longitude    *float64
+	RadiusMeters int
+	Category     string
+	OpenNow      bool
+	Limit        int
+}
+
+type NearbyRestaurant struct {
+	RestaurantSummaryResponse
+	DistanceMeters float64 `json:"distance_m"`
+}
+
+// NearbyRestaurantsResponse lists the restaurants nearest first. There is no cursor, HasMore tells
+// that more restaurants are within the radius than the limit.
+type NearbyRestaurantsResponse struct {
+	Restaurants []NearbyRestaurant `json:"restaurants"`
+	Limit       int                `json:"-"`
+	HasMore     bool               `json:"-"`
+}
+
+// SpecialHoursRequest overrides the weekly hours from start_date to end_date inclusive, dates as
+// YYYY-MM-DD. end_date defaults to start_date. Without is_closed, open_time and close_time give
+// the alternate shift; add several rules for several shifts on the same days.
+type SpecialHoursRequest struct {
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	IsClosed  bool   `json:"is_closed"`
+	OpenTime  string `json:"open_time"`
+	CloseTime string `json:"close_time"`
+	Note      string `json:"note"`
+}
+
+type SpecialHoursResponse struct {
+	Id        int32  `json:"id"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	IsClosed  bool   `json:"is_closed"`
+	OpenTime  string `json:"open_time,omitempty"`
+	CloseTime string `json:"close_time,omitempty"`
+	NextDay   bool   `json:"next_day"`
+	Note      string `json:"note,omitempty"`
+}
+
+type ListSpecialHoursRequest struct {
+	// Upcoming leaves out the rules that ended before today
+	Upcoming bool
+}
+
+type ListSpecialHoursResponse struct {
+	SpecialHours []SpecialHoursResponse `json:"special_hours"`
+}
+
+type CreateSpecialHoursResponse struct {
+	Id int32 `json:"id"`
+}
+
+// UpdateStatusRequest moves a restaurant along its lifecycle: draft, pending_review, active,
+// suspended, closed.
+type UpdateStatusRequest struct {
+	Status string `json:"status"`
+}
+
+// ReviewRestaurantRequest is a reviewer decision. Reason is required to reject (back to draft) or
+// suspend, and shown to the restaurant members.
+type ReviewRestaurantRequest struct {
+	Status string `json:"status"`
+	Reason string `json:"reason"`
+}
+
+type SetDayClosedRequest struct {
+	IsClosed bool `json:"is_closed"`
+}
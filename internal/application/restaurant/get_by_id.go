@@ -2,46 +2,70 @@ package restaurantapp
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"go-ai/internal/domain/auth"
 	"go-ai/internal/domain/restaurant"
 	"go-ai/internal/infra/cache"
 	"go-ai/internal/transport/http/status"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type GetByIDUseCase struct {
 	repo  restaurant.Repository
+	users auth.Repository
 	cache *cache.AuthCache
 }
 
-func NewGetByIDUseCase(repo restaurant.Repository, cache *cache.AuthCache) *GetByIDUseCase {
+func NewGetByIDUseCase(repo restaurant.Repository, users auth.Repository, cache *cache.AuthCache) *GetByIDUseCase {
 	return &GetByIDUseCase{
 		repo:  repo,
+		users: users,
 		cache: cache,
 	}
 }
 
-func (uc *GetByIDUseCase) Execute(ctx context.Context, id int32) (*GetRestaurantByIDResponse, error) {
+func (uc *GetByIDUseCase) Execute(ctx context.Context, id int32, viewer Viewer) (*GetRestaurantByIDResponse, error) {
 	if id == 0 {
 		return nil, status.ErrInvalidField
 	}
 	record, err := uc.repo.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, restaurant.ErrRestaurantNoExitis
+		}
+		return nil, err
+	}
+	if err := authorizeViewer(ctx, uc.repo, record, viewer); err != nil {
+		return nil, err
+	}
+	userName, err := uc.ownerName(ctx, record.UserID)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := restaurantLocation(record)
 	if err != nil {
 		return nil, err
 	}
-	key := fmt.Sprintf("profile_%s", record.UserID.String())
-	profile, err := uc.cache.GetAuthCache(key)
+	now := time.Now().In(loc)
+	// from yesterday, its overnight shift may still be running
+	from := restaurant.DateOf(now).AddDate(0, 0, -1)
+	special, err := uc.repo.ListSpecialHours(ctx, id, &from)
 	if err != nil {
 		return nil, err
 	}
-	hours := make([]RestaurantHoursBase, 0, len(record.Hours))
-	for _, hour := range record.Hours {
-		hours = append(hours, RestaurantHoursBase{
-			Day:       hour.Day,
-			OpenTime:  hour.OpenTime,
-			CloseTime: hour.CloseTime,
-		})
+	schedule := restaurant.NewSchedule(record.Hours, special, loc)
+	today := restaurant.DateOf(now)
+	specialResp := make([]SpecialHoursResponse, 0, len(special))
+	for _, sh := range special {
+		if !sh.EndDate.Before(today) {
+			specialResp = append(specialResp, toSpecialHoursResponse(&sh))
+		}
 	}
-	return &GetRestaurantByIDResponse{
+	resp := &GetRestaurantByIDResponse{
 		RestaurantBase: RestaurantBase{
 			Name:        record.Name,
 			Description: record.Description,
@@ -54,9 +78,40 @@ func (uc *GetByIDUseCase) Execute(ctx context.Context, id int32) (*GetRestaurant
 			PhoneNumber: record.PhoneNumber,
 			WebsiteUrl:  record.WebsiteUrl,
 			Email:       record.Email,
+			Latitude:    latitudeOf(record.Location),
+			Longitude:   longitudeOf(record.Location),
+			Timezone:    record.Timezone,
 		},
-		Hours:    hours,
-		IsActive: len(hours) > 0,
-		UserName: profile.FullName,
-	}, nil
+		Hours:        toHoursResponse(record.Hours),
+		SpecialHours: specialResp,
+		Slug:         restaurant.Slug(record.ID, record.Name),
+		UserName:     userName,
+		Status:       string(record.Status),
+		StatusReason: record.StatusReason,
+	}
+	resp.IsOpen = schedule.IsOpenAt(now)
+	if closesAt, ok := schedule.ClosesAt(now); ok {
+		resp.ClosesAt = &closesAt
+	}
+	if nextOpenAt, ok := schedule.NextOpening(now); ok {
+		resp.NextOpenAt = &nextOpenAt
+	}
+	return resp, nil
+}
+
+// ownerName reads the name of the owner from the profile cache, which only holds users that signed
+// in lately, falling back to the user record. An owner that no longer exists has no name.
+func (uc *GetByIDUseCase) ownerName(ctx context.Context, userID uuid.UUID) (string, error) {
+	profile, err := uc.cache.GetAuthCache(fmt.Sprintf("profile_%s", userID.String()))
+	if err == nil && profile != nil {
+		return profile.FullName, nil
+	}
+	record, err := uc.users.GetById(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+	return record.FullName, nil
 }
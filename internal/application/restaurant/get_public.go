@@ -0,0 +1,89 @@
+package restaurantapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/domain/restaurant"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type GetPublicUseCase struct {
+	repo restaurant.Repository
+}
+
+func NewGetPublicUseCase(repo restaurant.Repository) *GetPublicUseCase {
+	return &GetPublicUseCase{
+		repo: repo,
+	}
+}
+
+// Execute returns the public page of the restaurant a slug points to. Restaurants that are not
+// active read as restaurant.ErrRestaurantNoExitis. The slug in the response is the canonical one,
+// it differs from the requested slug after a rename.
+func (uc *GetPublicUseCase) Execute(ctx context.Context, slug string) (*PublicRestaurantResponse, error) {
+	record, err := publicRestaurant(ctx, uc.repo, slug)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := restaurantLocation(record)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().In(loc)
+	today := restaurant.DateOf(now)
+	special, err := uc.repo.ListSpecialHours(ctx, record.ID, &today)
+	if err != nil {
+		return nil, err
+	}
+	specialResp := make([]SpecialHoursResponse, 0, len(special))
+	for _, sh := range special {
+		specialResp = append(specialResp, toSpecialHoursResponse(&sh))
+	}
+	lastModified := record.UpdatedAt
+	if midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc); midnight.After(lastModified) {
+		lastModified = midnight
+	}
+	return &PublicRestaurantResponse{
+		Slug: restaurant.Slug(record.ID, record.Name),
+		RestaurantBase: RestaurantBase{
+			Name:        record.Name,
+			Description: record.Description,
+			Address:     record.Address,
+			Category:    record.Category,
+			City:        record.City,
+			District:    record.District,
+			LogoUrl:     record.LogoUrl,
+			BannerUrl:   record.BannerUrl,
+			PhoneNumber: record.PhoneNumber,
+			WebsiteUrl:  record.WebsiteUrl,
+			Email:       record.Email,
+			Latitude:    latitudeOf(record.Location),
+			Longitude:   longitudeOf(record.Location),
+			Timezone:    record.Timezone,
+		},
+		Hours:        toHoursResponse(record.Hours),
+		SpecialHours: specialResp,
+		LastModified: lastModified,
+	}, nil
+}
+
+// publicRestaurant loads the restaurant a slug points to when it is public.
+func publicRestaurant(ctx context.Context, repo restaurant.Repository, slug string) (*restaurant.Entity, error) {
+	id, ok := restaurant.ParseSlug(slug)
+	if !ok {
+		return nil, restaurant.ErrRestaurantNoExitis
+	}
+	record, err := repo.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, restaurant.ErrRestaurantNoExitis
+		}
+		return nil, err
+	}
+	if !record.Status.IsPublic() {
+		return nil, restaurant.ErrRestaurantNoExitis
+	}
+	return record, nil
+}
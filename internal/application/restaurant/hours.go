@@ -0,0 +1,105 @@
+package restaurantapp
+
+import (
+	"go-ai/internal/config"
+	"go-ai/internal/domain/restaurant"
+	"strings"
+	"time"
+)
+
+// toHours validates the shifts of a request against each other.
+func toHours(request []RestaurantHoursBase) ([]restaurant.Hours, error) {
+	hours := make([]restaurant.Hours, 0, len(request))
+	for _, hour := range request {
+		hours = append(hours, restaurant.Hours{
+			Day:       hour.Day,
+			OpenTime:  hour.OpenTime,
+			CloseTime: hour.CloseTime,
+			IsClosed:  hour.IsClosed,
+		})
+	}
+	if err := restaurant.ValidateHours(hours); err != nil {
+		return nil, err
+	}
+	return hours, nil
+}
+
+func toHoursResponse(hours []restaurant.Hours) []RestaurantHoursBase {
+	resp := make([]RestaurantHoursBase, 0, len(hours))
+	for _, hour := range hours {
+		resp = append(resp, RestaurantHoursBase{
+			Day:       hour.Day,
+			OpenTime:  hour.OpenTime,
+			CloseTime: hour.CloseTime,
+			IsClosed:  hour.IsClosed,
+			NextDay:   hour.Overnight(),
+		})
+	}
+	return resp
+}
+
+// requestTimezone checks the timezone of a request, fallback is used when it has none.
+func requestTimezone(tz, fallback string) (string, error) {
+	tz = strings.TrimSpace(tz)
+	if tz == "" {
+		return fallback, nil
+	}
+	if _, err := time.LoadLocation(tz); err != nil {
+		return "", restaurant.ErrInvalidTimezone
+	}
+	return tz, nil
+}
+
+// restaurantLocation is the timezone the hours of a restaurant are read in, DEFAULT_TIMEZONE for a
+// restaurant saved with one this server does not know.
+func restaurantLocation(r *restaurant.Entity) (*time.Location, error) {
+	if loc, err := time.LoadLocation(r.Timezone); err == nil && r.Timezone != "" {
+		return loc, nil
+	}
+	config, _ := config.LoadConfig()
+	return time.LoadLocation(config.DefaultTimezone)
+}
+
+// toSpecialHours reads a special hours request; an end date defaults to the start date and a
+// closed rule keeps no times.
+func toSpecialHours(request SpecialHoursRequest, restaurantID int32) (*restaurant.SpecialHours, error) {
+	start, err := time.Parse(time.DateOnly, request.StartDate)
+	if err != nil {
+		return nil, restaurant.ErrInvalidSpecialHours
+	}
+	end := start
+	if request.EndDate != "" {
+		end, err = time.Parse(time.DateOnly, request.EndDate)
+		if err != nil {
+			return nil, restaurant.ErrInvalidSpecialHours
+		}
+	}
+	special := &restaurant.SpecialHours{
+		RestaurantID: restaurantID,
+		StartDate:    start,
+		EndDate:      end,
+		IsClosed:     request.IsClosed,
+		Note:         strings.TrimSpace(request.Note),
+	}
+	if !request.IsClosed {
+		special.OpenTime = request.OpenTime
+		special.CloseTime = request.CloseTime
+	}
+	if err := special.Validate(); err != nil {
+		return nil, err
+	}
+	return special, nil
+}
+
+func toSpecialHoursResponse(s *restaurant.SpecialHours) SpecialHoursResponse {
+	return SpecialHoursResponse{
+		Id:        s.ID,
+		StartDate: s.StartDate.Format(time.DateOnly),
+		EndDate:   s.EndDate.Format(time.DateOnly),
+		IsClosed:  s.IsClosed,
+		OpenTime:  s.OpenTime,
+		CloseTime: s.CloseTime,
+		NextDay:   s.Overnight(),
+		Note:      s.Note,
+	}
+}
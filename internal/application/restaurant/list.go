@@ -0,0 +1,170 @@
+package restaurantapp
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"go-ai/internal/domain/restaurant"
+	"strings"
+	"time"
+)
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+type ListRestaurantsUseCase struct {
+	repo restaurant.Repository
+}
+
+func NewListRestaurantsUseCase(repo restaurant.Repository) *ListRestaurantsUseCase {
+	return &ListRestaurantsUseCase{
+		repo: repo,
+	}
+}
+
+// Execute returns one page of restaurants using keyset pagination: the cursor holds the sort
+// position of the last restaurant of the previous page, so pages stay stable while restaurants
+// are added.
+func (uc *ListRestaurantsUseCase) Execute(ctx context.Context, request ListRestaurantsRequest) (*ListRestaurantsResponse, error) {
+	sort, err := restaurant.ParseListSort(request.Sort)
+	if err != nil {
+		return nil, err
+	}
+	limit := request.Limit
+	if limit < 1 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+	statuses, err := listStatuses(request)
+	if err != nil {
+		return nil, err
+	}
+	filter := restaurant.ListFilter{
+		Statuses: statuses,
+		Category: strings.TrimSpace(request.Category),
+		City:     strings.TrimSpace(request.City),
+		District: strings.TrimSpace(request.District),
+		OwnerID:  request.OwnerId,
+		Sort:     sort,
+		// one extra row tells whether there is a next page
+		Limit: int32(limit + 1),
+	}
+	if request.Cursor != "" {
+		after, err := decodeListCursor(request.Cursor, sort)
+		if err != nil {
+			return nil, err
+		}
+		filter.After = after
+	}
+	if request.OpenNow {
+		now := time.Now()
+		filter.OpenAt = &now
+	}
+	records, err := uc.repo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	hasMore := len(records) > limit
+	if hasMore {
+		records = records[:limit]
+	}
+	restaurants := make([]RestaurantSummaryResponse, 0, len(records))
+	for _, r := range records {
+		restaurants = append(restaurants, toRestaurantSummary(&r))
+	}
+	resp := &ListRestaurantsResponse{
+		Restaurants: restaurants,
+		Limit:       limit,
+		HasMore:     hasMore,
+	}
+	if hasMore {
+		last := records[len(records)-1]
+		resp.NextCursor = encodeListCursor(sort, restaurant.ListCursor{
+			ID:        last.ID,
+			Name:      last.Name,
+			CreatedAt: last.CreatedAt,
+		})
+	}
+	return resp, nil
+}
+
+func toRestaurantSummary(r *restaurant.Entity) RestaurantSummaryResponse {
+	return RestaurantSummaryResponse{
+		Id:          r.ID,
+		Name:        r.Name,
+		Description: r.Description,
+		Address:     r.Address,
+		Category:    r.Category,
+		City:        r.City,
+		District:    r.District,
+		LogoUrl:     r.LogoUrl,
+		BannerUrl:   r.BannerUrl,
+		Latitude:    latitudeOf(r.Location),
+		Longitude:   longitudeOf(r.Location),
+		Status:      string(r.Status),
+		CreatedAt:   r.CreatedAt,
+	}
+}
+
+// listStatuses returns the statuses a list may show. Restaurants that are not public are listed
+// to reviewers, and to owners listing their own restaurants; anyone else asking for them is
+// refused rather than silently given active restaurants.
+func listStatuses(request ListRestaurantsRequest) ([]restaurant.Status, error) {
+	privileged := request.Viewer.Reviewer ||
+		(request.OwnerId != nil && *request.OwnerId == request.Viewer.UserID)
+	if request.Status == "" {
+		if privileged {
+			return restaurant.Statuses(), nil
+		}
+		return []restaurant.Status{restaurant.StatusActive}, nil
+	}
+	status, err := restaurant.ParseStatus(request.Status)
+	if err != nil {
+		return nil, err
+	}
+	if !status.IsPublic() && !privileged {
+		return nil, restaurant.ErrRestaurantForbidden
+	}
+	return []restaurant.Status{status}, nil
+}
+
+type listCursor struct {
+	Sort      restaurant.ListSort `json:"s"`
+	ID        int32               `json:"i"`
+	Name      string              `json:"n,omitempty"`
+	CreatedAt time.Time           `json:"c"`
+}
+
+func encodeListCursor(sort restaurant.ListSort, c restaurant.ListCursor) string {
+	b, _ := json.Marshal(listCursor{
+		Sort:      sort,
+		ID:        c.ID,
+		Name:      c.Name,
+		CreatedAt: c.CreatedAt,
+	})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeListCursor rejects cursors made for another sort, their position means nothing there.
+func decodeListCursor(s string, sort restaurant.ListSort) (*restaurant.ListCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, restaurant.ErrInvalidCursor
+	}
+	var c listCursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, restaurant.ErrInvalidCursor
+	}
+	if c.Sort != sort || c.ID <= 0 {
+		return nil, restaurant.ErrInvalidCursor
+	}
+	return &restaurant.ListCursor{
+		ID:        c.ID,
+		Name:      c.Name,
+		CreatedAt: c.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,40 @@
+package restaurantapp
+
+import (
+	"context"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type ListMembersUseCase struct {
+	repo restaurant.Repository
+}
+
+func NewListMembersUseCase(repo restaurant.Repository) *ListMembersUseCase {
+	return &ListMembersUseCase{
+		repo: repo,
+	}
+}
+
+func (uc *ListMembersUseCase) Execute(ctx context.Context, userID uuid.UUID, id int32) (*ListMembersResponse, error) {
+	anyRole := func(restaurant.MemberRole) bool { return true }
+	if _, err := authorizeMember(ctx, uc.repo, id, userID, anyRole); err != nil {
+		return nil, err
+	}
+	records, err := uc.repo.ListMembers(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]MemberResponse, 0, len(records))
+	for _, m := range records {
+		members = append(members, MemberResponse{
+			UserId:    m.UserID,
+			Role:      string(m.Role),
+			CreatedAt: m.CreatedAt,
+		})
+	}
+	return &ListMembersResponse{
+		Members: members,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package restaurantapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/domain/restaurant"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type ListSpecialHoursUseCase struct {
+	repo restaurant.Repository
+}
+
+func NewListSpecialHoursUseCase(repo restaurant.Repository) *ListSpecialHoursUseCase {
+	return &ListSpecialHoursUseCase{
+		repo: repo,
+	}
+}
+
+func (uc *ListSpecialHoursUseCase) Execute(ctx context.Context, request ListSpecialHoursRequest, viewer Viewer, id int32) (*ListSpecialHoursResponse, error) {
+	record, err := uc.repo.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, restaurant.ErrRestaurantNoExitis
+		}
+		return nil, err
+	}
+	if err := authorizeViewer(ctx, uc.repo, record, viewer); err != nil {
+		return nil, err
+	}
+	var from *time.Time
+	if request.Upcoming {
+		loc, err := restaurantLocation(record)
+		if err != nil {
+			return nil, err
+		}
+		today := restaurant.DateOf(time.Now().In(loc))
+		from = &today
+	}
+	records, err := uc.repo.ListSpecialHours(ctx, id, from)
+	if err != nil {
+		return nil, err
+	}
+	special := make([]SpecialHoursResponse, 0, len(records))
+	for _, s := range records {
+		special = append(special, toSpecialHoursResponse(&s))
+	}
+	return &ListSpecialHoursResponse{
+		SpecialHours: special,
+	}, nil
+}
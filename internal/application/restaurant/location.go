@@ -0,0 +1,53 @@
+package restaurantapp
+
+import (
+	"context"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/rs/zerolog"
+)
+
+// requestLocation validates the coordinates sent with a restaurant. Both or neither must be set;
+// no coordinates means the address is geocoded instead.
+func requestLocation(request RestaurantBase) (*restaurant.GeoPoint, error) {
+	if request.Latitude == nil && request.Longitude == nil {
+		return nil, nil
+	}
+	if request.Latitude == nil || request.Longitude == nil {
+		return nil, restaurant.ErrInvalidLocation
+	}
+	p := restaurant.GeoPoint{Latitude: *request.Latitude, Longitude: *request.Longitude}
+	if !p.Valid() {
+		return nil, restaurant.ErrInvalidLocation
+	}
+	return &p, nil
+}
+
+// geocodeAddress locates a restaurant from its address. A restaurant without a location is still
+// valid, it only does not show up in nearby searches, so failures are logged and not returned.
+func geocodeAddress(ctx context.Context, geocoder restaurant.Geocoder, request RestaurantBase) *restaurant.GeoPoint {
+	p, err := geocoder.Geocode(ctx, request.Address, request.District, request.City)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).
+			Str("address", request.Address).
+			Str("district", request.District).
+			Str("city", request.City).
+			Msg("restaurant address could not be geocoded")
+		return nil
+	}
+	return p
+}
+
+func latitudeOf(p *restaurant.GeoPoint) *float64 {
+	if p == nil {
+		return nil
+	}
+	return &p.Latitude
+}
+
+func longitudeOf(p *restaurant.GeoPoint) *float64 {
+	if p == nil {
+		return nil
+	}
+	return &p.Longitude
+}
@@ -0,0 +1,80 @@
+package restaurantapp
+
+import (
+	"context"
+	"go-ai/internal/domain/restaurant"
+	"strings"
+	"time"
+)
+
+const (
+	defaultNearbyRadius = 5000
+	maxNearbyRadius     = 50000
+)
+
+type NearbyRestaurantsUseCase struct {
+	repo restaurant.Repository
+}
+
+func NewNearbyRestaurantsUseCase(repo restaurant.Repository) *NearbyRestaurantsUseCase {
+	return &NearbyRestaurantsUseCase{
+		repo: repo,
+	}
+}
+
+// Execute returns the restaurants within the radius of a point, nearest first. Restaurants
+// without a location never match.
+func (uc *NearbyRestaurantsUseCase) Execute(ctx context.Context, request NearbyRestaurantsRequest) (*NearbyRestaurantsResponse, error) {
+	if request.Latitude == nil || request.Longitude == nil {
+		return nil, restaurant.ErrInvalidLocation
+	}
+	center := restaurant.GeoPoint{Latitude: *request.Latitude, Longitude: *request.Longitude}
+	if !center.Valid() {
+		return nil, restaurant.ErrInvalidLocation
+	}
+	radius := request.RadiusMeters
+	if radius < 1 {
+		radius = defaultNearbyRadius
+	}
+	if radius > maxNearbyRadius {
+		radius = maxNearbyRadius
+	}
+	limit := request.Limit
+	if limit < 1 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+	filter := restaurant.NearbyFilter{
+		Center:       center,
+		RadiusMeters: float64(radius),
+		Category:     strings.TrimSpace(request.Category),
+		// one extra row tells whether there are more restaurants in the radius
+		Limit: int32(limit + 1),
+	}
+	if request.OpenNow {
+		now := time.Now()
+		filter.OpenAt = &now
+	}
+	records, err := uc.repo.ListNearby(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	hasMore := len(records) > limit
+	if hasMore {
+		records = records[:limit]
+	}
+	restaurants := make([]NearbyRestaurant, 0, len(records))
+	for _, r := range records {
+		restaurants = append(restaurants, NearbyRestaurant{
+			RestaurantSummaryResponse: toRestaurantSummary(&r.Restaurant),
+			DistanceMeters:            r.DistanceMeters,
+		})
+	}
+	return &NearbyRestaurantsResponse{
+		Restaurants: restaurants,
+		Limit:       limit,
+		HasMore:     hasMore,
+	}, nil
+}
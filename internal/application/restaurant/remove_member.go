@@ -0,0 +1,37 @@
+package restaurantapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type RemoveMemberUseCase struct {
+	repo restaurant.Repository
+}
+
+func NewRemoveMemberUseCase(repo restaurant.Repository) *RemoveMemberUseCase {
+	return &RemoveMemberUseCase{
+		repo: repo,
+	}
+}
+
+func (uc *RemoveMemberUseCase) Execute(ctx context.Context, userID uuid.UUID, id int32, memberID uuid.UUID) error {
+	if _, err := authorizeMember(ctx, uc.repo, id, userID, restaurant.MemberRole.CanManageMembers); err != nil {
+		return err
+	}
+	member, err := uc.repo.GetMember(ctx, id, memberID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return restaurant.ErrMemberNotFound
+		}
+		return err
+	}
+	if member.Role == restaurant.MemberRoleOwner {
+		return restaurant.ErrCannotRemoveOwner
+	}
+	return uc.repo.DeleteMember(ctx, id, memberID)
+}
@@ -0,0 +1,44 @@
+package restaurantapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/domain/restaurant"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type ReviewRestaurantUseCase struct {
+	repo restaurant.Repository
+}
+
+func NewReviewRestaurantUseCase(repo restaurant.Repository) *ReviewRestaurantUseCase {
+	return &ReviewRestaurantUseCase{
+		repo: repo,
+	}
+}
+
+// Execute applies a reviewer status change: approving or rejecting a submission, suspending,
+// reinstating or closing a restaurant.
+func (uc *ReviewRestaurantUseCase) Execute(ctx context.Context, request ReviewRestaurantRequest, id int32) error {
+	next, err := restaurant.ParseStatus(request.Status)
+	if err != nil {
+		return err
+	}
+	record, err := uc.repo.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return restaurant.ErrRestaurantNoExitis
+		}
+		return err
+	}
+	if err := record.Status.Transition(next, restaurant.ActorReviewer); err != nil {
+		return err
+	}
+	reason := strings.TrimSpace(request.Reason)
+	if reason == "" && record.Status.NeedsReason(next) {
+		return restaurant.ErrStatusReasonRequired
+	}
+	return uc.repo.SetStatus(ctx, id, next, reason, record.Status)
+}
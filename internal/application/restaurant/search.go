@@ -0,0 +1,102 @@
+package restaurantapp
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"go-ai/internal/domain/restaurant"
+	"strings"
+)
+
+// maxSearchOffset stops clients from paging arbitrarily deep into ranked results.
+const maxSearchOffset = 1000
+
+type SearchRestaurantsUseCase struct {
+	repo restaurant.Repository
+}
+
+func NewSearchRestaurantsUseCase(repo restaurant.Repository) *SearchRestaurantsUseCase {
+	return &SearchRestaurantsUseCase{
+		repo: repo,
+	}
+}
+
+// Execute runs a full-text search over name, description, category, address, district and city,
+// best matches first. Ranks change as restaurants are edited, so the cursor is an offset.
+func (uc *SearchRestaurantsUseCase) Execute(ctx context.Context, request SearchRestaurantsRequest) (*SearchRestaurantsResponse, error) {
+	terms := restaurant.SearchTerms(request.Query)
+	if len(terms) == 0 {
+		return nil, restaurant.ErrInvalidSearchQuery
+	}
+	limit := request.Limit
+	if limit < 1 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+	offset := 0
+	if request.Cursor != "" {
+		o, err := decodeSearchCursor(request.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		offset = o
+	}
+	records, err := uc.repo.Search(ctx, restaurant.SearchFilter{
+		Terms:    terms,
+		Category: strings.TrimSpace(request.Category),
+		City:     strings.TrimSpace(request.City),
+		Limit:    int32(limit + 1),
+		Offset:   int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	hasMore := len(records) > limit && offset+limit < maxSearchOffset
+	if len(records) > limit {
+		records = records[:limit]
+	}
+	results := make([]RestaurantSearchResult, 0, len(records))
+	for _, r := range records {
+		results = append(results, RestaurantSearchResult{
+			RestaurantSummaryResponse: toRestaurantSummary(&r.Restaurant),
+			Rank:                      r.Rank,
+			NameHighlight:             r.NameHighlight,
+			Snippet:                   r.Snippet,
+		})
+	}
+	resp := &SearchRestaurantsResponse{
+		Results: results,
+		Limit:   limit,
+		HasMore: hasMore,
+	}
+	if hasMore {
+		resp.NextCursor = encodeSearchCursor(offset + limit)
+	}
+	return resp, nil
+}
+
+type searchCursor struct {
+	Offset int `json:"o"`
+}
+
+func encodeSearchCursor(offset int) string {
+	b, _ := json.Marshal(searchCursor{Offset: offset})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeSearchCursor(s string) (int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return 0, restaurant.ErrInvalidCursor
+	}
+	var c searchCursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return 0, restaurant.ErrInvalidCursor
+	}
+	if c.Offset < 0 || c.Offset >= maxSearchOffset {
+		return 0, restaurant.ErrInvalidCursor
+	}
+	return c.Offset, nil
+}
@@ -0,0 +1,26 @@
+package restaurantapp
+
+import (
+	"context"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type SetDayClosedUseCase struct {
+	repo restaurant.Repository
+}
+
+func NewSetDayClosedUseCase(repo restaurant.Repository) *SetDayClosedUseCase {
+	return &SetDayClosedUseCase{
+		repo: repo,
+	}
+}
+
+// Execute closes or reopens every shift of one day of the week, keeping their times.
+func (uc *SetDayClosedUseCase) Execute(ctx context.Context, request SetDayClosedRequest, userID uuid.UUID, id int32, day restaurant.DayOfWeek) error {
+	if _, err := authorizeMember(ctx, uc.repo, id, userID, restaurant.MemberRole.CanUpdate); err != nil {
+		return err
+	}
+	return uc.repo.SetDayClosed(ctx, id, day, request.IsClosed)
+}
@@ -2,20 +2,24 @@ package restaurantapp
 
 import (
 	"context"
+	"errors"
 	"go-ai/internal/domain/restaurant"
 	"go-ai/internal/transport/http/status"
 	"strings"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type UpdateRestaurantUseCase struct {
-	repo restaurant.Repository
+	repo     restaurant.Repository
+	geocoder restaurant.Geocoder
 }
 
-func NewUpdateRestaurantUseCase(repo restaurant.Repository) *UpdateRestaurantUseCase {
+func NewUpdateRestaurantUseCase(repo restaurant.Repository, geocoder restaurant.Geocoder) *UpdateRestaurantUseCase {
 	return &UpdateRestaurantUseCase{
-		repo: repo,
+		repo:     repo,
+		geocoder: geocoder,
 	}
 }
 
@@ -41,20 +45,37 @@ func (uc *UpdateRestaurantUseCase) Execute(ctx context.Context, request CreateRe
 	if request.PhoneNumber == "" {
 		return restaurant.ErrInvalidPhoneNumber
 	}
+	location, err := requestLocation(request.RestaurantBase)
+	if err != nil {
+		return err
+	}
 	record, err := uc.repo.GetById(ctx, id)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return restaurant.ErrRestaurantNoExitis
+		}
 		return err
 	}
 	if record == nil {
 		return restaurant.ErrRestaurantNoExitis
 	}
-	hours := make([]restaurant.Hours, 0, len(request.Hours))
-	for _, hour := range request.Hours {
-		hours = append(hours, restaurant.Hours{
-			Day:       hour.Day,
-			OpenTime:  hour.OpenTime,
-			CloseTime: hour.CloseTime,
-		})
+	if _, err := authorizeMember(ctx, uc.repo, id, userID, restaurant.MemberRole.CanUpdate); err != nil {
+		return err
+	}
+	// the stored location stays valid until the address changes
+	if location == nil {
+		location = record.Location
+		if location == nil || record.Address != request.Address || record.District != request.District || record.City != request.City {
+			location = geocodeAddress(ctx, uc.geocoder, request.RestaurantBase)
+		}
+	}
+	timezone, err := requestTimezone(request.Timezone, record.Timezone)
+	if err != nil {
+		return err
+	}
+	hours, err := toHours(request.Hours)
+	if err != nil {
+		return err
 	}
 	err = uc.repo.Update(ctx, &restaurant.Entity{
 		Email:       request.Email,
@@ -65,10 +86,12 @@ func (uc *UpdateRestaurantUseCase) Execute(ctx context.Context, request CreateRe
 		LogoUrl:     request.LogoUrl,
 		BannerUrl:   request.BannerUrl,
 		PhoneNumber: request.PhoneNumber,
-		Address:     request.PhoneNumber,
+		Address:     request.Address,
 		City:        request.City,
 		District:    request.District,
-		UserID:      userID,
+		UserID:      record.UserID,
+		Location:    location,
+		Timezone:    timezone,
 		Hours:       hours,
 	}, id)
 	if err != nil {
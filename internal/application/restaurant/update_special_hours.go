@@ -0,0 +1,30 @@
+package restaurantapp
+
+import (
+	"context"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+)
+
+type UpdateSpecialHoursUseCase struct {
+	repo restaurant.Repository
+}
+
+func NewUpdateSpecialHoursUseCase(repo restaurant.Repository) *UpdateSpecialHoursUseCase {
+	return &UpdateSpecialHoursUseCase{
+		repo: repo,
+	}
+}
+
+func (uc *UpdateSpecialHoursUseCase) Execute(ctx context.Context, request SpecialHoursRequest, userID uuid.UUID, id, specialID int32) error {
+	special, err := toSpecialHours(request, id)
+	if err != nil {
+		return err
+	}
+	special.ID = specialID
+	if _, err := authorizeMember(ctx, uc.repo, id, userID, restaurant.MemberRole.CanUpdate); err != nil {
+		return err
+	}
+	return uc.repo.UpdateSpecialHours(ctx, special)
+}
@@ -1,6 +1,13 @@
 package restaurantapp
 
-import "go-ai/internal/domain/restaurant"
+import (
+	"context"
+	"errors"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
 
 type UpdateStatusUseCase struct {
 	repo restaurant.Repository
@@ -12,6 +19,30 @@ func NewUpdateStatusUseCase(repo restaurant.Repository) *UpdateStatusUseCase {
 	}
 }
 
-func (uc *UpdateStatusUseCase) Execute(id int32, dayOfWeek int32) error {
-	return nil
+// Execute applies an owner status change: submitting a draft for review, withdrawing it, closing
+// the restaurant or submitting a closed one again. Approval is left to reviewers.
+func (uc *UpdateStatusUseCase) Execute(ctx context.Context, request UpdateStatusRequest, userID uuid.UUID, id int32) error {
+	next, err := restaurant.ParseStatus(request.Status)
+	if err != nil {
+		return err
+	}
+	if _, err := authorizeMember(ctx, uc.repo, id, userID, restaurant.MemberRole.CanChangeStatus); err != nil {
+		return err
+	}
+	record, err := uc.repo.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return restaurant.ErrRestaurantNoExitis
+		}
+		return err
+	}
+	if err := record.Status.Transition(next, restaurant.ActorOwner); err != nil {
+		return err
+	}
+	// a suspended restaurant closed by its owner keeps the reviewer note
+	reason := ""
+	if record.Status == restaurant.StatusSuspended {
+		reason = record.StatusReason
+	}
+	return uc.repo.SetStatus(ctx, id, next, reason, record.Status)
 }
@@ -0,0 +1,30 @@
+package tableapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/domain/restaurant"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// authorizeMember returns restaurant.ErrRestaurantForbidden unless userID is a member of the
+// restaurant whose role allowed accepts.
+func authorizeMember(ctx context.Context, restaurants restaurant.Repository, restaurantID int32, userID uuid.UUID, allowed func(restaurant.MemberRole) bool) error {
+	member, err := restaurants.GetMember(ctx, restaurantID, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return restaurant.ErrRestaurantForbidden
+		}
+		return err
+	}
+	if !allowed(member.Role) {
+		return restaurant.ErrRestaurantForbidden
+	}
+	return nil
+}
+
+func anyMember(restaurant.MemberRole) bool {
+	return true
+}
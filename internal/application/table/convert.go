@@ -0,0 +1,35 @@
+package tableapp
+
+import (
+	"go-ai/internal/domain/table"
+	"go-ai/internal/infra/storage"
+	"strings"
+)
+
+func toTable(request TableRequest, restaurantID int32) (*table.Table, error) {
+	t := &table.Table{
+		RestaurantID: restaurantID,
+		Name:         strings.TrimSpace(request.Name),
+		Seats:        request.Seats,
+		IsActive:     request.IsActive == nil || *request.IsActive,
+	}
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func toTableResponse(t *table.Table, storage *storage.MinioClient) TableResponse {
+	resp := TableResponse{
+		Id:       t.ID,
+		Name:     t.Name,
+		Seats:    t.Seats,
+		IsActive: t.IsActive,
+	}
+	if t.HasQR() {
+		resp.QrPngUrl = storage.PublicUrl(t.QrPngObject)
+		resp.QrSvgUrl = storage.PublicUrl(t.QrSvgObject)
+		resp.QrGeneratedAt = t.QrGeneratedAt
+	}
+	return resp
+}
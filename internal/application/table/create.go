@@ -0,0 +1,38 @@
+package tableapp
+
+import (
+	"context"
+	"go-ai/internal/domain/restaurant"
+	"go-ai/internal/domain/table"
+
+	"github.com/google/uuid"
+)
+
+type CreateTableUseCase struct {
+	repo        table.Repository
+	restaurants restaurant.Repository
+}
+
+func NewCreateTableUseCase(repo table.Repository, restaurants restaurant.Repository) *CreateTableUseCase {
+	return &CreateTableUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+func (uc *CreateTableUseCase) Execute(ctx context.Context, request TableRequest, userID uuid.UUID, restaurantID int32) (*CreateTableResponse, error) {
+	t, err := toTable(request, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeMember(ctx, uc.restaurants, restaurantID, userID, restaurant.MemberRole.CanUpdate); err != nil {
+		return nil, err
+	}
+	id, err := uc.repo.Create(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateTableResponse{
+		Id: id,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package tableapp
+
+import (
+	"context"
+	"go-ai/internal/domain/restaurant"
+	"go-ai/internal/domain/table"
+	"go-ai/internal/infra/storage"
+
+	"github.com/google/uuid"
+)
+
+type DeleteTableUseCase struct {
+	repo        table.Repository
+	restaurants restaurant.Repository
+	storage     *storage.MinioClient
+}
+
+func NewDeleteTableUseCase(repo table.Repository, restaurants restaurant.Repository, storage *storage.MinioClient) *DeleteTableUseCase {
+	return &DeleteTableUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+		storage:     storage,
+	}
+}
+
+// Execute deletes a table along with its QR code images. Printed codes of the table then open the
+// menu without selecting a table.
+func (uc *DeleteTableUseCase) Execute(ctx context.Context, userID uuid.UUID, restaurantID, id int32) error {
+	if err := authorizeMember(ctx, uc.restaurants, restaurantID, userID, restaurant.MemberRole.CanUpdate); err != nil {
+		return err
+	}
+	t, err := uc.repo.Get(ctx, restaurantID, id)
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.Delete(ctx, restaurantID, id); err != nil {
+		return err
+	}
+	if t.HasQR() {
+		// best effort, a leftover image is harmless
+		_ = uc.storage.Remove(ctx, t.QrPngObject)
+		_ = uc.storage.Remove(ctx, t.QrSvgObject)
+	}
+	return nil
+}
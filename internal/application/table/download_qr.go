@@ -0,0 +1,81 @@
+package tableapp
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"go-ai/internal/domain/restaurant"
+	"go-ai/internal/domain/table"
+	"go-ai/internal/infra/storage"
+	"go-ai/pkg/utils"
+	"path"
+
+	"github.com/google/uuid"
+)
+
+type DownloadQRUseCase struct {
+	repo        table.Repository
+	restaurants restaurant.Repository
+	storage     *storage.MinioClient
+	signer      *table.Signer
+}
+
+func NewDownloadQRUseCase(repo table.Repository, restaurants restaurant.Repository, storage *storage.MinioClient, signer *table.Signer) *DownloadQRUseCase {
+	return &DownloadQRUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+		storage:     storage,
+		signer:      signer,
+	}
+}
+
+// Execute zips the PNG and SVG QR codes of the active tables of a restaurant for printing,
+// generating the missing ones first. It returns the archive and a file name for it.
+func (uc *DownloadQRUseCase) Execute(ctx context.Context, userID uuid.UUID, restaurantID int32) ([]byte, string, error) {
+	if err := authorizeMember(ctx, uc.restaurants, restaurantID, userID, restaurant.MemberRole.CanUpdate); err != nil {
+		return nil, "", err
+	}
+	r, err := uc.restaurants.GetById(ctx, restaurantID)
+	if err != nil {
+		return nil, "", err
+	}
+	tables, err := uc.repo.List(ctx, restaurantID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, t := range tables {
+		if !t.IsActive {
+			continue
+		}
+		if !t.HasQR() {
+			if _, err := generateQR(ctx, uc.repo, uc.storage, uc.signer, r, &t); err != nil {
+				return nil, "", err
+			}
+		}
+		name := fmt.Sprintf("table-%d", t.ID)
+		if slug := utils.Slugify(t.Name); slug != "" {
+			name += "-" + slug
+		}
+		for _, object := range []string{t.QrPngObject, t.QrSvgObject} {
+			data, err := uc.storage.GetBytes(ctx, object)
+			if err != nil {
+				return nil, "", err
+			}
+			w, err := archive.Create(name + path.Ext(object))
+			if err != nil {
+				return nil, "", err
+			}
+			if _, err := w.Write(data); err != nil {
+				return nil, "", err
+			}
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), restaurant.Slug(r.ID, r.Name) + "-qr-codes.zip", nil
+}
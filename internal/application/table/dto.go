@@ -0,0 +1,37 @@
+package tableapp
+
+import "time"
+
+type TableRequest struct {
+	Name  string `json:"name"`
+	Seats int32  `json:"seats"`
+	// IsActive defaults to true; the QR code of an inactive table opens the menu without selecting it
+	IsActive *bool `json:"is_active"`
+}
+
+type CreateTableResponse struct {
+	Id int32 `json:"id"`
+}
+
+// TableResponse is a table with its QR code images, when generated.
+type TableResponse struct {
+	Id            int32      `json:"id"`
+	Name          string     `json:"name"`
+	Seats         int32      `json:"seats"`
+	IsActive      bool       `json:"is_active"`
+	QrPngUrl      string     `json:"qr_png_url,omitempty"`
+	QrSvgUrl      string     `json:"qr_svg_url,omitempty"`
+	QrGeneratedAt *time.Time `json:"qr_generated_at,omitempty"`
+}
+
+type ListTablesResponse struct {
+	Tables []TableResponse `json:"tables"`
+}
+
+// TableQRResponse is a freshly generated QR code. Link is what it encodes, the public menu page
+// with the table selected.
+type TableQRResponse struct {
+	Link     string `json:"link"`
+	QrPngUrl string `json:"qr_png_url"`
+	QrSvgUrl string `json:"qr_svg_url"`
+}
@@ -0,0 +1,43 @@
+package tableapp
+
+import (
+	"context"
+	"go-ai/internal/domain/restaurant"
+	"go-ai/internal/domain/table"
+	"go-ai/internal/infra/storage"
+
+	"github.com/google/uuid"
+)
+
+type GenerateQRUseCase struct {
+	repo        table.Repository
+	restaurants restaurant.Repository
+	storage     *storage.MinioClient
+	signer      *table.Signer
+}
+
+func NewGenerateQRUseCase(repo table.Repository, restaurants restaurant.Repository, storage *storage.MinioClient, signer *table.Signer) *GenerateQRUseCase {
+	return &GenerateQRUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+		storage:     storage,
+		signer:      signer,
+	}
+}
+
+// Execute generates the QR code of a table, replacing the previous one. The link it encodes does
+// not change between generations, so printed codes keep working.
+func (uc *GenerateQRUseCase) Execute(ctx context.Context, userID uuid.UUID, restaurantID, id int32) (*TableQRResponse, error) {
+	if err := authorizeMember(ctx, uc.restaurants, restaurantID, userID, restaurant.MemberRole.CanUpdate); err != nil {
+		return nil, err
+	}
+	r, err := uc.restaurants.GetById(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	t, err := uc.repo.Get(ctx, restaurantID, id)
+	if err != nil {
+		return nil, err
+	}
+	return generateQR(ctx, uc.repo, uc.storage, uc.signer, r, t)
+}
@@ -0,0 +1,77 @@
+package tableapp
+
+import (
+	"context"
+	"fmt"
+	"go-ai/internal/config"
+	"go-ai/internal/domain/restaurant"
+	"go-ai/internal/domain/table"
+	"go-ai/internal/infra/qrcode"
+	"go-ai/internal/infra/storage"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// qrPngSize is the width in pixels of the PNG codes, enough for a table tent at print resolution.
+const qrPngSize = 1024
+
+// NewTableSigner signs the table links with TABLE_LINK_SECRET, printed codes stop working when it
+// changes. Without it, only possible in development, the links are signed with DevTableLinkSecret.
+func NewTableSigner() *table.Signer {
+	cfg, _ := config.LoadConfig()
+	if cfg.TableLinkSecret == "" {
+		return table.NewSigner(config.DevTableLinkSecret)
+	}
+	return table.NewSigner(cfg.TableLinkSecret)
+}
+
+// tableLink is the public menu page of the diner app at APP_BASE_URL with the table selected. The
+// app passes table and sig on to /api/public/restaurants/{slug}/menu.
+func tableLink(signer *table.Signer, r *restaurant.Entity, tableID int32) string {
+	config, _ := config.LoadConfig()
+	query := url.Values{}
+	query.Set("table", strconv.Itoa(int(tableID)))
+	query.Set("sig", signer.Sign(r.ID, tableID))
+	return fmt.Sprintf("%s/menu/%s?%s", strings.TrimRight(config.AppBaseUrl, "/"), restaurant.Slug(r.ID, r.Name), query.Encode())
+}
+
+// generateQR renders the QR code of a table as PNG and SVG, stores both and records them on the
+// table. The images of a previous generation are removed; object names carry the generation time
+// so caches never serve a stale code.
+func generateQR(ctx context.Context, tables table.Repository, storage *storage.MinioClient, signer *table.Signer, r *restaurant.Entity, t *table.Table) (*TableQRResponse, error) {
+	link := tableLink(signer, r, t.ID)
+	code, err := qrcode.Encode(link)
+	if err != nil {
+		return nil, err
+	}
+	png, err := code.PNG(qrPngSize)
+	if err != nil {
+		return nil, err
+	}
+	prefix := fmt.Sprintf("qr/restaurant-%d/table-%d-%d", r.ID, t.ID, time.Now().UnixNano())
+	pngUrl, err := storage.PutBytes(ctx, prefix+".png", png, "image/png")
+	if err != nil {
+		return nil, err
+	}
+	svgUrl, err := storage.PutBytes(ctx, prefix+".svg", code.SVG(), "image/svg+xml")
+	if err != nil {
+		return nil, err
+	}
+	if err := tables.SetQR(ctx, r.ID, t.ID, prefix+".png", prefix+".svg"); err != nil {
+		return nil, err
+	}
+	if t.HasQR() {
+		// best effort, a leftover image is harmless
+		_ = storage.Remove(ctx, t.QrPngObject)
+		_ = storage.Remove(ctx, t.QrSvgObject)
+	}
+	t.QrPngObject = prefix + ".png"
+	t.QrSvgObject = prefix + ".svg"
+	return &TableQRResponse{
+		Link:     link,
+		QrPngUrl: pngUrl,
+		QrSvgUrl: svgUrl,
+	}, nil
+}
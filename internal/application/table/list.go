@@ -0,0 +1,42 @@
+package tableapp
+
+import (
+	"context"
+	"go-ai/internal/domain/restaurant"
+	"go-ai/internal/domain/table"
+	"go-ai/internal/infra/storage"
+
+	"github.com/google/uuid"
+)
+
+type ListTablesUseCase struct {
+	repo        table.Repository
+	restaurants restaurant.Repository
+	storage     *storage.MinioClient
+}
+
+func NewListTablesUseCase(repo table.Repository, restaurants restaurant.Repository, storage *storage.MinioClient) *ListTablesUseCase {
+	return &ListTablesUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+		storage:     storage,
+	}
+}
+
+// Execute lists the tables of a restaurant to its members, staff included.
+func (uc *ListTablesUseCase) Execute(ctx context.Context, userID uuid.UUID, restaurantID int32) (*ListTablesResponse, error) {
+	if err := authorizeMember(ctx, uc.restaurants, restaurantID, userID, anyMember); err != nil {
+		return nil, err
+	}
+	tables, err := uc.repo.List(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ListTablesResponse{
+		Tables: make([]TableResponse, 0, len(tables)),
+	}
+	for _, t := range tables {
+		resp.Tables = append(resp.Tables, toTableResponse(&t, uc.storage))
+	}
+	return resp, nil
+}
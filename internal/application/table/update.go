@@ -0,0 +1,34 @@
+package tableapp
+
+import (
+	"context"
+	"go-ai/internal/domain/restaurant"
+	"go-ai/internal/domain/table"
+
+	"github.com/google/uuid"
+)
+
+type UpdateTableUseCase struct {
+	repo        table.Repository
+	restaurants restaurant.Repository
+}
+
+func NewUpdateTableUseCase(repo table.Repository, restaurants restaurant.Repository) *UpdateTableUseCase {
+	return &UpdateTableUseCase{
+		repo:        repo,
+		restaurants: restaurants,
+	}
+}
+
+// Execute renames or resizes a table. Its QR code stays valid, the link only carries the table id.
+func (uc *UpdateTableUseCase) Execute(ctx context.Context, request TableRequest, userID uuid.UUID, restaurantID, id int32) error {
+	t, err := toTable(request, restaurantID)
+	if err != nil {
+		return err
+	}
+	if err := authorizeMember(ctx, uc.restaurants, restaurantID, userID, restaurant.MemberRole.CanUpdate); err != nil {
+		return err
+	}
+	t.ID = id
+	return uc.repo.Update(ctx, t)
+}
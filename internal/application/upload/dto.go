@@ -3,3 +3,7 @@ package uploadapp
 type UploadLogoResponse struct {
 	Url string `json:"url"`
 }
+
+type UploadImageResponse struct {
+	Url string `json:"url"`
+}
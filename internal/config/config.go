@@ -7,30 +7,58 @@ import (
 	"github.com/spf13/viper"
 )
 
+// DevTableLinkSecret signs the table links in development when TABLE_LINK_SECRET is not set. It is
+// public, so the server refuses to start with it anywhere else.
+const DevTableLinkSecret = "dev-table-link-secret"
+
 type Config struct {
-	JwtAccessSecret     string `mapstructure:"JWT_SECRET"`
-	JwtRefreshSecret    string `mapstructure:"JWT_REFRESH_SECRET"`
-	JwtExpiresIn        int    `mapstructure:"JWT_EXPIRES_IN"`
-	JwtRefreshExpiresIn int    `mapstructure:"JWT_REFRESH_EXPIRES_IN"`
-	RedisHost           string `mapstructure:"REDIS_HOST"`
-	RedisPassword       string `mapstructure:"REDIS_PASSWORD"`
-	RedisPort           int    `mapstructure:"REDIS_PORT"`
-	RedisDB             int    `mapstructure:"REDIS_DB"`
-	DBName              string `mapstructure:"POSTGRES_DB"`
-	DBHost              string `mapstructure:"POSTGRES_HOST"`
-	DBPort              string `mapstructure:"POSTGRES_PORT"`
-	DBUser              string `mapstructure:"POSTGRES_USER"`
-	DBPassword          string `mapstructure:"POSTGRES_PASSWORD"`
-	DBSSLMode           string `mapstructure:"db_sslmode"`
-	ServerPort          string `mapstructure:"PORT"`
-	ServerHost          string `mapstructure:"server_host"`
-	Environment         string `mapstructure:"ENVIRONMENT"`
-	MinioEndPoint       string `mapstructure:"MINIO_END_POINT"`
-	MinioPort           string `mapstructure:"MINIO_PORT"`
-	MinioAccessKey      string `mapstructure:"MINIO_ACCESS_KEY"`
-	MinioSecretKey      string `mapstructure:"MINIO_SECRET_KEY"`
-	Bucket              string `mapstructure:"MINIO_BUCKET"`
-	MinioUseSSL         bool   `mapstructure:"MINIO_USE_SSL"`
+	JwtKeysDir             string `mapstructure:"JWT_KEYS_DIR"`
+	JwtSigningKid          string `mapstructure:"JWT_SIGNING_KID"`
+	JwtExpiresIn           int    `mapstructure:"JWT_EXPIRES_IN"`
+	JwtRefreshExpiresIn    int    `mapstructure:"JWT_REFRESH_EXPIRES_IN"`
+	RedisHost              string `mapstructure:"REDIS_HOST"`
+	RedisPassword          string `mapstructure:"REDIS_PASSWORD"`
+	RedisPort              int    `mapstructure:"REDIS_PORT"`
+	RedisDB                int    `mapstructure:"REDIS_DB"`
+	DBName                 string `mapstructure:"POSTGRES_DB"`
+	DBHost                 string `mapstructure:"POSTGRES_HOST"`
+	DBPort                 string `mapstructure:"POSTGRES_PORT"`
+	DBUser                 string `mapstructure:"POSTGRES_USER"`
+	DBPassword             string `mapstructure:"POSTGRES_PASSWORD"`
+	DBSSLMode              string `mapstructure:"db_sslmode"`
+	ServerPort             string `mapstructure:"PORT"`
+	ServerHost             string `mapstructure:"server_host"`
+	Environment            string `mapstructure:"ENVIRONMENT"`
+	MinioEndPoint          string `mapstructure:"MINIO_END_POINT"`
+	MinioPort              string `mapstructure:"MINIO_PORT"`
+	MinioAccessKey         string `mapstructure:"MINIO_ACCESS_KEY"`
+	MinioSecretKey         string `mapstructure:"MINIO_SECRET_KEY"`
+	Bucket                 string `mapstructure:"MINIO_BUCKET"`
+	MinioUseSSL            bool   `mapstructure:"MINIO_USE_SSL"`
+	AppBaseUrl             string `mapstructure:"APP_BASE_URL"`
+	MailDriver             string `mapstructure:"MAIL_DRIVER"`
+	MailFrom               string `mapstructure:"MAIL_FROM"`
+	SmtpHost               string `mapstructure:"SMTP_HOST"`
+	SmtpPort               int    `mapstructure:"SMTP_PORT"`
+	SmtpUsername           string `mapstructure:"SMTP_USERNAME"`
+	SmtpPassword           string `mapstructure:"SMTP_PASSWORD"`
+	PasswordResetExpiresIn int    `mapstructure:"PASSWORD_RESET_EXPIRES_IN"`
+	ApiBaseUrl             string `mapstructure:"API_BASE_URL"`
+	EmailVerifyExpiresIn   int    `mapstructure:"EMAIL_VERIFY_EXPIRES_IN"`
+	RequireEmailVerified   bool   `mapstructure:"REQUIRE_EMAIL_VERIFIED"`
+	MfaIssuer              string `mapstructure:"MFA_ISSUER"`
+	MfaChallengeExpiresIn  int    `mapstructure:"MFA_CHALLENGE_EXPIRES_IN"`
+	LoginMaxAttemptsEmail  int    `mapstructure:"LOGIN_MAX_ATTEMPTS_EMAIL"`
+	LoginMaxAttemptsIp     int    `mapstructure:"LOGIN_MAX_ATTEMPTS_IP"`
+	LoginAttemptWindow     int    `mapstructure:"LOGIN_ATTEMPT_WINDOW"`
+	LoginLockoutDuration   int    `mapstructure:"LOGIN_LOCKOUT_DURATION"`
+	LoginDelayBaseMs       int    `mapstructure:"LOGIN_DELAY_BASE_MS"`
+	LoginDelayMaxMs        int    `mapstructure:"LOGIN_DELAY_MAX_MS"`
+	OidcProviders          string `mapstructure:"OIDC_PROVIDERS"`
+	DefaultTimezone        string `mapstructure:"DEFAULT_TIMEZONE"`
+	GeocoderDriver         string `mapstructure:"GEOCODER_DRIVER"`
+	GeocoderUrl            string `mapstructure:"GEOCODER_URL"`
+	TableLinkSecret        string `mapstructure:"TABLE_LINK_SECRET"`
 }
 
 func LoadConfig() (*Config, error) {
@@ -72,8 +100,8 @@ func LoadConfig() (*Config, error) {
 
 func setDefaults() {
 	// JWT defaults
-	viper.SetDefault("JWT_SECRET", "your-access-secret-key")
-	viper.SetDefault("JWT_REFRESH_SECRET", "your-refresh-secret-key")
+	viper.SetDefault("JWT_KEYS_DIR", "keys/jwt")
+	viper.SetDefault("JWT_SIGNING_KID", "")
 	viper.SetDefault("JWT_EXPIRES_IN", 3000)
 	viper.SetDefault("JWT_REFRESH_EXPIRES_IN", 6480000)
 
@@ -105,6 +133,43 @@ func setDefaults() {
 	viper.SetDefault("MINIO_SECRET_KEY", "minioadmin")
 	viper.SetDefault("MINIO_USE_SSL", false)
 	viper.SetDefault("MINIO_BUCKET", "uploads")
+
+	// Mail defaults
+	viper.SetDefault("APP_BASE_URL", "http://localhost:3000")
+	viper.SetDefault("MAIL_DRIVER", "log")
+	viper.SetDefault("MAIL_FROM", "no-reply@go-ai.local")
+	viper.SetDefault("SMTP_HOST", "localhost")
+	viper.SetDefault("SMTP_PORT", 587)
+	viper.SetDefault("SMTP_USERNAME", "")
+	viper.SetDefault("SMTP_PASSWORD", "")
+	viper.SetDefault("PASSWORD_RESET_EXPIRES_IN", 3600)
+
+	// Email verification defaults
+	viper.SetDefault("API_BASE_URL", "http://localhost:8080")
+	viper.SetDefault("EMAIL_VERIFY_EXPIRES_IN", 86400)
+	viper.SetDefault("REQUIRE_EMAIL_VERIFIED", false)
+
+	// Two-factor defaults
+	viper.SetDefault("MFA_ISSUER", "go-ai")
+	viper.SetDefault("MFA_CHALLENGE_EXPIRES_IN", 300)
+
+	// Login throttling defaults
+	viper.SetDefault("LOGIN_MAX_ATTEMPTS_EMAIL", 5)
+	viper.SetDefault("LOGIN_MAX_ATTEMPTS_IP", 20)
+	viper.SetDefault("LOGIN_ATTEMPT_WINDOW", 900)
+	viper.SetDefault("LOGIN_LOCKOUT_DURATION", 900)
+	viper.SetDefault("LOGIN_DELAY_BASE_MS", 200)
+	viper.SetDefault("LOGIN_DELAY_MAX_MS", 3000)
+
+	// OpenID Connect providers, comma separated names, see oidc.NewProviders
+	viper.SetDefault("OIDC_PROVIDERS", "")
+
+	// Timezone used to read restaurant opening hours
+	viper.SetDefault("DEFAULT_TIMEZONE", "Asia/Ho_Chi_Minh")
+
+	// Geocoding defaults, see geocode.NewGeocoder
+	viper.SetDefault("GEOCODER_DRIVER", "offline")
+	viper.SetDefault("GEOCODER_URL", "https://nominatim.openstreetmap.org")
 }
 
 // GetString returns a string value from config
@@ -0,0 +1,23 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKey is a long-lived credential a user issues for scripts and integrations. Only the hash of
+// the secret is kept; Prefix identifies the key and is safe to show. Scopes are permission names
+// and further restrict what the role of the owner allows.
+type APIKey struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Name       string
+	Prefix     string
+	SecretHash string
+	Scopes     []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
@@ -1,14 +1,62 @@
 package auth
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
 type Entity struct {
-	ID       uuid.UUID
-	FullName string
-	Email    string
-	Password string
+	ID              uuid.UUID
+	FullName        string
+	Email           string
+	Password        string
+	Role            string
+	IsActive        bool
+	EmailVerifiedAt *time.Time
+	CreatedAt       time.Time
+}
+
+// UserFilter narrows the user list of the admin API. Nil and empty fields do not filter.
+type UserFilter struct {
+	Search   string
 	Role     string
-	IsActive bool
+	IsActive *bool
+	Limit    int32
+	Offset   int32
+}
+
+// TOTP is the two-factor state of a user. Secret is set during enrolment and EnabledAt once the
+// user confirmed it with a valid code.
+type TOTP struct {
+	Secret    string
+	EnabledAt *time.Time
+}
+
+type PasswordResetToken struct {
+	ID        int64
+	UserID    uuid.UUID
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+}
+
+type EmailVerificationToken struct {
+	ID        int64
+	UserID    uuid.UUID
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+}
+
+// Identity links a user to an account at an external OpenID Connect provider. Subject is the
+// stable id the provider gives the account; the email may change over time.
+type Identity struct {
+	ID          int64
+	UserID      uuid.UUID
+	Provider    string
+	Subject     string
+	Email       string
+	CreatedAt   time.Time
+	LastLoginAt *time.Time
 }
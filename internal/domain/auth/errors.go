@@ -1,6 +1,9 @@
 package auth
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 var (
 	ErrPasswordVerifyFail      = errors.New("Password verification failed")
@@ -12,4 +15,41 @@ var (
 	ErrTokenWrongSigningMethod = errors.New("Token has wrong signing method")
 	ErrTokenGenerateFail       = errors.New("Failed to generate token")
 	ErrorRefreshTokenEmpty     = errors.New("Refresh Token empty string")
+	ErrSessionNotFound         = errors.New("Session not found")
+	ErrRefreshTokenReused      = errors.New("Refresh token has already been used")
+	ErrResetTokenInvalid       = errors.New("Reset token is invalid or expired")
+	ErrVerifyTokenInvalid      = errors.New("Verification token is invalid or expired")
+	ErrEmailNotVerified        = errors.New("Email is not verified")
+	ErrMfaAlreadyEnabled       = errors.New("Two-factor authentication is already enabled")
+	ErrMfaNotEnabled           = errors.New("Two-factor authentication is not enabled")
+	ErrMfaSetupRequired        = errors.New("Two-factor setup has not been started")
+	ErrMfaCodeInvalid          = errors.New("Two-factor code is invalid")
+	ErrMfaChallengeInvalid     = errors.New("MFA challenge is invalid or expired")
+	ErrLoginLocked             = errors.New("Too many failed login attempts, try again later")
+	ErrAPIKeyNameRequired      = errors.New("API key name is required")
+	ErrAPIKeyScopeInvalid      = errors.New("API key scope is not allowed")
+	ErrAPIKeyExpiryInvalid     = errors.New("API key expiry is invalid")
+	ErrAPIKeyNotFound          = errors.New("API key not found")
+	ErrOIDCProviderNotFound    = errors.New("Login provider not found")
+	ErrOIDCStateInvalid        = errors.New("Login state is invalid or expired")
+	ErrOIDCLoginFailed         = errors.New("External login failed")
+	ErrOIDCEmailRequired       = errors.New("External account has no email address")
+	ErrOIDCAccountUnverified   = errors.New("An account with this email exists, verify its email before signing in with this provider")
+	ErrUserNotFound            = errors.New("User not found")
+	ErrRoleNotFound            = errors.New("Role not found")
+	ErrCannotModifySelf        = errors.New("You cannot change the status or role of your own account")
 )
+
+// LockoutError is returned while logins are locked for an email or IP address. It matches
+// ErrLoginLocked with errors.Is and carries how long the lock still lasts.
+type LockoutError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockoutError) Error() string {
+	return ErrLoginLocked.Error()
+}
+
+func (e *LockoutError) Unwrap() error {
+	return ErrLoginLocked
+}
@@ -0,0 +1,15 @@
+package auth
+
+// Permission names as stored in the permission table and granted to roles through role_permission.
+const (
+	PermissionRestaurantCreate = "restaurant:create"
+	PermissionRestaurantRead   = "restaurant:read"
+	PermissionRestaurantUpdate = "restaurant:update"
+	PermissionRestaurantDelete = "restaurant:delete"
+	PermissionRestaurantReview = "restaurant:review"
+	PermissionUploadCreate     = "upload:create"
+	PermissionUserRead         = "user:read"
+	PermissionUserManage       = "user:manage"
+	PermissionOrderWrite       = "order:write"
+	PermissionOrderManage      = "order:manage"
+)
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -11,4 +12,33 @@ type Repository interface {
 	GetByEmail(ctx context.Context, email string) (*Entity, error)
 	CreateUser(ctx context.Context, u *Entity) (uuid.UUID, error)
 	GetByName(ctx context.Context, name string) (*Entity, error)
+	UpdateUser(ctx context.Context, u *Entity) error
+	GetPasswordHash(ctx context.Context, id uuid.UUID) (string, error)
+	UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) error
+	GetPermissionsByRole(ctx context.Context, role string) ([]string, error)
+	CreatePasswordResetToken(ctx context.Context, userID uuid.UUID, tokenHash string, expiresAt time.Time) error
+	GetPasswordResetToken(ctx context.Context, tokenHash string) (*PasswordResetToken, error)
+	ResetPassword(ctx context.Context, tokenID int64, userID uuid.UUID, passwordHash string) error
+	CreateEmailVerificationToken(ctx context.Context, userID uuid.UUID, tokenHash string, expiresAt time.Time) error
+	GetEmailVerificationToken(ctx context.Context, tokenHash string) (*EmailVerificationToken, error)
+	VerifyEmail(ctx context.Context, tokenID int64, userID uuid.UUID) error
+	GetTOTP(ctx context.Context, userID uuid.UUID) (*TOTP, error)
+	SetTOTPSecret(ctx context.Context, userID uuid.UUID, secret string) error
+	EnableTOTP(ctx context.Context, userID uuid.UUID, recoveryCodeHashes []string) error
+	DisableTOTP(ctx context.Context, userID uuid.UUID) error
+	UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error)
+	CreateAPIKey(ctx context.Context, k *APIKey) (*APIKey, error)
+	ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]APIKey, error)
+	GetAPIKeyByPrefix(ctx context.Context, prefix string) (*APIKey, error)
+	RevokeAPIKey(ctx context.Context, id, userID uuid.UUID) error
+	TouchAPIKey(ctx context.Context, id uuid.UUID) error
+	GetIdentity(ctx context.Context, provider, subject string) (*Identity, error)
+	LinkIdentity(ctx context.Context, identity *Identity) error
+	TouchIdentity(ctx context.Context, id int64, email string) error
+	CreateUserWithIdentity(ctx context.Context, u *Entity, identity *Identity) (uuid.UUID, error)
+	ListUsers(ctx context.Context, filter UserFilter) ([]Entity, int64, error)
+	SetActive(ctx context.Context, id uuid.UUID, isActive bool) error
+	ListRoles(ctx context.Context) ([]string, error)
+	SetRole(ctx context.Context, id uuid.UUID, role string) error
 }
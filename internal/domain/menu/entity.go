@@ -0,0 +1,97 @@
+package menu
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	maxNameLength = 120
+	// MaxPrice is the largest amount a NUMERIC(12,2) price column holds, in whole dong.
+	MaxPrice Price = 9_999_999_999
+)
+
+// Price is an amount in whole Vietnamese dong. The database keeps it as NUMERIC, there are no
+// fractions of a dong to lose on the way.
+type Price int64
+
+func (p Price) Valid() bool {
+	return p >= 0 && p <= MaxPrice
+}
+
+type ItemType string
+
+const (
+	ItemTypeDish     ItemType = "dish"
+	ItemTypeExtra    ItemType = "extra"
+	ItemTypeBeverage ItemType = "beverage"
+	ItemTypeCombo    ItemType = "combo"
+)
+
+func ParseItemType(s string) (ItemType, error) {
+	switch ItemType(s) {
+	case "":
+		return ItemTypeDish, nil
+	case ItemTypeDish, ItemTypeExtra, ItemTypeBeverage, ItemTypeCombo:
+		return ItemType(s), nil
+	default:
+		return "", ErrInvalidItemType
+	}
+}
+
+// Category groups the items of a restaurant menu. Slug is derived from the name and unique within
+// the restaurant; it is empty for names without any latin letter or digit. An inactive category
+// is hidden from diners along with its items.
+type Category struct {
+	ID           int64
+	RestaurantID int32
+	Name         string
+	Slug         string
+	SortOrder    int32
+	IsActive     bool
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (c Category) Validate() error {
+	if !validName(c.Name) {
+		return ErrInvalidCategoryName
+	}
+	return nil
+}
+
+// Item is a dish, drink, extra or combo. Items without a category are listed after the
+// categorized ones. IsAvailable is cleared when an item is sold out; it stays on the menu.
+type Item struct {
+	ID           int64
+	RestaurantID int32
+	CategoryID   *int64
+	Type         ItemType
+	Name         string
+	Description  string
+	ImageUrl     string
+	Price        Price
+	IsAvailable  bool
+	SortOrder    int32
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (i Item) Validate() error {
+	if !validName(i.Name) {
+		return ErrInvalidItemName
+	}
+	if !i.Price.Valid() {
+		return ErrInvalidPrice
+	}
+	if i.ImageUrl != "" && !strings.HasPrefix(i.ImageUrl, "http://") && !strings.HasPrefix(i.ImageUrl, "https://") {
+		return ErrInvalidImage
+	}
+	return nil
+}
+
+func validName(name string) bool {
+	name = strings.TrimSpace(name)
+	return name != "" && utf8.RuneCountInString(name) <= maxNameLength
+}
@@ -0,0 +1,26 @@
+package menu
+
+import "errors"
+
+var (
+	ErrInvalidCategoryName = errors.New("Invalid category name")
+	ErrCategoryExists      = errors.New("Category name already exists")
+	ErrCategoryNotFound    = errors.New("Category not found")
+	ErrInvalidItemName     = errors.New("Invalid item name")
+	ErrInvalidItemType     = errors.New("Invalid item type")
+	ErrInvalidPrice        = errors.New("Invalid price")
+	ErrInvalidImage        = errors.New("Invalid image url")
+	ErrItemNotFound        = errors.New("Menu item not found")
+
+	ErrInvalidOptionGroupName = errors.New("Invalid option group name")
+	ErrInvalidOptionName      = errors.New("Invalid option name")
+	ErrInvalidOptions         = errors.New("An option group needs between 1 and 50 options")
+	ErrInvalidSelectRange     = errors.New("Invalid min/max selection for the option group")
+	ErrOptionGroupNotFound    = errors.New("Option group not found")
+	ErrUnknownOption          = errors.New("Option is not offered for this item")
+	ErrDuplicateOption        = errors.New("Option selected more than once")
+	ErrOptionUnavailable      = errors.New("Option is not available")
+	ErrInvalidOptionQuantity  = errors.New("Invalid option quantity")
+	ErrTooFewOptions          = errors.New("Too few options selected")
+	ErrTooManyOptions         = errors.New("Too many options selected")
+)
@@ -0,0 +1,125 @@
+package menu
+
+import "time"
+
+const (
+	maxOptionsPerGroup = 50
+	// MaxOptionQuantity caps how many units of a single option go on one item.
+	MaxOptionQuantity = 99
+)
+
+// OptionGroup is a set of modifiers a diner picks from when ordering an item, "Size" or
+// "Toppings" say. MinSelect and MaxSelect bound the total quantity picked across the group; a nil
+// MaxSelect leaves it unbounded. A group with MinSelect above zero is required. The same group can
+// be attached to several items of the restaurant.
+type OptionGroup struct {
+	ID           int64
+	RestaurantID int32
+	Name         string
+	MinSelect    int32
+	MaxSelect    *int32
+	SortOrder    int32
+	Options      []Option
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Option is one choice of a group. PriceDelta is added to the item price once per unit picked,
+// up to MaxQuantity units.
+type Option struct {
+	ID          int64
+	GroupID     int64
+	Name        string
+	PriceDelta  Price
+	MaxQuantity int32
+	IsAvailable bool
+	SortOrder   int32
+}
+
+// Selection is an option picked for an item, Quantity units of it.
+type Selection struct {
+	OptionID int64
+	Quantity int32
+}
+
+func (g OptionGroup) Required() bool {
+	return g.MinSelect > 0
+}
+
+func (g OptionGroup) Validate() error {
+	if !validName(g.Name) {
+		return ErrInvalidOptionGroupName
+	}
+	if len(g.Options) == 0 || len(g.Options) > maxOptionsPerGroup {
+		return ErrInvalidOptions
+	}
+	var capacity int64
+	for _, o := range g.Options {
+		if !validName(o.Name) {
+			return ErrInvalidOptionName
+		}
+		if !o.PriceDelta.Valid() {
+			return ErrInvalidPrice
+		}
+		if o.MaxQuantity < 1 || o.MaxQuantity > MaxOptionQuantity {
+			return ErrInvalidOptionQuantity
+		}
+		capacity += int64(o.MaxQuantity)
+	}
+	if g.MinSelect < 0 || int64(g.MinSelect) > capacity {
+		return ErrInvalidSelectRange
+	}
+	if g.MaxSelect != nil && (*g.MaxSelect < 1 || *g.MaxSelect < g.MinSelect) {
+		return ErrInvalidSelectRange
+	}
+	return nil
+}
+
+// PriceSelection checks the options picked for an item against the groups attached to it and
+// returns what they add to the item price. Every selection must name a distinct available option
+// of one of the groups; a group without any selection counts as nothing picked, which fails when
+// the group is required.
+func PriceSelection(groups []OptionGroup, selections []Selection) (Price, error) {
+	type optionRef struct {
+		option Option
+		group  int
+	}
+	options := make(map[int64]optionRef)
+	for gi, g := range groups {
+		for _, o := range g.Options {
+			options[o.ID] = optionRef{option: o, group: gi}
+		}
+	}
+
+	picked := make([]int64, len(groups))
+	seen := make(map[int64]bool, len(selections))
+	var total Price
+	for _, s := range selections {
+		ref, ok := options[s.OptionID]
+		if !ok {
+			return 0, ErrUnknownOption
+		}
+		if seen[s.OptionID] {
+			return 0, ErrDuplicateOption
+		}
+		seen[s.OptionID] = true
+		if !ref.option.IsAvailable {
+			return 0, ErrOptionUnavailable
+		}
+		if s.Quantity < 1 || s.Quantity > ref.option.MaxQuantity {
+			return 0, ErrInvalidOptionQuantity
+		}
+		picked[ref.group] += int64(s.Quantity)
+		total += ref.option.PriceDelta * Price(s.Quantity)
+	}
+
+	for gi, g := range groups {
+		if picked[gi] < int64(g.MinSelect) {
+			return 0, ErrTooFewOptions
+		}
+		if g.MaxSelect != nil && picked[gi] > int64(*g.MaxSelect) {
+			return 0, ErrTooManyOptions
+		}
+	}
+	return total, nil
+}
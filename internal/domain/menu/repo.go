@@ -0,0 +1,27 @@
+package menu
+
+import "context"
+
+// Repository stores the menus. Every lookup is scoped by restaurant so an id from another
+// restaurant reads as not found.
+type Repository interface {
+	ListCategories(ctx context.Context, restaurantID int32) ([]Category, error)
+	GetCategory(ctx context.Context, restaurantID int32, id int64) (*Category, error)
+	CreateCategory(ctx context.Context, c *Category) (int64, error)
+	UpdateCategory(ctx context.Context, c *Category) error
+	DeleteCategory(ctx context.Context, restaurantID int32, id int64) error
+	ListItems(ctx context.Context, restaurantID int32) ([]Item, error)
+	GetItem(ctx context.Context, restaurantID int32, id int64) (*Item, error)
+	CreateItem(ctx context.Context, i *Item) (int64, error)
+	UpdateItem(ctx context.Context, i *Item) error
+	SetItemAvailability(ctx context.Context, restaurantID int32, id int64, available bool) error
+	DeleteItem(ctx context.Context, restaurantID int32, id int64) error
+	ListOptionGroups(ctx context.Context, restaurantID int32) ([]OptionGroup, error)
+	CreateOptionGroup(ctx context.Context, g *OptionGroup) (int64, error)
+	UpdateOptionGroup(ctx context.Context, g *OptionGroup) error
+	DeleteOptionGroup(ctx context.Context, restaurantID int32, id int64) error
+	// ListItemOptionGroups maps the id of every item with option groups to the attached group
+	// ids, in display order.
+	ListItemOptionGroups(ctx context.Context, restaurantID int32) (map[int64][]int64, error)
+	SetItemOptionGroups(ctx context.Context, restaurantID int32, itemID int64, groupIDs []int64) error
+}
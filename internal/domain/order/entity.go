@@ -0,0 +1,162 @@
+package order
+
+import (
+	"go-ai/internal/domain/menu"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxQuantity is the most servings a single line takes.
+	MaxQuantity = 99
+	// MaxItems is the most lines a cart holds.
+	MaxItems      = 50
+	maxNoteLength = 200
+)
+
+// Order is what a customer orders from a restaurant, a cart until it is placed. Type is empty on
+// carts; TableID is only set on dine-in orders placed from the QR code of a table. Subtotal is the
+// sum of the line totals, computed by the database.
+type Order struct {
+	ID           int64
+	RestaurantID int32
+	CustomerID   uuid.UUID
+	Type         Type
+	TableID      *int32
+	Status       Status
+	Note         string
+	CancelReason string
+	Subtotal     menu.Price
+	Items        []Item
+	PlacedAt     *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Item is a line of an order: a menu item with the options chosen for it. Names and prices are
+// copied from the menu when the line is priced, later menu edits leave it as it is; MenuItemID and
+// OptionID are cleared when the menu entry is deleted. UnitPrice and LineTotal are recomputed from
+// the copies by the database whenever the order is saved.
+type Item struct {
+	ID         int64
+	MenuItemID *int64
+	Name       string
+	BasePrice  menu.Price
+	Quantity   int32
+	Note       string
+	Options    []ItemOption
+	UnitPrice  menu.Price
+	LineTotal  menu.Price
+}
+
+type ItemOption struct {
+	OptionID   *int64
+	GroupName  string
+	Name       string
+	PriceDelta menu.Price
+	Quantity   int32
+}
+
+// NewItem prices quantity servings of a menu item with the selected options, checking them against
+// the option groups attached to the item. The item must not be sold out.
+func NewItem(item *menu.Item, groups []menu.OptionGroup, selections []menu.Selection, quantity int32, note string) (Item, error) {
+	if !item.IsAvailable {
+		return Item{}, ErrItemUnavailable
+	}
+	if quantity < 1 || quantity > MaxQuantity {
+		return Item{}, ErrInvalidQuantity
+	}
+	if !validNote(note) {
+		return Item{}, ErrInvalidNote
+	}
+	if _, err := menu.PriceSelection(groups, selections); err != nil {
+		return Item{}, err
+	}
+
+	type optionRef struct {
+		option menu.Option
+		group  string
+	}
+	options := make(map[int64]optionRef)
+	for _, g := range groups {
+		for _, o := range g.Options {
+			options[o.ID] = optionRef{option: o, group: g.Name}
+		}
+	}
+	menuItemID := item.ID
+	line := Item{
+		MenuItemID: &menuItemID,
+		Name:       item.Name,
+		BasePrice:  item.Price,
+		Quantity:   quantity,
+		Note:       strings.TrimSpace(note),
+		Options:    make([]ItemOption, 0, len(selections)),
+	}
+	for _, s := range selections {
+		ref := options[s.OptionID]
+		optionID := ref.option.ID
+		line.Options = append(line.Options, ItemOption{
+			OptionID:   &optionID,
+			GroupName:  ref.group,
+			Name:       ref.option.Name,
+			PriceDelta: ref.option.PriceDelta,
+			Quantity:   s.Quantity,
+		})
+	}
+	return line, nil
+}
+
+// Transition returns ErrInvalidStatusTransition unless actor may move the order to next. Dine-in
+// orders are served, takeaway orders picked up.
+func (o Order) Transition(next Status, actor Actor) error {
+	if err := o.Status.Transition(next, actor); err != nil {
+		return err
+	}
+	if (next == StatusServed && o.Type != TypeDineIn) || (next == StatusPickedUp && o.Type != TypeTakeaway) {
+		return ErrInvalidStatusTransition
+	}
+	return nil
+}
+
+// Move moves the order to next on behalf of actor. The reason is kept when the order is cancelled.
+func (o *Order) Move(next Status, actor Actor, reason string) error {
+	if err := o.Transition(next, actor); err != nil {
+		return err
+	}
+	if !validNote(reason) {
+		return ErrInvalidReason
+	}
+	o.Status = next
+	if next == StatusCancelled {
+		o.CancelReason = strings.TrimSpace(reason)
+	}
+	return nil
+}
+
+// Place checks a cart before it is placed as an order of type t and records how it is handed
+// over. Only dine-in orders take a table.
+func (o *Order) Place(t Type, tableID *int32, note string) error {
+	if err := o.Status.Transition(StatusPlaced, ActorCustomer); err != nil {
+		return err
+	}
+	if len(o.Items) == 0 {
+		return ErrEmptyCart
+	}
+	if t != TypeDineIn && tableID != nil {
+		return ErrTableNotAllowed
+	}
+	if !validNote(note) {
+		return ErrInvalidNote
+	}
+	o.Type = t
+	o.TableID = tableID
+	o.Note = strings.TrimSpace(note)
+	return nil
+}
+
+func validNote(note string) bool {
+	return utf8.RuneCountInString(strings.TrimSpace(note)) <= maxNoteLength
+}
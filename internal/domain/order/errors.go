@@ -0,0 +1,22 @@
+package order
+
+import "errors"
+
+var (
+	ErrOrderNotFound           = errors.New("Order not found")
+	ErrCartNotFound            = errors.New("Cart not found")
+	ErrCartItemNotFound        = errors.New("Cart item not found")
+	ErrEmptyCart               = errors.New("Cart is empty")
+	ErrTooManyItems            = errors.New("Too many items in cart")
+	ErrInvalidQuantity         = errors.New("Invalid quantity")
+	ErrInvalidNote             = errors.New("Invalid note")
+	ErrInvalidReason           = errors.New("Invalid reason")
+	ErrItemUnavailable         = errors.New("Menu item is sold out")
+	ErrInvalidOrderType        = errors.New("Invalid order type")
+	ErrTableNotAllowed         = errors.New("Only dine-in orders have a table")
+	ErrInvalidStatus           = errors.New("Invalid order status")
+	ErrInvalidStatusTransition = errors.New("Invalid order status transition")
+	ErrInvalidCursor           = errors.New("Invalid cursor")
+	ErrOrderChanged            = errors.New("Order was changed meanwhile, reload it")
+	ErrRestaurantClosed        = errors.New("Restaurant is closed")
+)
@@ -0,0 +1,38 @@
+package order
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ListFilter selects a page of placed orders, newest first. Status filters when set; BeforeID
+// is the id of the last order of the previous page, 0 for the first page.
+type ListFilter struct {
+	Status   *Status
+	BeforeID int64
+	Limit    int32
+}
+
+// Repository stores the orders. Writes to the lines of an order run in a transaction that also
+// recomputes the prices of the order.
+type Repository interface {
+	// GetCart returns ErrCartNotFound when the customer has no cart at the restaurant.
+	GetCart(ctx context.Context, restaurantID int32, customerID uuid.UUID) (*Order, error)
+	// AddCartItem adds a line to the cart of the customer at the restaurant, starting the cart
+	// when there is none, and returns the id of the line. It fails with ErrTooManyItems when the
+	// cart is full.
+	AddCartItem(ctx context.Context, restaurantID int32, customerID uuid.UUID, item *Item) (int64, error)
+	UpdateCartItem(ctx context.Context, restaurantID int32, customerID uuid.UUID, item *Item) error
+	DeleteCartItem(ctx context.Context, restaurantID int32, customerID uuid.UUID, itemID int64) error
+	DeleteCart(ctx context.Context, restaurantID int32, customerID uuid.UUID) error
+	// Place replaces the lines of the cart o with its Items and places it. It fails with
+	// ErrOrderChanged when the cart was changed since o was read.
+	Place(ctx context.Context, o *Order) error
+	Get(ctx context.Context, id int64) (*Order, error)
+	ListByCustomer(ctx context.Context, customerID uuid.UUID, filter ListFilter) ([]Order, error)
+	ListByRestaurant(ctx context.Context, restaurantID int32, filter ListFilter) ([]Order, error)
+	// UpdateStatus saves the Status and CancelReason of o. It fails with ErrOrderChanged when
+	// the order is no longer in status from.
+	UpdateStatus(ctx context.Context, o *Order, from Status) error
+}
@@ -0,0 +1,92 @@
+package order
+
+// Status is the stage of an order. A cart is an order the customer is still filling in; placing it
+// hands it to the restaurant, which accepts, prepares and hands it over. Dine-in orders are served
+// at the table, takeaway orders picked up at the counter.
+type Status string
+
+const (
+	StatusCart      Status = "cart"
+	StatusPlaced    Status = "placed"
+	StatusAccepted  Status = "accepted"
+	StatusPreparing Status = "preparing"
+	StatusReady     Status = "ready"
+	StatusServed    Status = "served"
+	StatusPickedUp  Status = "picked_up"
+	StatusCompleted Status = "completed"
+	StatusCancelled Status = "cancelled"
+)
+
+// Actor is who asks for a status change. Customers place their cart and may take the order back
+// until the restaurant accepts it; the staff of the restaurant move it along from there.
+type Actor int
+
+const (
+	ActorCustomer Actor = iota
+	ActorStaff
+)
+
+var statusTransitions = map[Actor]map[Status][]Status{
+	ActorCustomer: {
+		StatusCart:   {StatusPlaced},
+		StatusPlaced: {StatusCancelled},
+	},
+	ActorStaff: {
+		StatusPlaced:    {StatusAccepted, StatusCancelled},
+		StatusAccepted:  {StatusPreparing, StatusCancelled},
+		StatusPreparing: {StatusReady, StatusCancelled},
+		StatusReady:     {StatusServed, StatusPickedUp},
+		StatusServed:    {StatusCompleted},
+		StatusPickedUp:  {StatusCompleted},
+	},
+}
+
+func ParseStatus(s string) (Status, error) {
+	switch Status(s) {
+	case StatusCart, StatusPlaced, StatusAccepted, StatusPreparing, StatusReady,
+		StatusServed, StatusPickedUp, StatusCompleted, StatusCancelled:
+		return Status(s), nil
+	default:
+		return "", ErrInvalidStatus
+	}
+}
+
+// Statuses returns every status, in lifecycle order.
+func Statuses() []Status {
+	return []Status{
+		StatusCart, StatusPlaced, StatusAccepted, StatusPreparing, StatusReady,
+		StatusServed, StatusPickedUp, StatusCompleted, StatusCancelled,
+	}
+}
+
+// Transition returns ErrInvalidStatusTransition unless actor may move an order from s to next.
+func (s Status) Transition(next Status, actor Actor) error {
+	for _, allowed := range statusTransitions[actor][s] {
+		if allowed == next {
+			return nil
+		}
+	}
+	return ErrInvalidStatusTransition
+}
+
+// IsFinal reports whether an order in this status is over.
+func (s Status) IsFinal() bool {
+	return s == StatusCompleted || s == StatusCancelled
+}
+
+// Type is how the order is handed over.
+type Type string
+
+const (
+	TypeDineIn   Type = "dine_in"
+	TypeTakeaway Type = "takeaway"
+)
+
+func ParseType(s string) (Type, error) {
+	switch Type(s) {
+	case TypeDineIn, TypeTakeaway:
+		return Type(s), nil
+	default:
+		return "", ErrInvalidOrderType
+	}
+}
@@ -1,8 +1,13 @@
 package restaurant
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type Entity struct {
+	ID          int32
 	Name        string
 	Description string
 	Address     string
@@ -15,11 +20,22 @@ type Entity struct {
 	WebsiteUrl  string
 	Email       string
 	UserID      uuid.UUID
-	Hours       []Hours
+	Location    *GeoPoint
+	Timezone    string
+	Status      Status
+	// StatusReason is the reviewer note on the last rejection or suspension.
+	StatusReason string
+	CreatedAt    time.Time
+	// UpdatedAt moves on any change of the restaurant, its hours or its menu.
+	UpdatedAt time.Time
+	Hours     []Hours
 }
 
+// Hours is one shift of the weekly opening hours, in the restaurant timezone. A day may have
+// several shifts. IsClosed suspends a shift without losing its times.
 type Hours struct {
 	Day       DayOfWeek
 	OpenTime  string
 	CloseTime string
+	IsClosed  bool
 }
@@ -3,13 +3,34 @@ package restaurant
 import "errors"
 
 var (
-	ErrInvalidAddress       = errors.New("Invalid address")
-	ErrInvalidWebsite       = errors.New("Invalid website")
-	ErrInvalidLogo          = errors.New("Invalid logo")
-	ErrInvalidCity          = errors.New("Invalid city")
-	ErrInvalidBanner        = errors.New("Invalid banner")
-	ErrInvalidDistrict      = errors.New("Invalid district")
-	ErrInvalidPhoneNumber   = errors.New("Invalid phone number")
-	ErrRestaurantNameExitis = errors.New("Name restaurant exitis")
-	ErrRestaurantNoExitis   = errors.New("Restaurant not exitis")
+	ErrInvalidAddress          = errors.New("Invalid address")
+	ErrInvalidWebsite          = errors.New("Invalid website")
+	ErrInvalidLogo             = errors.New("Invalid logo")
+	ErrInvalidCity             = errors.New("Invalid city")
+	ErrInvalidBanner           = errors.New("Invalid banner")
+	ErrInvalidDistrict         = errors.New("Invalid district")
+	ErrInvalidPhoneNumber      = errors.New("Invalid phone number")
+	ErrRestaurantNameExitis    = errors.New("Name restaurant exitis")
+	ErrRestaurantNoExitis      = errors.New("Restaurant not exitis")
+	ErrRestaurantForbidden     = errors.New("You do not have permission on this restaurant")
+	ErrInvalidMemberRole       = errors.New("Invalid member role")
+	ErrMemberNotFound          = errors.New("Member not found")
+	ErrCannotRemoveOwner       = errors.New("Owner cannot be removed from the restaurant")
+	ErrCannotChangeOwnRole     = errors.New("You cannot change your own role in the restaurant")
+	ErrUserNotFound            = errors.New("User not found")
+	ErrInvalidSort             = errors.New("Invalid sort")
+	ErrInvalidCursor           = errors.New("Invalid cursor")
+	ErrInvalidSearchQuery      = errors.New("Search query must contain at least one word")
+	ErrInvalidLocation         = errors.New("Invalid location")
+	ErrGeocodeNotFound         = errors.New("Address could not be located")
+	ErrInvalidHours            = errors.New("Invalid opening hours")
+	ErrOverlappingHours        = errors.New("Opening hours overlap")
+	ErrInvalidTimezone         = errors.New("Invalid timezone")
+	ErrInvalidSpecialHours     = errors.New("Invalid special hours")
+	ErrSpecialHoursNotFound    = errors.New("Special hours not found")
+	ErrInvalidStatus           = errors.New("Invalid status")
+	ErrInvalidStatusTransition = errors.New("Status change is not allowed")
+	ErrStatusReasonRequired    = errors.New("A reason is required to reject or suspend a restaurant")
+	ErrStatusChanged           = errors.New("Status was changed meanwhile, reload the restaurant")
+	ErrHoursNotFound           = errors.New("No opening hours on this day")
 )
@@ -0,0 +1,59 @@
+package restaurant
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// earthRadiusMeters is the mean earth radius used for great-circle distances.
+const earthRadiusMeters = 6371000
+
+// GeoPoint is a WGS84 coordinate in decimal degrees.
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+func (p GeoPoint) Valid() bool {
+	return p.Latitude >= -90 && p.Latitude <= 90 && p.Longitude >= -180 && p.Longitude <= 180
+}
+
+// BoundingBox returns the smallest latitude/longitude box holding every point within radius
+// meters of p. It is used to narrow candidates with the location index before computing exact
+// distances. Near the poles or across the antimeridian the box falls back to every longitude.
+func (p GeoPoint) BoundingBox(radius float64) (min, max GeoPoint) {
+	dLat := radius / earthRadiusMeters * 180 / math.Pi
+	min.Latitude = math.Max(p.Latitude-dLat, -90)
+	max.Latitude = math.Min(p.Latitude+dLat, 90)
+	min.Longitude, max.Longitude = -180, 180
+	if max.Latitude < 90 && min.Latitude > -90 {
+		dLng := dLat / math.Cos(p.Latitude*math.Pi/180)
+		if p.Longitude-dLng >= -180 && p.Longitude+dLng <= 180 {
+			min.Longitude = p.Longitude - dLng
+			max.Longitude = p.Longitude + dLng
+		}
+	}
+	return min, max
+}
+
+// Geocoder turns a free text address into coordinates. It returns ErrGeocodeNotFound when the
+// address cannot be located.
+type Geocoder interface {
+	Geocode(ctx context.Context, address, district, city string) (*GeoPoint, error)
+}
+
+// NearbyFilter selects the restaurants within RadiusMeters of Center, nearest first. Empty fields
+// do not filter; OpenAt works as in ListFilter.
+type NearbyFilter struct {
+	Center       GeoPoint
+	RadiusMeters float64
+	Category     string
+	OpenAt       *time.Time
+	Limit        int32
+}
+
+type NearbyResult struct {
+	Restaurant     Entity
+	DistanceMeters float64
+}
@@ -0,0 +1,52 @@
+package restaurant
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ListSort orders a restaurant list. A leading "-" sorts descending; ties are broken by id so
+// every position in the list is unique and can be used as a cursor.
+type ListSort string
+
+const (
+	SortNameAsc       ListSort = "name"
+	SortNameDesc      ListSort = "-name"
+	SortCreatedAtAsc  ListSort = "created_at"
+	SortCreatedAtDesc ListSort = "-created_at"
+)
+
+func ParseListSort(s string) (ListSort, error) {
+	switch ListSort(s) {
+	case "":
+		return SortCreatedAtDesc, nil
+	case SortNameAsc, SortNameDesc, SortCreatedAtAsc, SortCreatedAtDesc:
+		return ListSort(s), nil
+	default:
+		return "", ErrInvalidSort
+	}
+}
+
+// ListCursor is the sort position of the last restaurant of a page; the next page starts right
+// after it.
+type ListCursor struct {
+	ID        int32
+	Name      string
+	CreatedAt time.Time
+}
+
+// ListFilter selects a page of restaurants. Empty fields do not filter. OpenAt keeps only the
+// restaurants whose hours, read in their own timezone, cover that instant. Statuses must not be
+// empty; public lists pass only StatusActive.
+type ListFilter struct {
+	Statuses []Status
+	Category string
+	City     string
+	District string
+	OwnerID  *uuid.UUID
+	OpenAt   *time.Time
+	Sort     ListSort
+	After    *ListCursor
+	Limit    int32
+}
@@ -0,0 +1,51 @@
+package restaurant
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MemberRole string
+
+const (
+	MemberRoleOwner   MemberRole = "owner"
+	MemberRoleManager MemberRole = "manager"
+	MemberRoleStaff   MemberRole = "staff"
+)
+
+type Member struct {
+	RestaurantID int32
+	UserID       uuid.UUID
+	Role         MemberRole
+	CreatedAt    time.Time
+}
+
+func ParseMemberRole(s string) (MemberRole, error) {
+	switch MemberRole(s) {
+	case MemberRoleOwner, MemberRoleManager, MemberRoleStaff:
+		return MemberRole(s), nil
+	default:
+		return "", ErrInvalidMemberRole
+	}
+}
+
+// CanUpdate reports whether the member may edit the restaurant profile and its hours.
+func (r MemberRole) CanUpdate() bool {
+	return r == MemberRoleOwner || r == MemberRoleManager
+}
+
+// CanDelete reports whether the member may delete the restaurant.
+func (r MemberRole) CanDelete() bool {
+	return r == MemberRoleOwner
+}
+
+// CanChangeStatus reports whether the member may submit the restaurant for review or close it.
+func (r MemberRole) CanChangeStatus() bool {
+	return r == MemberRoleOwner
+}
+
+// CanManageMembers reports whether the member may add or remove other members.
+func (r MemberRole) CanManageMembers() bool {
+	return r == MemberRoleOwner
+}
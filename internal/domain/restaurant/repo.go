@@ -1,11 +1,32 @@
 package restaurant
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type Repository interface {
 	Create(ctx context.Context, r *Entity) (int32, error)
 	GetById(ctx context.Context, id int32) (*Entity, error)
 	GetByName(ctx context.Context, name string) (*Entity, error)
+	List(ctx context.Context, filter ListFilter) ([]Entity, error)
+	Search(ctx context.Context, filter SearchFilter) ([]SearchResult, error)
+	ListNearby(ctx context.Context, filter NearbyFilter) ([]NearbyResult, error)
 	Update(ctx context.Context, r *Entity, id int32) error
 	Delete(ctx context.Context, id int32) error
+	// SetStatus fails with ErrStatusChanged when the restaurant is no longer in status from, so
+	// concurrent changes cannot both pass the transition check on the old status.
+	SetStatus(ctx context.Context, id int32, status Status, reason string, from Status) error
+	SetDayClosed(ctx context.Context, id int32, day DayOfWeek, closed bool) error
+	GetMember(ctx context.Context, restaurantID int32, userID uuid.UUID) (*Member, error)
+	ListMembers(ctx context.Context, restaurantID int32) ([]Member, error)
+	// UpsertMember fails with ErrUserNotFound when no user has the id of the member.
+	UpsertMember(ctx context.Context, m *Member) error
+	DeleteMember(ctx context.Context, restaurantID int32, userID uuid.UUID) error
+	ListSpecialHours(ctx context.Context, restaurantID int32, from *time.Time) ([]SpecialHours, error)
+	CreateSpecialHours(ctx context.Context, s *SpecialHours) (int32, error)
+	UpdateSpecialHours(ctx context.Context, s *SpecialHours) error
+	DeleteSpecialHours(ctx context.Context, restaurantID, id int32) error
 }
@@ -0,0 +1,231 @@
+package restaurant
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	secondsPerDay  = 24 * 60 * 60
+	secondsPerWeek = 7 * secondsPerDay
+)
+
+// clock is a wall clock time of day as stored in restaurant_hours.
+type clock struct {
+	hour, minute, second int
+}
+
+func parseClock(s string) (clock, error) {
+	for _, layout := range []string{time.TimeOnly, "15:04"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return clock{t.Hour(), t.Minute(), t.Second()}, nil
+		}
+	}
+	return clock{}, ErrInvalidHours
+}
+
+func (c clock) seconds() int {
+	return c.hour*3600 + c.minute*60 + c.second
+}
+
+// Overnight tells whether the shift runs past midnight into the next day. A shift that closes at
+// or before it opens does; the same open and close time is a 24 hour shift.
+func (h Hours) Overnight() bool {
+	open, err := parseClock(h.OpenTime)
+	if err != nil {
+		return false
+	}
+	close, err := parseClock(h.CloseTime)
+	if err != nil {
+		return false
+	}
+	return close.seconds() <= open.seconds()
+}
+
+// ValidateHours checks the times of every shift and that no two shifts of the week overlap,
+// overnight ones included. Closed shifts are checked too so they can be reopened as they are.
+func ValidateHours(hours []Hours) error {
+	type span struct{ start, end int }
+	spans := make([]span, 0, len(hours))
+	for _, h := range hours {
+		if h.Day < Sunday || h.Day > Saturday {
+			return ErrInvalidHours
+		}
+		open, err := parseClock(h.OpenTime)
+		if err != nil {
+			return err
+		}
+		close, err := parseClock(h.CloseTime)
+		if err != nil {
+			return err
+		}
+		start := int(h.Day)*secondsPerDay + open.seconds()
+		length := close.seconds() - open.seconds()
+		if length <= 0 {
+			length += secondsPerDay
+		}
+		spans = append(spans, span{start, start + length})
+	}
+	// the week wraps around, a Saturday night shift can run into Sunday morning
+	for i := range spans {
+		for j := i + 1; j < len(spans); j++ {
+			for _, shift := range []int{-secondsPerWeek, 0, secondsPerWeek} {
+				if spans[i].start < spans[j].end+shift && spans[j].start+shift < spans[i].end {
+					return ErrOverlappingHours
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Shift is one opening period at concrete instants.
+type Shift struct {
+	Start time.Time
+	End   time.Time
+}
+
+// scheduleDays is how far ahead opening periods are laid out: a week, plus the day before whose
+// overnight shifts may still be running. maxScheduleDays caps the search for the next opening
+// through long special closures.
+const (
+	scheduleDays    = 9
+	maxScheduleDays = maxSpecialHoursDays + scheduleDays
+)
+
+// Schedule answers when a restaurant is open from its weekly hours and special hours, read as wall
+// clock times in the restaurant timezone. Several shifts per day and overnight shifts are
+// supported; closed shifts are skipped.
+type Schedule struct {
+	hours   []Hours
+	special []SpecialHours
+	loc     *time.Location
+}
+
+func NewSchedule(hours []Hours, special []SpecialHours, loc *time.Location) *Schedule {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return &Schedule{
+		hours:   hours,
+		special: special,
+		loc:     loc,
+	}
+}
+
+// IsOpenAt tells whether one of the shifts covers t.
+func (s *Schedule) IsOpenAt(t time.Time) bool {
+	_, ok := s.shiftAt(t)
+	return ok
+}
+
+// ClosesAt returns when the opening period covering t ends. Back to back shifts, such as one
+// ending at midnight and the next starting then, count as a single period. There is none when
+// closed at t, or when open around the clock.
+func (s *Schedule) ClosesAt(t time.Time) (time.Time, bool) {
+	shift, ok := s.shiftAt(t)
+	if !ok || shift.End.Sub(shift.Start) >= 7*24*time.Hour {
+		return time.Time{}, false
+	}
+	return shift.End, true
+}
+
+// NextOpening returns the start of the first opening period after t, within the coming week or
+// past the special hours ahead. There is none when the restaurant has no open shift, or never
+// closes.
+func (s *Schedule) NextOpening(t time.Time) (time.Time, bool) {
+	days := scheduleDays
+	today := DateOf(t.In(s.loc))
+	for _, sh := range s.special {
+		if d := int(sh.EndDate.Sub(today).Hours()/24) + scheduleDays; d > days {
+			days = min(d, maxScheduleDays)
+		}
+	}
+	for _, shift := range s.shiftsAround(t, days) {
+		if shift.Start.After(t) {
+			return shift.Start, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func (s *Schedule) shiftAt(t time.Time) (Shift, bool) {
+	for _, shift := range s.shiftsAround(t, scheduleDays) {
+		if !shift.Start.After(t) && t.Before(shift.End) {
+			return shift, true
+		}
+	}
+	return Shift{}, false
+}
+
+// shiftsAround lays the hours on the calendar for days days from the day before t, whose
+// overnight shifts may still be running, merging periods that touch or overlap.
+func (s *Schedule) shiftsAround(t time.Time, days int) []Shift {
+	y, m, d := t.In(s.loc).Date()
+	shifts := []Shift{}
+	for offset := -1; offset < days-1; offset++ {
+		day := time.Date(y, m, d+offset, 0, 0, 0, 0, s.loc)
+		for _, h := range s.hoursOn(day) {
+			open, err := parseClock(h.OpenTime)
+			if err != nil {
+				continue
+			}
+			close, err := parseClock(h.CloseTime)
+			if err != nil {
+				continue
+			}
+			closeDay := d + offset
+			if h.Overnight() {
+				closeDay++
+			}
+			shifts = append(shifts, Shift{
+				Start: time.Date(y, m, d+offset, open.hour, open.minute, open.second, 0, s.loc),
+				End:   time.Date(y, m, closeDay, close.hour, close.minute, close.second, 0, s.loc),
+			})
+		}
+	}
+	sort.Slice(shifts, func(i, j int) bool {
+		return shifts[i].Start.Before(shifts[j].Start)
+	})
+	merged := make([]Shift, 0, len(shifts))
+	for _, shift := range shifts {
+		if n := len(merged); n > 0 && !shift.Start.After(merged[n-1].End) {
+			if shift.End.After(merged[n-1].End) {
+				merged[n-1].End = shift.End
+			}
+			continue
+		}
+		merged = append(merged, shift)
+	}
+	return merged
+}
+
+// hoursOn returns the open shifts of a day: its special hours when it has some, its weekly hours
+// otherwise.
+func (s *Schedule) hoursOn(day time.Time) []Hours {
+	hours := []Hours{}
+	special := false
+	for _, sh := range s.special {
+		if !sh.Covers(day) {
+			continue
+		}
+		if sh.IsClosed {
+			return nil
+		}
+		special = true
+		hours = append(hours, Hours{
+			Day:       DayOfWeek(day.Weekday()),
+			OpenTime:  sh.OpenTime,
+			CloseTime: sh.CloseTime,
+		})
+	}
+	if special {
+		return hours
+	}
+	for _, h := range s.hours {
+		if !h.IsClosed && h.Day == DayOfWeek(day.Weekday()) {
+			hours = append(hours, h)
+		}
+	}
+	return hours
+}
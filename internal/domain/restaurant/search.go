@@ -0,0 +1,40 @@
+package restaurant
+
+import (
+	"strings"
+	"unicode"
+)
+
+// maxSearchTerms bounds the work a single query can ask of the index.
+const maxSearchTerms = 8
+
+// SearchFilter selects a page of restaurants matching every term as a word prefix, ignoring case
+// and Vietnamese diacritics.
+type SearchFilter struct {
+	Terms    []string
+	Category string
+	City     string
+	Limit    int32
+	Offset   int32
+}
+
+// SearchResult is a restaurant matching a search. NameHighlight and Snippet are HTML escaped with
+// the matched words wrapped in <mark>.
+type SearchResult struct {
+	Restaurant    Entity
+	Rank          float32
+	NameHighlight string
+	Snippet       string
+}
+
+// SearchTerms splits free text into the words a search matches on. Punctuation separates words
+// and is dropped.
+func SearchTerms(q string) []string {
+	terms := strings.FieldsFunc(strings.ToLower(q), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r)
+	})
+	if len(terms) > maxSearchTerms {
+		terms = terms[:maxSearchTerms]
+	}
+	return terms
+}
@@ -0,0 +1,29 @@
+package restaurant
+
+import (
+	"go-ai/pkg/utils"
+	"strconv"
+	"strings"
+)
+
+// Slug is the public URL name of a restaurant: its name made URL safe followed by its id, such as
+// "com-tam-ba-ghien-42". The id keeps slugs unique and lets a renamed restaurant keep its links. A
+// name without any latin letter or digit gives the id alone.
+func Slug(id int32, name string) string {
+	base := utils.Slugify(name)
+	if base == "" {
+		return strconv.Itoa(int(id))
+	}
+	return base + "-" + strconv.Itoa(int(id))
+}
+
+// ParseSlug returns the id a slug ends with. The name part is not checked; callers compare the
+// slug with the canonical one and redirect when it is stale.
+func ParseSlug(slug string) (int32, bool) {
+	idPart := slug[strings.LastIndex(slug, "-")+1:]
+	id, err := strconv.ParseInt(idPart, 10, 32)
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+	return int32(id), true
+}
@@ -0,0 +1,59 @@
+package restaurant
+
+import "time"
+
+// maxSpecialHoursDays bounds a single rule; longer closures are better served by the restaurant
+// status.
+const maxSpecialHoursDays = 366
+
+// SpecialHours overrides the weekly hours from StartDate to EndDate inclusive: a holiday, a
+// one-off event or a temporary closure. A day covered by special hours only uses those; a closed
+// rule closes it whole, otherwise its shifts are the ones of the rules covering it. Dates are
+// calendar dates at midnight UTC.
+type SpecialHours struct {
+	ID           int32
+	RestaurantID int32
+	StartDate    time.Time
+	EndDate      time.Time
+	IsClosed     bool
+	OpenTime     string
+	CloseTime    string
+	Note         string
+	CreatedAt    time.Time
+}
+
+func (s SpecialHours) Validate() error {
+	if s.StartDate.IsZero() || s.EndDate.Before(s.StartDate) {
+		return ErrInvalidSpecialHours
+	}
+	if s.EndDate.Sub(s.StartDate) >= maxSpecialHoursDays*24*time.Hour {
+		return ErrInvalidSpecialHours
+	}
+	if s.IsClosed {
+		return nil
+	}
+	if _, err := parseClock(s.OpenTime); err != nil {
+		return ErrInvalidSpecialHours
+	}
+	if _, err := parseClock(s.CloseTime); err != nil {
+		return ErrInvalidSpecialHours
+	}
+	return nil
+}
+
+// Overnight tells whether the alternate shift runs past midnight, as Hours.Overnight.
+func (s SpecialHours) Overnight() bool {
+	return !s.IsClosed && Hours{OpenTime: s.OpenTime, CloseTime: s.CloseTime}.Overnight()
+}
+
+// Covers tells whether the rule applies to the calendar date of day, in the location of day.
+func (s SpecialHours) Covers(day time.Time) bool {
+	date := DateOf(day)
+	return !date.Before(s.StartDate) && !date.After(s.EndDate)
+}
+
+// DateOf returns the calendar date of t in its location, as stored in SpecialHours.
+func DateOf(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
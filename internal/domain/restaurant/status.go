@@ -0,0 +1,72 @@
+package restaurant
+
+// Status is the lifecycle stage of a restaurant. Only active restaurants are shown to the public;
+// the other stages are visible to the restaurant members and to reviewers.
+type Status string
+
+const (
+	StatusDraft         Status = "draft"
+	StatusPendingReview Status = "pending_review"
+	StatusActive        Status = "active"
+	StatusSuspended     Status = "suspended"
+	StatusClosed        Status = "closed"
+)
+
+// StatusActor is who asks for a status change. Owners move their restaurant in and out of
+// review; reviewers approve, reject and suspend.
+type StatusActor int
+
+const (
+	ActorOwner StatusActor = iota
+	ActorReviewer
+)
+
+var statusTransitions = map[StatusActor]map[Status][]Status{
+	ActorOwner: {
+		StatusDraft:         {StatusPendingReview},
+		StatusPendingReview: {StatusDraft},
+		StatusActive:        {StatusClosed},
+		StatusSuspended:     {StatusClosed},
+		StatusClosed:        {StatusPendingReview},
+	},
+	ActorReviewer: {
+		StatusPendingReview: {StatusActive, StatusDraft},
+		StatusActive:        {StatusSuspended, StatusClosed},
+		StatusSuspended:     {StatusActive, StatusClosed},
+	},
+}
+
+func ParseStatus(s string) (Status, error) {
+	switch Status(s) {
+	case StatusDraft, StatusPendingReview, StatusActive, StatusSuspended, StatusClosed:
+		return Status(s), nil
+	default:
+		return "", ErrInvalidStatus
+	}
+}
+
+// Statuses returns every status, in lifecycle order.
+func Statuses() []Status {
+	return []Status{StatusDraft, StatusPendingReview, StatusActive, StatusSuspended, StatusClosed}
+}
+
+// Transition returns ErrInvalidStatusTransition unless actor may move a restaurant from s to next.
+func (s Status) Transition(next Status, actor StatusActor) error {
+	for _, allowed := range statusTransitions[actor][s] {
+		if allowed == next {
+			return nil
+		}
+	}
+	return ErrInvalidStatusTransition
+}
+
+// NeedsReason reports whether a reviewer moving a restaurant from s to next must explain it to the
+// members: rejecting a submission or suspending.
+func (s Status) NeedsReason(next Status) bool {
+	return (s == StatusPendingReview && next == StatusDraft) || next == StatusSuspended
+}
+
+// IsPublic reports whether restaurants in this status are listed to everyone.
+func (s Status) IsPublic() bool {
+	return s == StatusActive
+}
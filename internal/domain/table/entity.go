@@ -0,0 +1,43 @@
+package table
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	maxNameLength = 60
+	maxSeats      = 100
+)
+
+// Table is a dining table of a restaurant. Its QR code links to the public menu with the table
+// selected; QrPngObject and QrSvgObject name the images in object storage once generated. An
+// inactive table keeps its QR code but the link no longer selects it.
+type Table struct {
+	ID            int32
+	RestaurantID  int32
+	Name          string
+	Seats         int32
+	IsActive      bool
+	QrPngObject   string
+	QrSvgObject   string
+	QrGeneratedAt *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (t Table) Validate() error {
+	name := strings.TrimSpace(t.Name)
+	if name == "" || utf8.RuneCountInString(name) > maxNameLength {
+		return ErrInvalidTableName
+	}
+	if t.Seats < 0 || t.Seats > maxSeats {
+		return ErrInvalidSeats
+	}
+	return nil
+}
+
+func (t Table) HasQR() bool {
+	return t.QrGeneratedAt != nil && t.QrPngObject != "" && t.QrSvgObject != ""
+}
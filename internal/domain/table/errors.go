@@ -0,0 +1,11 @@
+package table
+
+import "errors"
+
+var (
+	ErrInvalidTableName = errors.New("Invalid table name")
+	ErrInvalidSeats     = errors.New("Invalid number of seats")
+	ErrTableExists      = errors.New("Table name already exists")
+	ErrTableNotFound    = errors.New("Table not found")
+	ErrInvalidTableLink = errors.New("Invalid table link")
+)
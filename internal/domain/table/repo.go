@@ -0,0 +1,14 @@
+package table
+
+import "context"
+
+// Repository stores the tables. Every lookup is scoped by restaurant so an id from another
+// restaurant reads as not found.
+type Repository interface {
+	List(ctx context.Context, restaurantID int32) ([]Table, error)
+	Get(ctx context.Context, restaurantID, id int32) (*Table, error)
+	Create(ctx context.Context, t *Table) (int32, error)
+	Update(ctx context.Context, t *Table) error
+	SetQR(ctx context.Context, restaurantID, id int32, pngObject, svgObject string) error
+	Delete(ctx context.Context, restaurantID, id int32) error
+}
@@ -3,6 +3,7 @@ package cache
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -56,20 +57,31 @@ func (authCache *AuthCache) SetAuthCache(key string, value *AuthData, ttl time.D
 	return nil
 }
 
-func (authCache *AuthCache) SetRefreshTokenCache(key string, refersh string, ttl time.Duration) error {
-	err := authCache.Redis.Set(authCache.Ctx, key, refersh, ttl).Err()
+func (authCache *AuthCache) SetPermissionsCache(key string, permissions []string, ttl time.Duration) error {
+	b, err := json.Marshal(permissions)
+	if err != nil {
+		return err
+	}
+	err = authCache.Redis.Set(authCache.Ctx, key, string(b), ttl).Err()
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (authCache *AuthCache) GetRefreshTokenCache(key string) (string, error) {
-	value, err := authCache.Redis.Get(authCache.Ctx, key).Result()
+func (authCache *AuthCache) GetPermissionsCache(key string) ([]string, error) {
+	val, err := authCache.Redis.Get(authCache.Ctx, key).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	return value, nil
+	permissions := []string{}
+	if err := json.Unmarshal([]byte(val), &permissions); err != nil {
+		return nil, err
+	}
+	return permissions, nil
 }
 
 func (authCache *AuthCache) DeleteAuthCache(key string) error {
@@ -79,3 +91,47 @@ func (authCache *AuthCache) DeleteAuthCache(key string) error {
 	}
 	return nil
 }
+
+// SetRevokedTokenCache denylists an access token by its jti until it would have expired anyway.
+func (authCache *AuthCache) SetRevokedTokenCache(key string, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+	err := authCache.Redis.Set(authCache.Ctx, key, "1", ttl).Err()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (authCache *AuthCache) IsRevokedTokenCache(key string) (bool, error) {
+	n, err := authCache.Redis.Exists(authCache.Ctx, key).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// SetRevokedBeforeCache stores the instant before which every token of a user is rejected.
+func (authCache *AuthCache) SetRevokedBeforeCache(key string, at time.Time, ttl time.Duration) error {
+	err := authCache.Redis.Set(authCache.Ctx, key, at.Unix(), ttl).Err()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (authCache *AuthCache) GetRevokedBeforeCache(key string) (time.Time, error) {
+	val, err := authCache.Redis.Get(authCache.Ctx, key).Result()
+	if err == redis.Nil {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	unix, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(unix, 0), nil
+}
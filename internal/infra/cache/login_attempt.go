@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// incrLoginFailureScript increments KEYS[1] and starts its ARGV[1] milliseconds window when the
+// counter has none, in one step so a counter is never left without expiry.
+var incrLoginFailureScript = redis.NewScript(`
+local n = redis.call('INCR', KEYS[1])
+if redis.call('PTTL', KEYS[1]) < 0 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return n
+`)
+
+// IncrLoginFailureCache counts a failed login under key. The window starts at the first failure
+// and is not extended by later ones.
+func (authCache *AuthCache) IncrLoginFailureCache(key string, window time.Duration) (int64, error) {
+	return incrLoginFailureScript.Run(authCache.Ctx, authCache.Redis, []string{key}, window.Milliseconds()).Int64()
+}
+
+func (authCache *AuthCache) SetLockoutCache(key string, ttl time.Duration) error {
+	err := authCache.Redis.Set(authCache.Ctx, key, "1", ttl).Err()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetLockoutCache returns how long the lockout under key still lasts, zero when there is none.
+func (authCache *AuthCache) GetLockoutCache(key string) (time.Duration, error) {
+	ttl, err := authCache.Redis.TTL(authCache.Ctx, key).Result()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
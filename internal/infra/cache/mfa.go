@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// MfaChallengeData is the state of a login that passed the password check and is waiting for the
+// second factor. Email keys the login failure counter the wrong codes are added to.
+type MfaChallengeData struct {
+	UserId    uuid.UUID
+	Email     string
+	UserAgent string
+	IpAddress string
+	Attempts  int
+}
+
+func (authCache *AuthCache) SetMfaChallengeCache(key string, value *MfaChallengeData, ttl time.Duration) error {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	err = authCache.Redis.Set(authCache.Ctx, key, string(b), ttl).Err()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateMfaChallengeCache rewrites the challenge without extending its expiry.
+func (authCache *AuthCache) UpdateMfaChallengeCache(key string, value *MfaChallengeData) error {
+	return authCache.SetMfaChallengeCache(key, value, redis.KeepTTL)
+}
+
+func (authCache *AuthCache) GetMfaChallengeCache(key string) (*MfaChallengeData, error) {
+	val, err := authCache.Redis.Get(authCache.Ctx, key).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	challenge := &MfaChallengeData{}
+	if err := json.Unmarshal([]byte(val), challenge); err != nil {
+		return nil, err
+	}
+	return challenge, nil
+}
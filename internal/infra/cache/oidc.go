@@ -0,0 +1,43 @@
+package cache
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// OIDCStateData is what an authorization request leaves behind for its callback.
+type OIDCStateData struct {
+	Provider     string
+	Nonce        string
+	CodeVerifier string
+}
+
+func (authCache *AuthCache) SetOIDCStateCache(key string, value *OIDCStateData, ttl time.Duration) error {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	err = authCache.Redis.Set(authCache.Ctx, key, string(b), ttl).Err()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// TakeOIDCStateCache reads and deletes the state in one step so a callback can only be used once.
+func (authCache *AuthCache) TakeOIDCStateCache(key string) (*OIDCStateData, error) {
+	val, err := authCache.Redis.GetDel(authCache.Ctx, key).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	state := &OIDCStateData{}
+	if err := json.Unmarshal([]byte(val), state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
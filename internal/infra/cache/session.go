@@ -0,0 +1,136 @@
+package cache
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+type SessionData struct {
+	SessionId        string
+	UserId           uuid.UUID
+	RefreshTokenHash string
+	UserAgent        string
+	IpAddress        string
+	CreatedAt        time.Time
+	LastUsedAt       time.Time
+}
+
+// SetSessionCache stores the session under key and indexes key in the listKey set of the user.
+func (authCache *AuthCache) SetSessionCache(listKey string, key string, value *SessionData, ttl time.Duration) error {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	pipe := authCache.Redis.TxPipeline()
+	pipe.Set(authCache.Ctx, key, string(b), ttl)
+	pipe.SAdd(authCache.Ctx, listKey, key)
+	pipe.Expire(authCache.Ctx, listKey, ttl)
+	if _, err := pipe.Exec(authCache.Ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// rotateSessionScript replaces the session in KEYS[2] only while it still holds the refresh token
+// hash ARGV[1], it returns 0 when the session is gone or another rotation came first.
+var rotateSessionScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[2])
+if not current or cjson.decode(current).RefreshTokenHash ~= ARGV[1] then
+	return 0
+end
+redis.call('SET', KEYS[2], ARGV[2], 'PX', ARGV[3])
+redis.call('SADD', KEYS[1], KEYS[2])
+redis.call('PEXPIRE', KEYS[1], ARGV[3])
+return 1
+`)
+
+// RotateSessionCache stores value under key in one atomic step, provided the stored session still
+// holds the refresh token hash oldHash, so a refresh token is consumed once. It returns false when
+// the token was already consumed or the session revoked meanwhile.
+func (authCache *AuthCache) RotateSessionCache(listKey string, key string, oldHash string, value *SessionData, ttl time.Duration) (bool, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return false, err
+	}
+	n, err := rotateSessionScript.Run(authCache.Ctx, authCache.Redis, []string{listKey, key}, oldHash, string(b), ttl.Milliseconds()).Int64()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+func (authCache *AuthCache) GetSessionCache(key string) (*SessionData, error) {
+	val, err := authCache.Redis.Get(authCache.Ctx, key).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	session := &SessionData{}
+	if err := json.Unmarshal([]byte(val), session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// GetSessionsCache returns every live session indexed in listKey and prunes the expired ones.
+func (authCache *AuthCache) GetSessionsCache(listKey string) ([]SessionData, error) {
+	keys, err := authCache.Redis.SMembers(authCache.Ctx, listKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return []SessionData{}, nil
+	}
+	values, err := authCache.Redis.MGet(authCache.Ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]SessionData, 0, len(values))
+	expired := []any{}
+	for i, v := range values {
+		raw, ok := v.(string)
+		if !ok {
+			expired = append(expired, keys[i])
+			continue
+		}
+		session := SessionData{}
+		if err := json.Unmarshal([]byte(raw), &session); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	if len(expired) > 0 {
+		if err := authCache.Redis.SRem(authCache.Ctx, listKey, expired...).Err(); err != nil {
+			return nil, err
+		}
+	}
+	return sessions, nil
+}
+
+func (authCache *AuthCache) DeleteSessionCache(listKey string, key string) error {
+	pipe := authCache.Redis.TxPipeline()
+	pipe.Del(authCache.Ctx, key)
+	pipe.SRem(authCache.Ctx, listKey, key)
+	if _, err := pipe.Exec(authCache.Ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteSessionsCache removes every session indexed in listKey together with the index itself.
+func (authCache *AuthCache) DeleteSessionsCache(listKey string) error {
+	keys, err := authCache.Redis.SMembers(authCache.Ctx, listKey).Result()
+	if err != nil {
+		return err
+	}
+	keys = append(keys, listKey)
+	if err := authCache.Redis.Del(authCache.Ctx, keys...).Err(); err != nil {
+		return err
+	}
+	return nil
+}
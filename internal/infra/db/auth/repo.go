@@ -2,20 +2,30 @@ package authrepo
 
 import (
 	"context"
+	"errors"
 	auth "go-ai/internal/domain/auth"
 	sqlc "go-ai/internal/infra/sqlc/user"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// likeEscaper escapes the LIKE wildcards and the escape character itself, see ESCAPE '\' in the
+// ListUsers and CountUsers queries.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 type AuthRepo struct {
-	q *sqlc.Queries
+	pool *pgxpool.Pool
+	q    *sqlc.Queries
 }
 
 func NewAuthRepo(pool *pgxpool.Pool) *AuthRepo {
 	return &AuthRepo{
-		q: sqlc.New(pool),
+		q:    sqlc.New(pool),
+		pool: pool,
 	}
 }
 
@@ -26,12 +36,14 @@ func (au *AuthRepo) GetByEmail(ctx context.Context, email string) (*auth.Entity,
 	}
 
 	return &auth.Entity{
-		ID:       u.ID,
-		Email:    *u.Email,
-		FullName: u.FullName,
-		Password: u.PasswordHash,
-		Role:     *u.RoleName,
-		IsActive: u.IsActive,
+		ID:              u.ID,
+		Email:           *u.Email,
+		FullName:        u.FullName,
+		Password:        u.PasswordHash,
+		Role:            *u.RoleName,
+		IsActive:        u.IsActive,
+		EmailVerifiedAt: u.EmailVerifiedAt,
+		CreatedAt:       u.CreatedAt,
 	}, nil
 }
 
@@ -53,11 +65,13 @@ func (au *AuthRepo) GetByName(ctx context.Context, name string) (*auth.Entity, e
 		return nil, err
 	}
 	return &auth.Entity{
-		ID:       u.ID,
-		Email:    *u.Email,
-		FullName: u.FullName,
-		Role:     *u.RoleName,
-		IsActive: u.IsActive,
+		ID:              u.ID,
+		Email:           *u.Email,
+		FullName:        u.FullName,
+		Role:            *u.RoleName,
+		IsActive:        u.IsActive,
+		EmailVerifiedAt: u.EmailVerifiedAt,
+		CreatedAt:       u.CreatedAt,
 	}, nil
 }
 
@@ -67,10 +81,487 @@ func (au *AuthRepo) GetById(ctx context.Context, id uuid.UUID) (*auth.Entity, er
 		return nil, err
 	}
 	return &auth.Entity{
-		ID:       u.ID,
-		Email:    *u.Email,
-		FullName: u.FullName,
-		Role:     *u.RoleName,
-		IsActive: u.IsActive,
+		ID:              u.ID,
+		Email:           *u.Email,
+		FullName:        u.FullName,
+		Role:            *u.RoleName,
+		IsActive:        u.IsActive,
+		EmailVerifiedAt: u.EmailVerifiedAt,
+		CreatedAt:       u.CreatedAt,
 	}, nil
 }
+
+// UpdateUser writes the full name and email of the user. A new email is unverified: its
+// verification time is cleared and the links mailed for the previous address stop working.
+func (au *AuthRepo) UpdateUser(ctx context.Context, a *auth.Entity) error {
+	tx, err := au.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := au.q.WithTx(tx)
+	previous, err := qtx.GetUserEmailForUpdate(ctx, a.ID)
+	if err != nil {
+		return err
+	}
+	_, err = qtx.UpdateUser(ctx, sqlc.UpdateUserParams{
+		FullName: a.FullName,
+		Email:    &a.Email,
+		ID:       a.ID,
+	})
+	if err != nil {
+		return err
+	}
+	if previous == nil || !strings.EqualFold(*previous, a.Email) {
+		if err := qtx.UseUserEmailVerificationTokens(ctx, a.ID); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (au *AuthRepo) GetPasswordHash(ctx context.Context, id uuid.UUID) (string, error) {
+	return au.q.GetUserPasswordHash(ctx, id)
+}
+
+func (au *AuthRepo) UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) error {
+	return au.q.UpdateUserPassword(ctx, sqlc.UpdateUserPasswordParams{
+		PasswordHash: passwordHash,
+		ID:           id,
+	})
+}
+
+func (au *AuthRepo) GetPermissionsByRole(ctx context.Context, role string) ([]string, error) {
+	permissions, err := au.q.GetPermissionsByRole(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+	return permissions, nil
+}
+
+func (au *AuthRepo) CreatePasswordResetToken(ctx context.Context, userID uuid.UUID, tokenHash string, expiresAt time.Time) error {
+	return au.q.CreatePasswordResetToken(ctx, sqlc.CreatePasswordResetTokenParams{
+		UserID:    userID,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+	})
+}
+
+func (au *AuthRepo) GetPasswordResetToken(ctx context.Context, tokenHash string) (*auth.PasswordResetToken, error) {
+	t, err := au.q.GetPasswordResetTokenByHash(ctx, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.PasswordResetToken{
+		ID:        t.ID,
+		UserID:    t.UserID,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		UsedAt:    t.UsedAt,
+	}, nil
+}
+
+// ResetPassword consumes the reset token and stores the new password in one transaction, so a
+// token can never be used twice even when two requests race.
+func (au *AuthRepo) ResetPassword(ctx context.Context, tokenID int64, userID uuid.UUID, passwordHash string) error {
+	tx, err := au.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := au.q.WithTx(tx)
+	affected, err := qtx.UsePasswordResetToken(ctx, tokenID)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return auth.ErrResetTokenInvalid
+	}
+	if err := qtx.UseUserPasswordResetTokens(ctx, userID); err != nil {
+		return err
+	}
+	err = qtx.UpdateUserPassword(ctx, sqlc.UpdateUserPasswordParams{
+		PasswordHash: passwordHash,
+		ID:           userID,
+	})
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (au *AuthRepo) CreateEmailVerificationToken(ctx context.Context, userID uuid.UUID, tokenHash string, expiresAt time.Time) error {
+	return au.q.CreateEmailVerificationToken(ctx, sqlc.CreateEmailVerificationTokenParams{
+		UserID:    userID,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+	})
+}
+
+func (au *AuthRepo) GetEmailVerificationToken(ctx context.Context, tokenHash string) (*auth.EmailVerificationToken, error) {
+	t, err := au.q.GetEmailVerificationTokenByHash(ctx, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.EmailVerificationToken{
+		ID:        t.ID,
+		UserID:    t.UserID,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		UsedAt:    t.UsedAt,
+	}, nil
+}
+
+func (au *AuthRepo) VerifyEmail(ctx context.Context, tokenID int64, userID uuid.UUID) error {
+	tx, err := au.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := au.q.WithTx(tx)
+	affected, err := qtx.UseEmailVerificationToken(ctx, tokenID)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return auth.ErrVerifyTokenInvalid
+	}
+	if err := qtx.SetUserEmailVerified(ctx, userID); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (au *AuthRepo) GetTOTP(ctx context.Context, userID uuid.UUID) (*auth.TOTP, error) {
+	t, err := au.q.GetUserTOTP(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	totp := &auth.TOTP{
+		EnabledAt: t.TotpEnabledAt,
+	}
+	if t.TotpSecret != nil {
+		totp.Secret = *t.TotpSecret
+	}
+	return totp, nil
+}
+
+func (au *AuthRepo) SetTOTPSecret(ctx context.Context, userID uuid.UUID, secret string) error {
+	return au.q.SetUserTOTPSecret(ctx, sqlc.SetUserTOTPSecretParams{
+		TotpSecret: &secret,
+		ID:         userID,
+	})
+}
+
+// EnableTOTP turns two-factor on and replaces every recovery code of the user in one transaction.
+func (au *AuthRepo) EnableTOTP(ctx context.Context, userID uuid.UUID, recoveryCodeHashes []string) error {
+	tx, err := au.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := au.q.WithTx(tx)
+	if err := qtx.DeleteUserRecoveryCodes(ctx, userID); err != nil {
+		return err
+	}
+	for _, hash := range recoveryCodeHashes {
+		err := qtx.CreateRecoveryCode(ctx, sqlc.CreateRecoveryCodeParams{
+			UserID:   userID,
+			CodeHash: hash,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if err := qtx.EnableUserTOTP(ctx, userID); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (au *AuthRepo) DisableTOTP(ctx context.Context, userID uuid.UUID) error {
+	tx, err := au.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := au.q.WithTx(tx)
+	if err := qtx.DeleteUserRecoveryCodes(ctx, userID); err != nil {
+		return err
+	}
+	if err := qtx.DisableUserTOTP(ctx, userID); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UseTOTPStep records step as the last TOTP time step accepted for the user and reports whether it
+// is newer than the previous one, so a code seen once is not accepted again.
+func (au *AuthRepo) UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	affected, err := au.q.UseUserTOTPStep(ctx, sqlc.UseUserTOTPStepParams{
+		ID:           userID,
+		TotpLastStep: &step,
+	})
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// UseRecoveryCode marks an unused recovery code as consumed and reports whether one matched.
+func (au *AuthRepo) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error) {
+	affected, err := au.q.UseRecoveryCode(ctx, sqlc.UseRecoveryCodeParams{
+		UserID:   userID,
+		CodeHash: codeHash,
+	})
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (au *AuthRepo) CreateAPIKey(ctx context.Context, k *auth.APIKey) (*auth.APIKey, error) {
+	row, err := au.q.CreateAPIKey(ctx, sqlc.CreateAPIKeyParams{
+		UserID:     k.UserID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		SecretHash: k.SecretHash,
+		Scopes:     k.Scopes,
+		ExpiresAt:  k.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toAPIKey(row), nil
+}
+
+func (au *AuthRepo) ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]auth.APIKey, error) {
+	rows, err := au.q.ListAPIKeysByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]auth.APIKey, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, *toAPIKey(row))
+	}
+	return keys, nil
+}
+
+func (au *AuthRepo) GetAPIKeyByPrefix(ctx context.Context, prefix string) (*auth.APIKey, error) {
+	row, err := au.q.GetAPIKeyByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	return toAPIKey(row), nil
+}
+
+func (au *AuthRepo) RevokeAPIKey(ctx context.Context, id, userID uuid.UUID) error {
+	affected, err := au.q.RevokeAPIKey(ctx, sqlc.RevokeAPIKeyParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return auth.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func (au *AuthRepo) TouchAPIKey(ctx context.Context, id uuid.UUID) error {
+	return au.q.TouchAPIKey(ctx, id)
+}
+
+func toAPIKey(k sqlc.ApiKey) *auth.APIKey {
+	return &auth.APIKey{
+		ID:         k.ID,
+		UserID:     k.UserID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		SecretHash: k.SecretHash,
+		Scopes:     k.Scopes,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+		CreatedAt:  k.CreatedAt,
+	}
+}
+
+func (au *AuthRepo) GetIdentity(ctx context.Context, provider, subject string) (*auth.Identity, error) {
+	i, err := au.q.GetUserIdentity(ctx, sqlc.GetUserIdentityParams{
+		Provider: provider,
+		Subject:  subject,
+	})
+	if err != nil {
+		return nil, err
+	}
+	identity := &auth.Identity{
+		ID:          i.ID,
+		UserID:      i.UserID,
+		Provider:    i.Provider,
+		Subject:     i.Subject,
+		CreatedAt:   i.CreatedAt,
+		LastLoginAt: i.LastLoginAt,
+	}
+	if i.Email != nil {
+		identity.Email = *i.Email
+	}
+	return identity, nil
+}
+
+func (au *AuthRepo) LinkIdentity(ctx context.Context, identity *auth.Identity) error {
+	return au.q.CreateUserIdentity(ctx, sqlc.CreateUserIdentityParams{
+		UserID:   identity.UserID,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Email:    &identity.Email,
+	})
+}
+
+func (au *AuthRepo) TouchIdentity(ctx context.Context, id int64, email string) error {
+	return au.q.TouchUserIdentity(ctx, sqlc.TouchUserIdentityParams{
+		ID:    id,
+		Email: &email,
+	})
+}
+
+// CreateUserWithIdentity registers a user coming from an external provider together with the
+// identity that links them, so a failed link never leaves an orphan account.
+func (au *AuthRepo) CreateUserWithIdentity(ctx context.Context, a *auth.Entity, identity *auth.Identity) (uuid.UUID, error) {
+	tx, err := au.pool.Begin(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := au.q.WithTx(tx)
+	id, err := qtx.CreateUser(ctx, sqlc.CreateUserParams{
+		Email:        &a.Email,
+		PasswordHash: a.Password,
+		FullName:     a.FullName,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if a.EmailVerifiedAt != nil {
+		if err := qtx.SetUserEmailVerified(ctx, id); err != nil {
+			return uuid.Nil, err
+		}
+	}
+	err = qtx.CreateUserIdentity(ctx, sqlc.CreateUserIdentityParams{
+		UserID:   id,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Email:    &identity.Email,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+func (au *AuthRepo) ListUsers(ctx context.Context, filter auth.UserFilter) ([]auth.Entity, int64, error) {
+	var search, role *string
+	if filter.Search != "" {
+		// the term is matched literally, so a search for "_" or "%" does not list every user
+		pattern := likeEscaper.Replace(filter.Search)
+		search = &pattern
+	}
+	if filter.Role != "" {
+		role = &filter.Role
+	}
+	total, err := au.q.CountUsers(ctx, sqlc.CountUsersParams{
+		Search:   search,
+		Role:     role,
+		IsActive: filter.IsActive,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, err := au.q.ListUsers(ctx, sqlc.ListUsersParams{
+		Search:   search,
+		Role:     role,
+		IsActive: filter.IsActive,
+		Limit:    filter.Limit,
+		Offset:   filter.Offset,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	users := make([]auth.Entity, 0, len(rows))
+	for _, u := range rows {
+		user := auth.Entity{
+			ID:              u.ID,
+			FullName:        u.FullName,
+			IsActive:        u.IsActive,
+			EmailVerifiedAt: u.EmailVerifiedAt,
+			CreatedAt:       u.CreatedAt,
+		}
+		if u.Email != nil {
+			user.Email = *u.Email
+		}
+		// role_id is set to NULL when a role is deleted
+		if u.RoleName != nil {
+			user.Role = *u.RoleName
+		}
+		users = append(users, user)
+	}
+	return users, total, nil
+}
+
+func (au *AuthRepo) SetActive(ctx context.Context, id uuid.UUID, isActive bool) error {
+	n, err := au.q.SetUserActive(ctx, sqlc.SetUserActiveParams{
+		IsActive: isActive,
+		ID:       id,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return auth.ErrUserNotFound
+	}
+	return nil
+}
+
+func (au *AuthRepo) ListRoles(ctx context.Context) ([]string, error) {
+	return au.q.ListRoles(ctx)
+}
+
+func (au *AuthRepo) SetRole(ctx context.Context, id uuid.UUID, role string) error {
+	roleID, err := au.q.GetRoleIDByName(ctx, role)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return auth.ErrRoleNotFound
+	}
+	if err != nil {
+		return err
+	}
+	n, err := au.q.SetUserRole(ctx, sqlc.SetUserRoleParams{
+		RoleID: int(roleID),
+		ID:     id,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return auth.ErrUserNotFound
+	}
+	return nil
+}
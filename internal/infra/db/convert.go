@@ -6,7 +6,7 @@ import (
 	"math/big"
 	"strings"
 
-	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 func NumericToFloat(n pgtype.Numeric) (float64, error) {
@@ -27,9 +27,33 @@ func NumericToFloat(n pgtype.Numeric) (float64, error) {
 	return f, nil
 }
 
+// NumericToInt drops the fraction of n. It works on the digits rather than through a float, so
+// prices read back exactly.
 func NumericToInt(n pgtype.Numeric) (int64, error) {
-	f, err := NumericToFloat(n)
-	return int64(f), err
+	if n.NaN {
+		return 0, fmt.Errorf("numeric is NaN")
+	}
+	if n.Int == nil {
+		return 0, fmt.Errorf("numeric has no value (nil Int)")
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(n.Exp))), nil)
+	i := new(big.Int).Set(n.Int)
+	if n.Exp < 0 {
+		i.Quo(i, scale)
+	} else {
+		i.Mul(i, scale)
+	}
+	if !i.IsInt64() {
+		return 0, fmt.Errorf("numeric out of int64 range")
+	}
+	return i.Int64(), nil
+}
+
+func abs(n int32) int32 {
+	if n < 0 {
+		return -n
+	}
+	return n
 }
 
 func NumericToString(n pgtype.Numeric) (string, error) {
@@ -56,3 +80,7 @@ func NumericToString(n pgtype.Numeric) (string, error) {
 
 	return s, nil
 }
+
+func IntToNumeric(i int64) pgtype.Numeric {
+	return pgtype.Numeric{Int: big.NewInt(i), Valid: true}
+}
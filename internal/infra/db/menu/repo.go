@@ -0,0 +1,447 @@
+package menurepo
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/infra/db"
+	sqlc "go-ai/internal/infra/sqlc/menu"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// uniqueViolation is the Postgres error code of a unique constraint violation.
+const uniqueViolation = "23505"
+
+type MenuRepo struct {
+	pool *pgxpool.Pool
+	q    *sqlc.Queries
+}
+
+func NewMenuRepo(pool *pgxpool.Pool) *MenuRepo {
+	return &MenuRepo{
+		q:    sqlc.New(pool),
+		pool: pool,
+	}
+}
+
+func (mr *MenuRepo) ListCategories(ctx context.Context, restaurantID int32) ([]menu.Category, error) {
+	records, err := mr.q.ListMenuCategories(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	categories := make([]menu.Category, 0, len(records))
+	for _, r := range records {
+		categories = append(categories, toCategory(r))
+	}
+	return categories, nil
+}
+
+func (mr *MenuRepo) GetCategory(ctx context.Context, restaurantID int32, id int64) (*menu.Category, error) {
+	record, err := mr.q.GetMenuCategory(ctx, sqlc.GetMenuCategoryParams{
+		ID:           id,
+		RestaurantID: restaurantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, menu.ErrCategoryNotFound
+		}
+		return nil, err
+	}
+	category := toCategory(record)
+	return &category, nil
+}
+
+func (mr *MenuRepo) CreateCategory(ctx context.Context, c *menu.Category) (int64, error) {
+	id, err := mr.q.CreateMenuCategory(ctx, sqlc.CreateMenuCategoryParams{
+		RestaurantID: c.RestaurantID,
+		Name:         c.Name,
+		Slug:         optionalString(c.Slug),
+		SortOrder:    c.SortOrder,
+		IsActive:     c.IsActive,
+	})
+	if err != nil {
+		return 0, categoryError(err)
+	}
+	return id, nil
+}
+
+func (mr *MenuRepo) UpdateCategory(ctx context.Context, c *menu.Category) error {
+	n, err := mr.q.UpdateMenuCategory(ctx, sqlc.UpdateMenuCategoryParams{
+		ID:           c.ID,
+		RestaurantID: c.RestaurantID,
+		Name:         c.Name,
+		Slug:         optionalString(c.Slug),
+		SortOrder:    c.SortOrder,
+		IsActive:     c.IsActive,
+	})
+	if err != nil {
+		return categoryError(err)
+	}
+	if n == 0 {
+		return menu.ErrCategoryNotFound
+	}
+	return nil
+}
+
+func (mr *MenuRepo) DeleteCategory(ctx context.Context, restaurantID int32, id int64) error {
+	n, err := mr.q.DeleteMenuCategory(ctx, sqlc.DeleteMenuCategoryParams{
+		ID:           id,
+		RestaurantID: restaurantID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return menu.ErrCategoryNotFound
+	}
+	return nil
+}
+
+func (mr *MenuRepo) ListItems(ctx context.Context, restaurantID int32) ([]menu.Item, error) {
+	records, err := mr.q.ListMenuItems(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]menu.Item, 0, len(records))
+	for _, r := range records {
+		item, err := toItem(r)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, *item)
+	}
+	return items, nil
+}
+
+func (mr *MenuRepo) GetItem(ctx context.Context, restaurantID int32, id int64) (*menu.Item, error) {
+	record, err := mr.q.GetMenuItem(ctx, sqlc.GetMenuItemParams{
+		ID:           id,
+		RestaurantID: restaurantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, menu.ErrItemNotFound
+		}
+		return nil, err
+	}
+	return toItem(record)
+}
+
+func (mr *MenuRepo) CreateItem(ctx context.Context, i *menu.Item) (int64, error) {
+	return mr.q.CreateMenuItem(ctx, sqlc.CreateMenuItemParams{
+		RestaurantID: i.RestaurantID,
+		TopicID:      i.CategoryID,
+		Type:         sqlc.MenuItemType(i.Type),
+		Name:         i.Name,
+		Description:  optionalString(i.Description),
+		ImageUrl:     optionalString(i.ImageUrl),
+		BasePrice:    db.IntToNumeric(int64(i.Price)),
+		IsActive:     i.IsAvailable,
+		SortOrder:    i.SortOrder,
+	})
+}
+
+func (mr *MenuRepo) UpdateItem(ctx context.Context, i *menu.Item) error {
+	n, err := mr.q.UpdateMenuItem(ctx, sqlc.UpdateMenuItemParams{
+		ID:           i.ID,
+		RestaurantID: i.RestaurantID,
+		TopicID:      i.CategoryID,
+		Type:         sqlc.MenuItemType(i.Type),
+		Name:         i.Name,
+		Description:  optionalString(i.Description),
+		ImageUrl:     optionalString(i.ImageUrl),
+		BasePrice:    db.IntToNumeric(int64(i.Price)),
+		IsActive:     i.IsAvailable,
+		SortOrder:    i.SortOrder,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return menu.ErrItemNotFound
+	}
+	return nil
+}
+
+func (mr *MenuRepo) SetItemAvailability(ctx context.Context, restaurantID int32, id int64, available bool) error {
+	n, err := mr.q.SetMenuItemAvailability(ctx, sqlc.SetMenuItemAvailabilityParams{
+		ID:           id,
+		RestaurantID: restaurantID,
+		IsActive:     available,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return menu.ErrItemNotFound
+	}
+	return nil
+}
+
+func (mr *MenuRepo) DeleteItem(ctx context.Context, restaurantID int32, id int64) error {
+	n, err := mr.q.DeleteMenuItem(ctx, sqlc.DeleteMenuItemParams{
+		ID:           id,
+		RestaurantID: restaurantID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return menu.ErrItemNotFound
+	}
+	return nil
+}
+
+func (mr *MenuRepo) ListOptionGroups(ctx context.Context, restaurantID int32) ([]menu.OptionGroup, error) {
+	groupRecords, err := mr.q.ListMenuOptionGroups(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	optionRecords, err := mr.q.ListMenuOptions(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	options := make(map[int64][]menu.Option, len(groupRecords))
+	for _, r := range optionRecords {
+		option, err := toOption(r)
+		if err != nil {
+			return nil, err
+		}
+		options[r.OptionGroupID] = append(options[r.OptionGroupID], option)
+	}
+	groups := make([]menu.OptionGroup, 0, len(groupRecords))
+	for _, r := range groupRecords {
+		groups = append(groups, menu.OptionGroup{
+			ID:           r.ID,
+			RestaurantID: r.RestaurantID,
+			Name:         r.Name,
+			MinSelect:    r.MinSelect,
+			MaxSelect:    r.MaxSelect,
+			SortOrder:    r.SortOrder,
+			Options:      options[r.ID],
+			CreatedAt:    r.CreatedAt,
+			UpdatedAt:    r.UpdatedAt,
+		})
+	}
+	return groups, nil
+}
+
+func (mr *MenuRepo) CreateOptionGroup(ctx context.Context, g *menu.OptionGroup) (int64, error) {
+	tx, err := mr.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := mr.q.WithTx(tx)
+	id, err := qtx.CreateMenuOptionGroup(ctx, sqlc.CreateMenuOptionGroupParams{
+		RestaurantID: g.RestaurantID,
+		Name:         g.Name,
+		MinSelect:    g.MinSelect,
+		MaxSelect:    g.MaxSelect,
+		IsRequired:   g.Required(),
+		SortOrder:    g.SortOrder,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := createOptions(ctx, qtx, id, g.Options); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// UpdateOptionGroup replaces the options of the group along with its own fields, the options get
+// new ids.
+func (mr *MenuRepo) UpdateOptionGroup(ctx context.Context, g *menu.OptionGroup) error {
+	tx, err := mr.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := mr.q.WithTx(tx)
+	n, err := qtx.UpdateMenuOptionGroup(ctx, sqlc.UpdateMenuOptionGroupParams{
+		ID:           g.ID,
+		RestaurantID: g.RestaurantID,
+		Name:         g.Name,
+		MinSelect:    g.MinSelect,
+		MaxSelect:    g.MaxSelect,
+		IsRequired:   g.Required(),
+		SortOrder:    g.SortOrder,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return menu.ErrOptionGroupNotFound
+	}
+	if err := qtx.DeleteMenuOptions(ctx, g.ID); err != nil {
+		return err
+	}
+	if err := createOptions(ctx, qtx, g.ID, g.Options); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (mr *MenuRepo) DeleteOptionGroup(ctx context.Context, restaurantID int32, id int64) error {
+	n, err := mr.q.DeleteMenuOptionGroup(ctx, sqlc.DeleteMenuOptionGroupParams{
+		ID:           id,
+		RestaurantID: restaurantID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return menu.ErrOptionGroupNotFound
+	}
+	return nil
+}
+
+func (mr *MenuRepo) ListItemOptionGroups(ctx context.Context, restaurantID int32) (map[int64][]int64, error) {
+	records, err := mr.q.ListMenuItemOptionGroups(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	groups := make(map[int64][]int64)
+	for _, r := range records {
+		groups[r.MenuItemID] = append(groups[r.MenuItemID], r.OptionGroupID)
+	}
+	return groups, nil
+}
+
+// SetItemOptionGroups replaces the groups attached to the item, keeping the order of groupIDs.
+// The groups are expected to belong to the restaurant of the item.
+func (mr *MenuRepo) SetItemOptionGroups(ctx context.Context, restaurantID int32, itemID int64, groupIDs []int64) error {
+	tx, err := mr.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := mr.q.WithTx(tx)
+	_, err = qtx.GetMenuItem(ctx, sqlc.GetMenuItemParams{
+		ID:           itemID,
+		RestaurantID: restaurantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return menu.ErrItemNotFound
+		}
+		return err
+	}
+	if err := qtx.DeleteMenuItemOptionGroups(ctx, itemID); err != nil {
+		return err
+	}
+	for i, groupID := range groupIDs {
+		err := qtx.CreateMenuItemOptionGroup(ctx, sqlc.CreateMenuItemOptionGroupParams{
+			MenuItemID:    itemID,
+			OptionGroupID: groupID,
+			SortOrder:     int32(i),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+func createOptions(ctx context.Context, qtx *sqlc.Queries, groupID int64, options []menu.Option) error {
+	for _, o := range options {
+		maxQuantity := o.MaxQuantity
+		err := qtx.CreateMenuOption(ctx, sqlc.CreateMenuOptionParams{
+			OptionGroupID: groupID,
+			Name:          &o.Name,
+			PriceDelta:    db.IntToNumeric(int64(o.PriceDelta)),
+			QuantityMax:   &maxQuantity,
+			SortOrder:     o.SortOrder,
+			IsActive:      o.IsAvailable,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toCategory(r sqlc.Topic) menu.Category {
+	return menu.Category{
+		ID:           r.ID,
+		RestaurantID: r.RestaurantID,
+		Name:         r.Name,
+		Slug:         valueOf(r.Slug),
+		SortOrder:    r.SortOrder,
+		IsActive:     r.IsActive,
+		CreatedAt:    r.CreatedAt,
+		UpdatedAt:    r.UpdatedAt,
+	}
+}
+
+func toItem(r sqlc.MenuItem) (*menu.Item, error) {
+	price, err := db.NumericToInt(r.BasePrice)
+	if err != nil {
+		return nil, err
+	}
+	return &menu.Item{
+		ID:           r.ID,
+		RestaurantID: r.RestaurantID,
+		CategoryID:   r.TopicID,
+		Type:         menu.ItemType(r.Type),
+		Name:         r.Name,
+		Description:  valueOf(r.Description),
+		ImageUrl:     valueOf(r.ImageUrl),
+		Price:        menu.Price(price),
+		IsAvailable:  r.IsActive,
+		SortOrder:    r.SortOrder,
+		CreatedAt:    r.CreatedAt,
+		UpdatedAt:    r.UpdatedAt,
+	}, nil
+}
+
+func toOption(r sqlc.OptionItem) (menu.Option, error) {
+	delta, err := db.NumericToInt(r.PriceDelta)
+	if err != nil {
+		return menu.Option{}, err
+	}
+	// options created before quantities were tracked have no maximum, they are picked at most once
+	maxQuantity := int32(1)
+	if r.QuantityMax != nil && *r.QuantityMax > 0 {
+		maxQuantity = *r.QuantityMax
+	}
+	return menu.Option{
+		ID:          r.ID,
+		GroupID:     r.OptionGroupID,
+		Name:        valueOf(r.Name),
+		PriceDelta:  menu.Price(delta),
+		MaxQuantity: maxQuantity,
+		IsAvailable: r.IsActive,
+		SortOrder:   r.SortOrder,
+	}, nil
+}
+
+// categoryError reports a slug taken by another category of the restaurant as ErrCategoryExists.
+func categoryError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return menu.ErrCategoryExists
+	}
+	return err
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func valueOf(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,440 @@
+package orderrepo
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/order"
+	"go-ai/internal/infra/db"
+	sqlc "go-ai/internal/infra/sqlc/order"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type OrderRepo struct {
+	pool *pgxpool.Pool
+	q    *sqlc.Queries
+}
+
+func NewOrderRepo(pool *pgxpool.Pool) *OrderRepo {
+	return &OrderRepo{
+		q:    sqlc.New(pool),
+		pool: pool,
+	}
+}
+
+func (or *OrderRepo) GetCart(ctx context.Context, restaurantID int32, customerID uuid.UUID) (*order.Order, error) {
+	record, err := or.q.GetCart(ctx, sqlc.GetCartParams{
+		RestaurantID: restaurantID,
+		CustomerID:   customerID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, order.ErrCartNotFound
+		}
+		return nil, err
+	}
+	orders, err := or.withItems(ctx, []sqlc.CustomerOrder{record})
+	if err != nil {
+		return nil, err
+	}
+	return &orders[0], nil
+}
+
+func (or *OrderRepo) AddCartItem(ctx context.Context, restaurantID int32, customerID uuid.UUID, item *order.Item) (int64, error) {
+	tx, err := or.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := or.q.WithTx(tx)
+	// the upsert locks the cart, concurrent additions count the lines one after the other
+	orderID, err := qtx.UpsertCart(ctx, sqlc.UpsertCartParams{
+		RestaurantID: restaurantID,
+		CustomerID:   customerID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	count, err := qtx.CountOrderItems(ctx, orderID)
+	if err != nil {
+		return 0, err
+	}
+	if count >= order.MaxItems {
+		return 0, order.ErrTooManyItems
+	}
+	id, err := createItem(ctx, qtx, orderID, item)
+	if err != nil {
+		return 0, err
+	}
+	if err := qtx.RecalculateOrder(ctx, orderID); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (or *OrderRepo) UpdateCartItem(ctx context.Context, restaurantID int32, customerID uuid.UUID, item *order.Item) error {
+	tx, err := or.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := or.q.WithTx(tx)
+	orderID, err := lockCart(ctx, qtx, restaurantID, customerID)
+	if err != nil {
+		return err
+	}
+	n, err := qtx.UpdateOrderItem(ctx, sqlc.UpdateOrderItemParams{
+		ID:         item.ID,
+		OrderID:    orderID,
+		MenuItemID: item.MenuItemID,
+		Name:       item.Name,
+		BasePrice:  db.IntToNumeric(int64(item.BasePrice)),
+		Quantity:   item.Quantity,
+		Note:       optionalString(item.Note),
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return order.ErrCartItemNotFound
+	}
+	if err := qtx.DeleteOrderItemOptions(ctx, item.ID); err != nil {
+		return err
+	}
+	if err := createOptions(ctx, qtx, item.ID, item.Options); err != nil {
+		return err
+	}
+	if err := qtx.RecalculateOrder(ctx, orderID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (or *OrderRepo) DeleteCartItem(ctx context.Context, restaurantID int32, customerID uuid.UUID, itemID int64) error {
+	tx, err := or.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := or.q.WithTx(tx)
+	orderID, err := lockCart(ctx, qtx, restaurantID, customerID)
+	if err != nil {
+		return err
+	}
+	n, err := qtx.DeleteOrderItem(ctx, sqlc.DeleteOrderItemParams{
+		ID:      itemID,
+		OrderID: orderID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return order.ErrCartItemNotFound
+	}
+	if err := qtx.RecalculateOrder(ctx, orderID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (or *OrderRepo) DeleteCart(ctx context.Context, restaurantID int32, customerID uuid.UUID) error {
+	n, err := or.q.DeleteCart(ctx, sqlc.DeleteCartParams{
+		RestaurantID: restaurantID,
+		CustomerID:   customerID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return order.ErrCartNotFound
+	}
+	return nil
+}
+
+func (or *OrderRepo) Place(ctx context.Context, o *order.Order) error {
+	tx, err := or.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := or.q.WithTx(tx)
+	orderType := string(o.Type)
+	// first, so the cart is locked and known unchanged before its lines are replaced
+	n, err := qtx.PlaceOrder(ctx, sqlc.PlaceOrderParams{
+		ID:        o.ID,
+		Type:      &orderType,
+		TableID:   o.TableID,
+		Note:      optionalString(o.Note),
+		UpdatedAt: o.UpdatedAt,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return order.ErrOrderChanged
+	}
+	if err := qtx.DeleteOrderItems(ctx, o.ID); err != nil {
+		return err
+	}
+	for i := range o.Items {
+		if _, err := createItem(ctx, qtx, o.ID, &o.Items[i]); err != nil {
+			return err
+		}
+	}
+	if err := qtx.RecalculateOrder(ctx, o.ID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (or *OrderRepo) Get(ctx context.Context, id int64) (*order.Order, error) {
+	record, err := or.q.GetOrder(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, order.ErrOrderNotFound
+		}
+		return nil, err
+	}
+	orders, err := or.withItems(ctx, []sqlc.CustomerOrder{record})
+	if err != nil {
+		return nil, err
+	}
+	return &orders[0], nil
+}
+
+func (or *OrderRepo) ListByCustomer(ctx context.Context, customerID uuid.UUID, filter order.ListFilter) ([]order.Order, error) {
+	records, err := or.q.ListCustomerOrders(ctx, sqlc.ListCustomerOrdersParams{
+		CustomerID: customerID,
+		Status:     statusOf(filter.Status),
+		BeforeID:   beforeOf(filter.BeforeID),
+		Limit:      filter.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return or.withItems(ctx, records)
+}
+
+func (or *OrderRepo) ListByRestaurant(ctx context.Context, restaurantID int32, filter order.ListFilter) ([]order.Order, error) {
+	records, err := or.q.ListRestaurantOrders(ctx, sqlc.ListRestaurantOrdersParams{
+		RestaurantID: restaurantID,
+		Status:       statusOf(filter.Status),
+		BeforeID:     beforeOf(filter.BeforeID),
+		Limit:        filter.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return or.withItems(ctx, records)
+}
+
+func (or *OrderRepo) UpdateStatus(ctx context.Context, o *order.Order, from order.Status) error {
+	n, err := or.q.UpdateOrderStatus(ctx, sqlc.UpdateOrderStatusParams{
+		Status:       string(o.Status),
+		CancelReason: optionalString(o.CancelReason),
+		ID:           o.ID,
+		FromStatus:   string(from),
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return order.ErrOrderChanged
+	}
+	return nil
+}
+
+// withItems reads the lines of the orders of records, with their options, in two queries.
+func (or *OrderRepo) withItems(ctx context.Context, records []sqlc.CustomerOrder) ([]order.Order, error) {
+	orders := make([]order.Order, 0, len(records))
+	ids := make([]int64, 0, len(records))
+	for _, r := range records {
+		o, err := toOrder(r)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, o)
+		ids = append(ids, r.ID)
+	}
+	if len(ids) == 0 {
+		return orders, nil
+	}
+	optionRecords, err := or.q.ListOrderItemOptions(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	options := make(map[int64][]order.ItemOption)
+	for _, r := range optionRecords {
+		o, err := toItemOption(r)
+		if err != nil {
+			return nil, err
+		}
+		options[r.OrderItemID] = append(options[r.OrderItemID], o)
+	}
+	itemRecords, err := or.q.ListOrderItems(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	items := make(map[int64][]order.Item)
+	for _, r := range itemRecords {
+		item, err := toItem(r)
+		if err != nil {
+			return nil, err
+		}
+		item.Options = options[r.ID]
+		items[r.OrderID] = append(items[r.OrderID], item)
+	}
+	for i := range orders {
+		orders[i].Items = items[orders[i].ID]
+	}
+	return orders, nil
+}
+
+// lockCart returns the id of the cart of the customer at the restaurant, locked until the end of
+// the transaction.
+func lockCart(ctx context.Context, q *sqlc.Queries, restaurantID int32, customerID uuid.UUID) (int64, error) {
+	id, err := q.LockCart(ctx, sqlc.LockCartParams{
+		RestaurantID: restaurantID,
+		CustomerID:   customerID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, order.ErrCartNotFound
+		}
+		return 0, err
+	}
+	return id, nil
+}
+
+// createItem adds item to an order with its options and sets its id. Its prices are left to
+// RecalculateOrder.
+func createItem(ctx context.Context, q *sqlc.Queries, orderID int64, item *order.Item) (int64, error) {
+	id, err := q.CreateOrderItem(ctx, sqlc.CreateOrderItemParams{
+		OrderID:    orderID,
+		MenuItemID: item.MenuItemID,
+		Name:       item.Name,
+		BasePrice:  db.IntToNumeric(int64(item.BasePrice)),
+		Quantity:   item.Quantity,
+		Note:       optionalString(item.Note),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := createOptions(ctx, q, id, item.Options); err != nil {
+		return 0, err
+	}
+	item.ID = id
+	return id, nil
+}
+
+func createOptions(ctx context.Context, q *sqlc.Queries, itemID int64, options []order.ItemOption) error {
+	for _, o := range options {
+		err := q.CreateOrderItemOption(ctx, sqlc.CreateOrderItemOptionParams{
+			OrderItemID: itemID,
+			OptionID:    o.OptionID,
+			GroupName:   o.GroupName,
+			Name:        o.Name,
+			PriceDelta:  db.IntToNumeric(int64(o.PriceDelta)),
+			Quantity:    o.Quantity,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toOrder(r sqlc.CustomerOrder) (order.Order, error) {
+	subtotal, err := db.NumericToInt(r.Subtotal)
+	if err != nil {
+		return order.Order{}, err
+	}
+	return order.Order{
+		ID:           r.ID,
+		RestaurantID: r.RestaurantID,
+		CustomerID:   r.CustomerID,
+		Type:         order.Type(valueOf(r.Type)),
+		TableID:      r.TableID,
+		Status:       order.Status(r.Status),
+		Note:         valueOf(r.Note),
+		CancelReason: valueOf(r.CancelReason),
+		Subtotal:     menu.Price(subtotal),
+		PlacedAt:     r.PlacedAt,
+		CreatedAt:    r.CreatedAt,
+		UpdatedAt:    r.UpdatedAt,
+	}, nil
+}
+
+func toItem(r sqlc.OrderItem) (order.Item, error) {
+	basePrice, err := db.NumericToInt(r.BasePrice)
+	if err != nil {
+		return order.Item{}, err
+	}
+	unitPrice, err := db.NumericToInt(r.UnitPrice)
+	if err != nil {
+		return order.Item{}, err
+	}
+	lineTotal, err := db.NumericToInt(r.LineTotal)
+	if err != nil {
+		return order.Item{}, err
+	}
+	return order.Item{
+		ID:         r.ID,
+		MenuItemID: r.MenuItemID,
+		Name:       r.Name,
+		BasePrice:  menu.Price(basePrice),
+		Quantity:   r.Quantity,
+		Note:       valueOf(r.Note),
+		UnitPrice:  menu.Price(unitPrice),
+		LineTotal:  menu.Price(lineTotal),
+	}, nil
+}
+
+func toItemOption(r sqlc.OrderItemOption) (order.ItemOption, error) {
+	delta, err := db.NumericToInt(r.PriceDelta)
+	if err != nil {
+		return order.ItemOption{}, err
+	}
+	return order.ItemOption{
+		OptionID:   r.OptionID,
+		GroupName:  r.GroupName,
+		Name:       r.Name,
+		PriceDelta: menu.Price(delta),
+		Quantity:   r.Quantity,
+	}, nil
+}
+
+func statusOf(s *order.Status) *string {
+	if s == nil {
+		return nil
+	}
+	status := string(*s)
+	return &status
+}
+
+func beforeOf(id int64) *int64 {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func valueOf(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
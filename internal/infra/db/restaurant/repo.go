@@ -2,12 +2,23 @@ package restaurantrepo
 
 import (
 	"context"
+	"errors"
 	"go-ai/internal/domain/restaurant"
 	sqlc "go-ai/internal/infra/sqlc/restaurant"
+	"html"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// foreignKeyViolation is the Postgres error code of a foreign key constraint violation.
+const foreignKeyViolation = "23503"
+
 type RestaurantRepo struct {
 	pool *pgxpool.Pool
 	q    *sqlc.Queries
@@ -40,6 +51,9 @@ func (rr *RestaurantRepo) Create(ctx context.Context, r *restaurant.Entity) (int
 		WebsiteUrl:  &r.WebsiteUrl,
 		Email:       &r.Email,
 		UserID:      r.UserID,
+		Latitude:    latitudeOf(r.Location),
+		Longitude:   longitudeOf(r.Location),
+		Timezone:    r.Timezone,
 	})
 	if err != nil {
 		return 0, err
@@ -50,11 +64,20 @@ func (rr *RestaurantRepo) Create(ctx context.Context, r *restaurant.Entity) (int
 			DayOfWeek:    int32(h.Day),
 			OpenTime:     h.OpenTime,
 			CloseTime:    h.CloseTime,
+			IsClosed:     h.IsClosed,
 		})
 		if err != nil {
 			return 0, err
 		}
 	}
+	err = qtx.UpsertRestaurantMember(ctx, sqlc.UpsertRestaurantMemberParams{
+		RestaurantID: id,
+		UserID:       r.UserID,
+		Role:         string(restaurant.MemberRoleOwner),
+	})
+	if err != nil {
+		return 0, err
+	}
 	if err := tx.Commit(ctx); err != nil {
 		return 0, err
 	}
@@ -66,6 +89,9 @@ func (rr *RestaurantRepo) GetById(ctx context.Context, id int32) (*restaurant.En
 	if err != nil {
 		return nil, err
 	}
+	if len(records) == 0 {
+		return nil, pgx.ErrNoRows
+	}
 	hours := []restaurant.Hours{}
 	for _, r := range records {
 		dayOfWeek, err := restaurant.ParseDayOfWeek(r.DayOfWeek)
@@ -76,23 +102,34 @@ func (rr *RestaurantRepo) GetById(ctx context.Context, id int32) (*restaurant.En
 			Day:       dayOfWeek,
 			OpenTime:  r.OpenTime,
 			CloseTime: r.CloseTime,
+			IsClosed:  r.IsClosed,
 		})
 	}
 	first := records[0]
+	status, err := restaurant.ParseStatus(first.Status)
+	if err != nil {
+		return nil, err
+	}
 	entity := &restaurant.Entity{
-		Name:        first.Name,
-		Description: *first.Description,
-		Address:     *first.Address,
-		Category:    *first.Category,
-		City:        *first.City,
-		District:    *first.District,
-		LogoUrl:     *first.LogoUrl,
-		BannerUrl:   *first.BannerUrl,
-		PhoneNumber: *first.PhoneNumber,
-		WebsiteUrl:  *first.WebsiteUrl,
-		Email:       *first.Email,
-		UserID:      first.UserID,
-		Hours:       hours,
+		ID:           first.ID,
+		Name:         first.Name,
+		Description:  *first.Description,
+		Address:      *first.Address,
+		Category:     *first.Category,
+		City:         *first.City,
+		District:     *first.District,
+		LogoUrl:      *first.LogoUrl,
+		BannerUrl:    *first.BannerUrl,
+		PhoneNumber:  *first.PhoneNumber,
+		WebsiteUrl:   *first.WebsiteUrl,
+		Email:        *first.Email,
+		UserID:       first.UserID,
+		Location:     geoPoint(first.Latitude, first.Longitude),
+		Timezone:     first.Timezone,
+		Status:       status,
+		StatusReason: valueOf(first.StatusReason),
+		UpdatedAt:    first.UpdatedAt,
+		Hours:        hours,
 	}
 	return entity, nil
 }
@@ -102,6 +139,9 @@ func (rr *RestaurantRepo) GetByName(ctx context.Context, name string) (*restaura
 	if err != nil {
 		return nil, err
 	}
+	if len(records) == 0 {
+		return nil, pgx.ErrNoRows
+	}
 	hours := []restaurant.Hours{}
 	for _, r := range records {
 		dayOfWeek, err := restaurant.ParseDayOfWeek(r.DayOfWeek)
@@ -112,27 +152,167 @@ func (rr *RestaurantRepo) GetByName(ctx context.Context, name string) (*restaura
 			Day:       dayOfWeek,
 			OpenTime:  r.OpenTime,
 			CloseTime: r.CloseTime,
+			IsClosed:  r.IsClosed,
 		})
 	}
 	first := records[0]
+	status, err := restaurant.ParseStatus(first.Status)
+	if err != nil {
+		return nil, err
+	}
 	entity := &restaurant.Entity{
-		Name:        first.Name,
-		Description: *first.Description,
-		Address:     *first.Address,
-		Category:    *first.Category,
-		City:        *first.City,
-		District:    *first.District,
-		LogoUrl:     *first.LogoUrl,
-		BannerUrl:   *first.BannerUrl,
-		PhoneNumber: *first.PhoneNumber,
-		WebsiteUrl:  *first.WebsiteUrl,
-		Email:       *first.Email,
-		UserID:      first.UserID,
-		Hours:       hours,
+		ID:           first.ID,
+		Name:         first.Name,
+		Description:  *first.Description,
+		Address:      *first.Address,
+		Category:     *first.Category,
+		City:         *first.City,
+		District:     *first.District,
+		LogoUrl:      *first.LogoUrl,
+		BannerUrl:    *first.BannerUrl,
+		PhoneNumber:  *first.PhoneNumber,
+		WebsiteUrl:   *first.WebsiteUrl,
+		Email:        *first.Email,
+		UserID:       first.UserID,
+		Location:     geoPoint(first.Latitude, first.Longitude),
+		Timezone:     first.Timezone,
+		Status:       status,
+		StatusReason: valueOf(first.StatusReason),
+		Hours:        hours,
 	}
 	return entity, nil
 }
 
+func (rr *RestaurantRepo) List(ctx context.Context, filter restaurant.ListFilter) ([]restaurant.Entity, error) {
+	params := sqlc.ListRestaurantsParams{
+		Category: optionalString(filter.Category),
+		City:     optionalString(filter.City),
+		District: optionalString(filter.District),
+		OwnerID:  filter.OwnerID,
+		Sort:     string(filter.Sort),
+		OpenAt:   filter.OpenAt,
+		Statuses: make([]string, 0, len(filter.Statuses)),
+		Limit:    filter.Limit,
+	}
+	for _, status := range filter.Statuses {
+		params.Statuses = append(params.Statuses, string(status))
+	}
+	if filter.After != nil {
+		cursorID := int64(filter.After.ID)
+		params.CursorID = &cursorID
+		params.CursorName = &filter.After.Name
+		params.CursorCreatedAt = &filter.After.CreatedAt
+	}
+	records, err := rr.q.ListRestaurants(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	restaurants := make([]restaurant.Entity, 0, len(records))
+	for _, r := range records {
+		status, err := restaurant.ParseStatus(r.Status)
+		if err != nil {
+			return nil, err
+		}
+		restaurants = append(restaurants, restaurant.Entity{
+			ID:          r.ID,
+			Name:        r.Name,
+			Description: valueOf(r.Description),
+			Address:     valueOf(r.Address),
+			Category:    valueOf(r.Category),
+			City:        valueOf(r.City),
+			District:    valueOf(r.District),
+			LogoUrl:     valueOf(r.LogoUrl),
+			BannerUrl:   valueOf(r.BannerUrl),
+			Location:    geoPoint(r.Latitude, r.Longitude),
+			Status:      status,
+			CreatedAt:   r.CreatedAt,
+		})
+	}
+	return restaurants, nil
+}
+
+func (rr *RestaurantRepo) Search(ctx context.Context, filter restaurant.SearchFilter) ([]restaurant.SearchResult, error) {
+	if len(filter.Terms) == 0 {
+		return []restaurant.SearchResult{}, nil
+	}
+	records, err := rr.q.SearchRestaurants(ctx, sqlc.SearchRestaurantsParams{
+		Query:    prefixQuery(filter.Terms),
+		Category: optionalString(filter.Category),
+		City:     optionalString(filter.City),
+		Limit:    filter.Limit,
+		Offset:   filter.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]restaurant.SearchResult, 0, len(records))
+	for _, r := range records {
+		results = append(results, restaurant.SearchResult{
+			Restaurant: restaurant.Entity{
+				ID:          r.ID,
+				Name:        r.Name,
+				Description: valueOf(r.Description),
+				Address:     valueOf(r.Address),
+				Category:    valueOf(r.Category),
+				City:        valueOf(r.City),
+				District:    valueOf(r.District),
+				LogoUrl:     valueOf(r.LogoUrl),
+				BannerUrl:   valueOf(r.BannerUrl),
+				Location:    geoPoint(r.Latitude, r.Longitude),
+				// the query only returns active restaurants
+				Status:    restaurant.StatusActive,
+				CreatedAt: r.CreatedAt,
+			},
+			Rank:          r.Rank,
+			NameHighlight: markHighlight(r.NameHighlight),
+			Snippet:       markHighlight(r.Snippet),
+		})
+	}
+	return results, nil
+}
+
+func (rr *RestaurantRepo) ListNearby(ctx context.Context, filter restaurant.NearbyFilter) ([]restaurant.NearbyResult, error) {
+	min, max := filter.Center.BoundingBox(filter.RadiusMeters)
+	params := sqlc.ListNearbyRestaurantsParams{
+		Latitude:     filter.Center.Latitude,
+		Longitude:    filter.Center.Longitude,
+		MinLatitude:  min.Latitude,
+		MaxLatitude:  max.Latitude,
+		MinLongitude: min.Longitude,
+		MaxLongitude: max.Longitude,
+		Radius:       filter.RadiusMeters,
+		Category:     optionalString(filter.Category),
+		OpenAt:       filter.OpenAt,
+		Limit:        filter.Limit,
+	}
+	records, err := rr.q.ListNearbyRestaurants(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]restaurant.NearbyResult, 0, len(records))
+	for _, r := range records {
+		results = append(results, restaurant.NearbyResult{
+			Restaurant: restaurant.Entity{
+				ID:          r.ID,
+				Name:        r.Name,
+				Description: valueOf(r.Description),
+				Address:     valueOf(r.Address),
+				Category:    valueOf(r.Category),
+				City:        valueOf(r.City),
+				District:    valueOf(r.District),
+				LogoUrl:     valueOf(r.LogoUrl),
+				BannerUrl:   valueOf(r.BannerUrl),
+				Location:    geoPoint(r.Latitude, r.Longitude),
+				// the query only returns active restaurants
+				Status:    restaurant.StatusActive,
+				CreatedAt: r.CreatedAt,
+			},
+			DistanceMeters: r.Distance,
+		})
+	}
+	return results, nil
+}
+
 func (rr *RestaurantRepo) Update(ctx context.Context, r *restaurant.Entity, id int32) error {
 	tx, err := rr.pool.Begin(ctx)
 	if err != nil {
@@ -140,7 +320,7 @@ func (rr *RestaurantRepo) Update(ctx context.Context, r *restaurant.Entity, id i
 	}
 	defer tx.Rollback(ctx)
 	qtx := rr.q.WithTx(tx)
-	err = rr.q.UpdateRestaurant(ctx, sqlc.UpdateRestaurantParams{
+	err = qtx.UpdateRestaurant(ctx, sqlc.UpdateRestaurantParams{
 		ID:          id,
 		Name:        r.Name,
 		Description: &r.Description,
@@ -154,6 +334,9 @@ func (rr *RestaurantRepo) Update(ctx context.Context, r *restaurant.Entity, id i
 		WebsiteUrl:  &r.WebsiteUrl,
 		Email:       &r.Email,
 		UserID:      r.UserID,
+		Latitude:    latitudeOf(r.Location),
+		Longitude:   longitudeOf(r.Location),
+		Timezone:    r.Timezone,
 	})
 	if err != nil {
 		return err
@@ -168,6 +351,7 @@ func (rr *RestaurantRepo) Update(ctx context.Context, r *restaurant.Entity, id i
 			OpenTime:     h.OpenTime,
 			DayOfWeek:    int32(h.Day),
 			CloseTime:    h.CloseTime,
+			IsClosed:     h.IsClosed,
 		})
 		if err != nil {
 			return err
@@ -197,3 +381,228 @@ func (rr *RestaurantRepo) Delete(ctx context.Context, id int32) error {
 	}
 	return nil
 }
+
+func (rr *RestaurantRepo) SetStatus(ctx context.Context, id int32, status restaurant.Status, reason string, from restaurant.Status) error {
+	n, err := rr.q.SetRestaurantStatus(ctx, sqlc.SetRestaurantStatusParams{
+		Status:       string(status),
+		StatusReason: optionalString(reason),
+		ID:           id,
+		FromStatus:   string(from),
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return restaurant.ErrStatusChanged
+	}
+	return nil
+}
+
+func (rr *RestaurantRepo) SetDayClosed(ctx context.Context, id int32, day restaurant.DayOfWeek, closed bool) error {
+	n, err := rr.q.SetRestaurantDayClosed(ctx, sqlc.SetRestaurantDayClosedParams{
+		RestaurantID: id,
+		DayOfWeek:    int32(day),
+		IsClosed:     closed,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return restaurant.ErrHoursNotFound
+	}
+	return nil
+}
+
+func (rr *RestaurantRepo) GetMember(ctx context.Context, restaurantID int32, userID uuid.UUID) (*restaurant.Member, error) {
+	m, err := rr.q.GetRestaurantMember(ctx, sqlc.GetRestaurantMemberParams{
+		RestaurantID: restaurantID,
+		UserID:       userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	role, err := restaurant.ParseMemberRole(m.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &restaurant.Member{
+		RestaurantID: m.RestaurantID,
+		UserID:       m.UserID,
+		Role:         role,
+		CreatedAt:    m.CreatedAt,
+	}, nil
+}
+
+func (rr *RestaurantRepo) ListMembers(ctx context.Context, restaurantID int32) ([]restaurant.Member, error) {
+	records, err := rr.q.ListRestaurantMembers(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]restaurant.Member, 0, len(records))
+	for _, m := range records {
+		role, err := restaurant.ParseMemberRole(m.Role)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, restaurant.Member{
+			RestaurantID: m.RestaurantID,
+			UserID:       m.UserID,
+			Role:         role,
+			CreatedAt:    m.CreatedAt,
+		})
+	}
+	return members, nil
+}
+
+// UpsertMember reports a user id that belongs to no user as ErrUserNotFound.
+func (rr *RestaurantRepo) UpsertMember(ctx context.Context, m *restaurant.Member) error {
+	err := rr.q.UpsertRestaurantMember(ctx, sqlc.UpsertRestaurantMemberParams{
+		RestaurantID: m.RestaurantID,
+		UserID:       m.UserID,
+		Role:         string(m.Role),
+	})
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation && pgErr.ConstraintName == "restaurant_member_user_id_fkey" {
+		return restaurant.ErrUserNotFound
+	}
+	return err
+}
+
+func (rr *RestaurantRepo) DeleteMember(ctx context.Context, restaurantID int32, userID uuid.UUID) error {
+	return rr.q.DeleteRestaurantMember(ctx, sqlc.DeleteRestaurantMemberParams{
+		RestaurantID: restaurantID,
+		UserID:       userID,
+	})
+}
+
+func (rr *RestaurantRepo) ListSpecialHours(ctx context.Context, restaurantID int32, from *time.Time) ([]restaurant.SpecialHours, error) {
+	params := sqlc.ListRestaurantSpecialHoursParams{
+		RestaurantID: restaurantID,
+	}
+	if from != nil {
+		params.FromDate = pgtype.Date{Time: *from, Valid: true}
+	}
+	records, err := rr.q.ListRestaurantSpecialHours(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	special := make([]restaurant.SpecialHours, 0, len(records))
+	for _, r := range records {
+		special = append(special, toSpecialHours(r))
+	}
+	return special, nil
+}
+
+func (rr *RestaurantRepo) CreateSpecialHours(ctx context.Context, s *restaurant.SpecialHours) (int32, error) {
+	return rr.q.CreateRestaurantSpecialHours(ctx, sqlc.CreateRestaurantSpecialHoursParams{
+		RestaurantID: s.RestaurantID,
+		StartDate:    pgtype.Date{Time: s.StartDate, Valid: true},
+		EndDate:      pgtype.Date{Time: s.EndDate, Valid: true},
+		IsClosed:     s.IsClosed,
+		OpenTime:     optionalString(s.OpenTime),
+		CloseTime:    optionalString(s.CloseTime),
+		Note:         optionalString(s.Note),
+	})
+}
+
+func (rr *RestaurantRepo) UpdateSpecialHours(ctx context.Context, s *restaurant.SpecialHours) error {
+	n, err := rr.q.UpdateRestaurantSpecialHours(ctx, sqlc.UpdateRestaurantSpecialHoursParams{
+		ID:           s.ID,
+		RestaurantID: s.RestaurantID,
+		StartDate:    pgtype.Date{Time: s.StartDate, Valid: true},
+		EndDate:      pgtype.Date{Time: s.EndDate, Valid: true},
+		IsClosed:     s.IsClosed,
+		OpenTime:     optionalString(s.OpenTime),
+		CloseTime:    optionalString(s.CloseTime),
+		Note:         optionalString(s.Note),
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return restaurant.ErrSpecialHoursNotFound
+	}
+	return nil
+}
+
+func (rr *RestaurantRepo) DeleteSpecialHours(ctx context.Context, restaurantID, id int32) error {
+	n, err := rr.q.DeleteRestaurantSpecialHours(ctx, sqlc.DeleteRestaurantSpecialHoursParams{
+		ID:           id,
+		RestaurantID: restaurantID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return restaurant.ErrSpecialHoursNotFound
+	}
+	return nil
+}
+
+func toSpecialHours(r sqlc.RestaurantSpecialHour) restaurant.SpecialHours {
+	return restaurant.SpecialHours{
+		ID:           r.ID,
+		RestaurantID: r.RestaurantID,
+		StartDate:    r.StartDate.Time,
+		EndDate:      r.EndDate.Time,
+		IsClosed:     r.IsClosed,
+		OpenTime:     valueOf(r.OpenTime),
+		CloseTime:    valueOf(r.CloseTime),
+		Note:         valueOf(r.Note),
+		CreatedAt:    r.CreatedAt,
+	}
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func valueOf(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// geoPoint reads a location column pair; the table constraint keeps both set or both null.
+func geoPoint(latitude, longitude *float64) *restaurant.GeoPoint {
+	if latitude == nil || longitude == nil {
+		return nil
+	}
+	return &restaurant.GeoPoint{Latitude: *latitude, Longitude: *longitude}
+}
+
+func latitudeOf(p *restaurant.GeoPoint) *float64 {
+	if p == nil {
+		return nil
+	}
+	return &p.Latitude
+}
+
+func longitudeOf(p *restaurant.GeoPoint) *float64 {
+	if p == nil {
+		return nil
+	}
+	return &p.Longitude
+}
+
+// prefixQuery builds a tsquery matching every term as a word prefix. Terms are quoted so no
+// input can change the query syntax.
+func prefixQuery(terms []string) string {
+	quoted := make([]string, 0, len(terms))
+	for _, t := range terms {
+		t = strings.ReplaceAll(t, `\`, `\\`)
+		t = strings.ReplaceAll(t, "'", "''")
+		quoted = append(quoted, "'"+t+"':*")
+	}
+	return strings.Join(quoted, " & ")
+}
+
+// markHighlight escapes a ts_headline result and turns the chr(2)/chr(3) match markers of the
+// search query into <mark> tags.
+func markHighlight(s string) string {
+	return strings.NewReplacer("\x02", "<mark>", "\x03", "</mark>").Replace(html.EscapeString(s))
+}
@@ -0,0 +1,145 @@
+package tablerepo
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/domain/table"
+	sqlc "go-ai/internal/infra/sqlc/table"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// uniqueViolation is the Postgres error code of a unique constraint violation.
+const uniqueViolation = "23505"
+
+type TableRepo struct {
+	pool *pgxpool.Pool
+	q    *sqlc.Queries
+}
+
+func NewTableRepo(pool *pgxpool.Pool) *TableRepo {
+	return &TableRepo{
+		q:    sqlc.New(pool),
+		pool: pool,
+	}
+}
+
+func (tr *TableRepo) List(ctx context.Context, restaurantID int32) ([]table.Table, error) {
+	records, err := tr.q.ListRestaurantTables(ctx, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	tables := make([]table.Table, 0, len(records))
+	for _, r := range records {
+		tables = append(tables, toTable(r))
+	}
+	return tables, nil
+}
+
+func (tr *TableRepo) Get(ctx context.Context, restaurantID, id int32) (*table.Table, error) {
+	record, err := tr.q.GetRestaurantTable(ctx, sqlc.GetRestaurantTableParams{
+		ID:           id,
+		RestaurantID: restaurantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, table.ErrTableNotFound
+		}
+		return nil, err
+	}
+	t := toTable(record)
+	return &t, nil
+}
+
+func (tr *TableRepo) Create(ctx context.Context, t *table.Table) (int32, error) {
+	id, err := tr.q.CreateRestaurantTable(ctx, sqlc.CreateRestaurantTableParams{
+		RestaurantID: t.RestaurantID,
+		Name:         t.Name,
+		Seats:        t.Seats,
+		IsActive:     t.IsActive,
+	})
+	if err != nil {
+		return 0, tableError(err)
+	}
+	return id, nil
+}
+
+func (tr *TableRepo) Update(ctx context.Context, t *table.Table) error {
+	n, err := tr.q.UpdateRestaurantTable(ctx, sqlc.UpdateRestaurantTableParams{
+		ID:           t.ID,
+		RestaurantID: t.RestaurantID,
+		Name:         t.Name,
+		Seats:        t.Seats,
+		IsActive:     t.IsActive,
+	})
+	if err != nil {
+		return tableError(err)
+	}
+	if n == 0 {
+		return table.ErrTableNotFound
+	}
+	return nil
+}
+
+func (tr *TableRepo) SetQR(ctx context.Context, restaurantID, id int32, pngObject, svgObject string) error {
+	n, err := tr.q.SetRestaurantTableQR(ctx, sqlc.SetRestaurantTableQRParams{
+		ID:           id,
+		RestaurantID: restaurantID,
+		QrPngObject:  &pngObject,
+		QrSvgObject:  &svgObject,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return table.ErrTableNotFound
+	}
+	return nil
+}
+
+func (tr *TableRepo) Delete(ctx context.Context, restaurantID, id int32) error {
+	n, err := tr.q.DeleteRestaurantTable(ctx, sqlc.DeleteRestaurantTableParams{
+		ID:           id,
+		RestaurantID: restaurantID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return table.ErrTableNotFound
+	}
+	return nil
+}
+
+func toTable(r sqlc.RestaurantTable) table.Table {
+	return table.Table{
+		ID:            r.ID,
+		RestaurantID:  r.RestaurantID,
+		Name:          r.Name,
+		Seats:         r.Seats,
+		IsActive:      r.IsActive,
+		QrPngObject:   valueOf(r.QrPngObject),
+		QrSvgObject:   valueOf(r.QrSvgObject),
+		QrGeneratedAt: r.QrGeneratedAt,
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	}
+}
+
+// tableError reports a name taken by another table of the restaurant as ErrTableExists.
+func tableError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return table.ErrTableExists
+	}
+	return err
+}
+
+func valueOf(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,17 @@
+package geocode
+
+import (
+	"go-ai/internal/config"
+	"go-ai/internal/domain/restaurant"
+)
+
+// NewGeocoder picks the implementation from GEOCODER_DRIVER: "nominatim" queries the
+// OpenStreetMap Nominatim API at GEOCODER_URL, anything else uses the built-in table of city and
+// district centres so local development works offline.
+func NewGeocoder() restaurant.Geocoder {
+	config, _ := config.LoadConfig()
+	if config.GeocoderDriver == "nominatim" {
+		return NewNominatimGeocoder(config.GeocoderUrl)
+	}
+	return NewOfflineGeocoder()
+}
@@ -0,0 +1,100 @@
+package geocode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-ai/internal/domain/restaurant"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrGeocoderResponse = errors.New("unexpected response from geocoder")
+
+// nominatimUserAgent identifies the application, the Nominatim usage policy rejects anonymous
+// clients.
+const nominatimUserAgent = "go-ai-geocoder/1.0"
+
+type NominatimGeocoder struct {
+	baseURL string
+	http    *http.Client
+}
+
+func NewNominatimGeocoder(baseURL string) *NominatimGeocoder {
+	return &NominatimGeocoder{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		http:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Geocode looks up the full address first and falls back to the district when the street is
+// unknown to OpenStreetMap, which is common for small alleys.
+func (g *NominatimGeocoder) Geocode(ctx context.Context, address, district, city string) (*restaurant.GeoPoint, error) {
+	for _, q := range [][]string{{address, district, city}, {district, city}} {
+		point, err := g.search(ctx, joinNonEmpty(q))
+		if err == nil || !errors.Is(err, restaurant.ErrGeocodeNotFound) {
+			return point, err
+		}
+	}
+	return nil, restaurant.ErrGeocodeNotFound
+}
+
+func (g *NominatimGeocoder) search(ctx context.Context, q string) (*restaurant.GeoPoint, error) {
+	if q == "" {
+		return nil, restaurant.ErrGeocodeNotFound
+	}
+	query := url.Values{
+		"q":            {q},
+		"format":       {"jsonv2"},
+		"limit":        {"1"},
+		"countrycodes": {"vn"},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.baseURL+"/search?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", nominatimUserAgent)
+	req.Header.Set("Accept-Language", "vi,en")
+	res, err := g.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: status %d", ErrGeocoderResponse, res.StatusCode)
+	}
+	// Nominatim returns the coordinates as strings
+	var places []struct {
+		Lat string `json:"lat"`
+		Lon string `json:"lon"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&places); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrGeocoderResponse, err)
+	}
+	if len(places) == 0 {
+		return nil, restaurant.ErrGeocodeNotFound
+	}
+	lat, err := strconv.ParseFloat(places[0].Lat, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrGeocoderResponse, err)
+	}
+	lng, err := strconv.ParseFloat(places[0].Lon, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrGeocoderResponse, err)
+	}
+	return &restaurant.GeoPoint{Latitude: lat, Longitude: lng}, nil
+}
+
+func joinNonEmpty(parts []string) string {
+	kept := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			kept = append(kept, p)
+		}
+	}
+	return strings.Join(kept, ", ")
+}
@@ -0,0 +1,166 @@
+package geocode
+
+import (
+	"context"
+	"go-ai/internal/domain/restaurant"
+	"go-ai/pkg/utils"
+	"regexp"
+	"strings"
+)
+
+// OfflineGeocoder is the local development stand-in for NominatimGeocoder. It knows the approximate
+// centres of the main Vietnamese cities and of the districts of the largest ones, so restaurants
+// get a location precise to the district without any network access. The street address is
+// ignored.
+type OfflineGeocoder struct{}
+
+func NewOfflineGeocoder() *OfflineGeocoder {
+	return &OfflineGeocoder{}
+}
+
+func (g *OfflineGeocoder) Geocode(ctx context.Context, address, district, city string) (*restaurant.GeoPoint, error) {
+	c, ok := offlineCities[offlineCityAliases[placeKey(city)]]
+	if !ok {
+		return nil, restaurant.ErrGeocodeNotFound
+	}
+	if p, ok := c.districts[placeKey(district)]; ok {
+		return &p, nil
+	}
+	center := c.center
+	return &center, nil
+}
+
+var (
+	placeSeparators = regexp.MustCompile(`[^a-z0-9]+`)
+	placePrefixes   = regexp.MustCompile(`^(thanh pho|tp|tinh|quan|huyen|thi xa|district|q) `)
+	placeSuffixes   = regexp.MustCompile(` (city|district|province)$`)
+	shortDistrict   = regexp.MustCompile(`^q([0-9]+)$`)
+)
+
+// placeKey normalizes a city or district the way people write it: "TP. Hồ Chí Minh",
+// "Quận 1", "Q.1" and "District 1" all reduce to their bare name.
+func placeKey(s string) string {
+	s = strings.ToLower(utils.FoldAccents(s))
+	s = strings.TrimSpace(placeSeparators.ReplaceAllString(s, " "))
+	s = placeSuffixes.ReplaceAllString(placePrefixes.ReplaceAllString(s, ""), "")
+	return shortDistrict.ReplaceAllString(s, "$1")
+}
+
+type offlineCity struct {
+	center    restaurant.GeoPoint
+	districts map[string]restaurant.GeoPoint
+}
+
+var offlineCityAliases = map[string]string{
+	"ho chi minh":     "ho chi minh",
+	"hcm":             "ho chi minh",
+	"tphcm":           "ho chi minh",
+	"sai gon":         "ho chi minh",
+	"saigon":          "ho chi minh",
+	"ha noi":          "ha noi",
+	"hanoi":           "ha noi",
+	"hn":              "ha noi",
+	"da nang":         "da nang",
+	"danang":          "da nang",
+	"hai phong":       "hai phong",
+	"can tho":         "can tho",
+	"hue":             "hue",
+	"thua thien hue":  "hue",
+	"nha trang":       "nha trang",
+	"khanh hoa":       "nha trang",
+	"da lat":          "da lat",
+	"dalat":           "da lat",
+	"lam dong":        "da lat",
+	"vung tau":        "vung tau",
+	"ba ria vung tau": "vung tau",
+	"bien hoa":        "bien hoa",
+	"dong nai":        "bien hoa",
+	"thu dau mot":     "thu dau mot",
+	"binh duong":      "thu dau mot",
+	"quy nhon":        "quy nhon",
+	"binh dinh":       "quy nhon",
+	"hoi an":          "hoi an",
+	"quang nam":       "hoi an",
+	"ha long":         "ha long",
+	"quang ninh":      "ha long",
+	"vinh":            "vinh",
+	"nghe an":         "vinh",
+	"buon ma thuot":   "buon ma thuot",
+	"dak lak":         "buon ma thuot",
+	"phu quoc":        "phu quoc",
+	"kien giang":      "phu quoc",
+}
+
+var offlineCities = map[string]offlineCity{
+	"ho chi minh": {
+		center: restaurant.GeoPoint{Latitude: 10.7769, Longitude: 106.7009},
+		districts: map[string]restaurant.GeoPoint{
+			"1":          {Latitude: 10.7756, Longitude: 106.7019},
+			"2":          {Latitude: 10.7872, Longitude: 106.7498},
+			"3":          {Latitude: 10.7843, Longitude: 106.6844},
+			"4":          {Latitude: 10.7578, Longitude: 106.7013},
+			"5":          {Latitude: 10.7540, Longitude: 106.6634},
+			"6":          {Latitude: 10.7480, Longitude: 106.6352},
+			"7":          {Latitude: 10.7340, Longitude: 106.7218},
+			"8":          {Latitude: 10.7240, Longitude: 106.6286},
+			"9":          {Latitude: 10.8428, Longitude: 106.8287},
+			"10":         {Latitude: 10.7746, Longitude: 106.6679},
+			"11":         {Latitude: 10.7629, Longitude: 106.6501},
+			"12":         {Latitude: 10.8672, Longitude: 106.6413},
+			"binh thanh": {Latitude: 10.8106, Longitude: 106.7091},
+			"phu nhuan":  {Latitude: 10.7991, Longitude: 106.6802},
+			"go vap":     {Latitude: 10.8387, Longitude: 106.6653},
+			"tan binh":   {Latitude: 10.8015, Longitude: 106.6526},
+			"tan phu":    {Latitude: 10.7901, Longitude: 106.6282},
+			"binh tan":   {Latitude: 10.7653, Longitude: 106.6035},
+			"thu duc":    {Latitude: 10.8494, Longitude: 106.7537},
+			"binh chanh": {Latitude: 10.6871, Longitude: 106.5939},
+			"hoc mon":    {Latitude: 10.8893, Longitude: 106.5951},
+			"cu chi":     {Latitude: 10.9733, Longitude: 106.4935},
+			"nha be":     {Latitude: 10.6950, Longitude: 106.7040},
+			"can gio":    {Latitude: 10.4114, Longitude: 106.9535},
+		},
+	},
+	"ha noi": {
+		center: restaurant.GeoPoint{Latitude: 21.0285, Longitude: 105.8542},
+		districts: map[string]restaurant.GeoPoint{
+			"hoan kiem":    {Latitude: 21.0288, Longitude: 105.8525},
+			"ba dinh":      {Latitude: 21.0358, Longitude: 105.8142},
+			"dong da":      {Latitude: 21.0181, Longitude: 105.8292},
+			"hai ba trung": {Latitude: 21.0059, Longitude: 105.8576},
+			"cau giay":     {Latitude: 21.0362, Longitude: 105.7906},
+			"tay ho":       {Latitude: 21.0705, Longitude: 105.8188},
+			"thanh xuan":   {Latitude: 20.9937, Longitude: 105.8141},
+			"hoang mai":    {Latitude: 20.9740, Longitude: 105.8630},
+			"long bien":    {Latitude: 21.0450, Longitude: 105.8886},
+			"nam tu liem":  {Latitude: 21.0124, Longitude: 105.7655},
+			"bac tu liem":  {Latitude: 21.0713, Longitude: 105.7644},
+			"ha dong":      {Latitude: 20.9714, Longitude: 105.7788},
+		},
+	},
+	"da nang": {
+		center: restaurant.GeoPoint{Latitude: 16.0544, Longitude: 108.2022},
+		districts: map[string]restaurant.GeoPoint{
+			"hai chau":     {Latitude: 16.0472, Longitude: 108.2199},
+			"thanh khe":    {Latitude: 16.0640, Longitude: 108.1870},
+			"son tra":      {Latitude: 16.1060, Longitude: 108.2520},
+			"ngu hanh son": {Latitude: 16.0010, Longitude: 108.2520},
+			"lien chieu":   {Latitude: 16.0718, Longitude: 108.1500},
+			"cam le":       {Latitude: 16.0150, Longitude: 108.1960},
+		},
+	},
+	"hai phong":     {center: restaurant.GeoPoint{Latitude: 20.8449, Longitude: 106.6881}},
+	"can tho":       {center: restaurant.GeoPoint{Latitude: 10.0452, Longitude: 105.7469}},
+	"hue":           {center: restaurant.GeoPoint{Latitude: 16.4637, Longitude: 107.5909}},
+	"nha trang":     {center: restaurant.GeoPoint{Latitude: 12.2388, Longitude: 109.1967}},
+	"da lat":        {center: restaurant.GeoPoint{Latitude: 11.9404, Longitude: 108.4583}},
+	"vung tau":      {center: restaurant.GeoPoint{Latitude: 10.4114, Longitude: 107.1362}},
+	"bien hoa":      {center: restaurant.GeoPoint{Latitude: 10.9574, Longitude: 106.8429}},
+	"thu dau mot":   {center: restaurant.GeoPoint{Latitude: 10.9804, Longitude: 106.6519}},
+	"quy nhon":      {center: restaurant.GeoPoint{Latitude: 13.7820, Longitude: 109.2192}},
+	"hoi an":        {center: restaurant.GeoPoint{Latitude: 15.8801, Longitude: 108.3380}},
+	"ha long":       {center: restaurant.GeoPoint{Latitude: 20.9599, Longitude: 107.0425}},
+	"vinh":          {center: restaurant.GeoPoint{Latitude: 18.6796, Longitude: 105.6813}},
+	"buon ma thuot": {center: restaurant.GeoPoint{Latitude: 12.6667, Longitude: 108.0500}},
+	"phu quoc":      {center: restaurant.GeoPoint{Latitude: 10.2899, Longitude: 103.9840}},
+}
@@ -0,0 +1,32 @@
+package mail
+
+import (
+	"context"
+	"go-ai/pkg/logger"
+
+	"github.com/rs/zerolog"
+)
+
+// LogMailer is the local development stand-in for SMTPMailer: messages end up in the application
+// log instead of an inbox.
+type LogMailer struct {
+	from   string
+	logger zerolog.Logger
+}
+
+func NewLogMailer(from string) *LogMailer {
+	return &LogMailer{
+		from:   from,
+		logger: logger.NewLogger().With().Str("component", "mailer").Logger(),
+	}
+}
+
+func (m *LogMailer) Send(ctx context.Context, msg Message) error {
+	m.logger.Info().
+		Str("from", m.from).
+		Str("to", msg.To).
+		Str("subject", msg.Subject).
+		Str("body", msg.Body).
+		Msg("mail sent")
+	return nil
+}
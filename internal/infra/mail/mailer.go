@@ -0,0 +1,27 @@
+package mail
+
+import (
+	"context"
+	"go-ai/internal/config"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers transactional emails such as password reset links.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewMailer picks the implementation from MAIL_DRIVER: "smtp" sends real mail, anything else
+// writes the messages to the log so local development does not need a mail server.
+func NewMailer() Mailer {
+	config, _ := config.LoadConfig()
+	if config.MailDriver == "smtp" {
+		return NewSMTPMailer(config.SmtpHost, config.SmtpPort, config.SmtpUsername, config.SmtpPassword, config.MailFrom)
+	}
+	return NewLogMailer(config.MailFrom)
+}
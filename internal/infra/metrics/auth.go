@@ -0,0 +1,26 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Collectors are registered on the default registry, which the echo prometheus middleware serves
+// on /metrics.
+var (
+	LoginFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "auth",
+		Name:      "login_failures_total",
+		Help:      "Number of failed password logins.",
+	})
+	LoginLockouts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "auth",
+		Name:      "login_lockouts_total",
+		Help:      "Number of temporary login lockouts, by the key that tripped them.",
+	}, []string{"scope"})
+	LoginRejectedLocked = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "auth",
+		Name:      "login_rejected_locked_total",
+		Help:      "Number of login attempts rejected because of an active lockout.",
+	}, []string{"scope"})
+)
@@ -0,0 +1,40 @@
+package oidc
+
+import (
+	"fmt"
+	"go-ai/internal/config"
+	"strings"
+)
+
+// NewProviders builds the providers listed in OIDC_PROVIDERS. Each name reads its settings from
+// OIDC_<NAME>_ISSUER, OIDC_<NAME>_CLIENT_ID, OIDC_<NAME>_CLIENT_SECRET and optionally
+// OIDC_<NAME>_SCOPES; providers without issuer or client id are skipped.
+func NewProviders() map[string]*Provider {
+	config, _ := config.LoadConfig()
+	providers := map[string]*Provider{}
+	for _, name := range strings.Split(config.OidcProviders, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		env := "OIDC_" + strings.ToUpper(name) + "_"
+		issuer := config.GetString(env + "ISSUER")
+		clientID := config.GetString(env + "CLIENT_ID")
+		if issuer == "" || clientID == "" {
+			continue
+		}
+		scopes := strings.Fields(config.GetString(env + "SCOPES"))
+		if len(scopes) == 0 {
+			scopes = []string{"openid", "email", "profile"}
+		}
+		providers[name] = NewProvider(ProviderConfig{
+			Name:         name,
+			Issuer:       issuer,
+			ClientID:     clientID,
+			ClientSecret: config.GetString(env + "CLIENT_SECRET"),
+			RedirectURL:  fmt.Sprintf("%s/api/auth/oidc/%s/callback", strings.TrimRight(config.ApiBaseUrl, "/"), name),
+			Scopes:       scopes,
+		})
+	}
+	return providers
+}
@@ -0,0 +1,52 @@
+package qrcode
+
+import (
+	"bytes"
+	"fmt"
+
+	qr "github.com/skip2/go-qrcode"
+)
+
+// Code is an encoded QR code, rendered with its quiet zone as PNG or SVG. Medium error correction
+// leaves room for a smudged or folded print.
+type Code struct {
+	code *qr.QRCode
+}
+
+func Encode(content string) (*Code, error) {
+	code, err := qr.New(content, qr.Medium)
+	if err != nil {
+		return nil, err
+	}
+	return &Code{code: code}, nil
+}
+
+// PNG renders the code size pixels wide, black on white.
+func (c *Code) PNG(size int) ([]byte, error) {
+	return c.code.PNG(size)
+}
+
+// SVG renders the code with one unit per module, so it scales to any print size. Each run of dark
+// modules on a row is a single rectangle of the path.
+func (c *Code) SVG() []byte {
+	bitmap := c.code.Bitmap()
+	n := len(bitmap)
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, n, n)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="#fff"/><path fill="#000" d="`, n, n)
+	for y, row := range bitmap {
+		for x := 0; x < len(row); {
+			if !row[x] {
+				x++
+				continue
+			}
+			start := x
+			for x < len(row) && row[x] {
+				x++
+			}
+			fmt.Fprintf(&b, "M%d %dh%dv1h-%dz", start, y, x-start, x-start)
+		}
+	}
+	b.WriteString(`"/></svg>`)
+	return b.Bytes()
+}
@@ -8,24 +8,32 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Restaurant struct {
-	ID          int32
-	Name        string
-	Description *string
-	Address     *string
-	Category    *string
-	City        *string
-	District    *string
-	LogoUrl     *string
-	BannerUrl   *string
-	PhoneNumber *string
-	WebsiteUrl  *string
-	Email       *string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	UserID      uuid.UUID
+	ID              int32
+	Name            string
+	Description     *string
+	Address         *string
+	Category        *string
+	City            *string
+	District        *string
+	LogoUrl         *string
+	BannerUrl       *string
+	PhoneNumber     *string
+	WebsiteUrl      *string
+	Email           *string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	UserID          uuid.UUID
+	SearchVector    interface{}
+	Latitude        *float64
+	Longitude       *float64
+	Timezone        string
+	Status          string
+	StatusReason    *string
+	StatusChangedAt time.Time
 }
 
 type RestaurantHour struct {
@@ -35,3 +43,24 @@ type RestaurantHour struct {
 	CloseTime    string
 	IsClosed     bool
 }
+
+type RestaurantMember struct {
+	RestaurantID int32
+	UserID       uuid.UUID
+	Role         string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type RestaurantSpecialHour struct {
+	ID           int32
+	RestaurantID int32
+	StartDate    pgtype.Date
+	EndDate      pgtype.Date
+	IsClosed     bool
+	OpenTime     *string
+	CloseTime    *string
+	Note         *string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
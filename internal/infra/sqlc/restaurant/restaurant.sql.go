@@ -7,13 +7,15 @@ package sqlc
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createRestaurant = `-- name: CreateRestaurant :one
-INSERT INTO "restaurant" (name, description, address, category, city, district, logo_url, banner_url, phone_number, website_url, email, user_id)
-VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+INSERT INTO "restaurant" (name, description, address, category, city, district, logo_url, banner_url, phone_number, website_url, email, user_id, latitude, longitude, timezone)
+VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 RETURNING id
 `
 
@@ -30,6 +32,9 @@ type CreateRestaurantParams struct {
 	WebsiteUrl  *string
 	Email       *string
 	UserID      uuid.UUID
+	Latitude    *float64
+	Longitude   *float64
+	Timezone    string
 }
 
 func (q *Queries) CreateRestaurant(ctx context.Context, arg CreateRestaurantParams) (int32, error) {
@@ -46,6 +51,9 @@ func (q *Queries) CreateRestaurant(ctx context.Context, arg CreateRestaurantPara
 		arg.WebsiteUrl,
 		arg.Email,
 		arg.UserID,
+		arg.Latitude,
+		arg.Longitude,
+		arg.Timezone,
 	)
 	var id int32
 	err := row.Scan(&id)
@@ -53,8 +61,8 @@ func (q *Queries) CreateRestaurant(ctx context.Context, arg CreateRestaurantPara
 }
 
 const createRestaurantHours = `-- name: CreateRestaurantHours :exec
-INSERT INTO "restaurant_hours" (restaurant_id, day_of_week, open_time, close_time)
-VALUES($1, $2, $3, $4)
+INSERT INTO "restaurant_hours" (restaurant_id, day_of_week, open_time, close_time, is_closed)
+VALUES($1, $2, $3, $4, $5)
 `
 
 type CreateRestaurantHoursParams struct {
@@ -62,6 +70,7 @@ type CreateRestaurantHoursParams struct {
 	DayOfWeek    int32
 	OpenTime     string
 	CloseTime    string
+	IsClosed     bool
 }
 
 func (q *Queries) CreateRestaurantHours(ctx context.Context, arg CreateRestaurantHoursParams) error {
@@ -70,10 +79,42 @@ func (q *Queries) CreateRestaurantHours(ctx context.Context, arg CreateRestauran
 		arg.DayOfWeek,
 		arg.OpenTime,
 		arg.CloseTime,
+		arg.IsClosed,
 	)
 	return err
 }
 
+const createRestaurantSpecialHours = `-- name: CreateRestaurantSpecialHours :one
+INSERT INTO "restaurant_special_hours" (restaurant_id, start_date, end_date, is_closed, open_time, close_time, note)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id
+`
+
+type CreateRestaurantSpecialHoursParams struct {
+	RestaurantID int32
+	StartDate    pgtype.Date
+	EndDate      pgtype.Date
+	IsClosed     bool
+	OpenTime     *string
+	CloseTime    *string
+	Note         *string
+}
+
+func (q *Queries) CreateRestaurantSpecialHours(ctx context.Context, arg CreateRestaurantSpecialHoursParams) (int32, error) {
+	row := q.db.QueryRow(ctx, createRestaurantSpecialHours,
+		arg.RestaurantID,
+		arg.StartDate,
+		arg.EndDate,
+		arg.IsClosed,
+		arg.OpenTime,
+		arg.CloseTime,
+		arg.Note,
+	)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
 const deleteRestaurant = `-- name: DeleteRestaurant :exec
 DELETE FROM "restaurant" WHERE id = $1
 `
@@ -92,6 +133,37 @@ func (q *Queries) DeleteRestaurantHours(ctx context.Context, restaurantID int32)
 	return err
 }
 
+const deleteRestaurantMember = `-- name: DeleteRestaurantMember :exec
+DELETE FROM "restaurant_member" WHERE restaurant_id = $1 AND user_id = $2
+`
+
+type DeleteRestaurantMemberParams struct {
+	RestaurantID int32
+	UserID       uuid.UUID
+}
+
+func (q *Queries) DeleteRestaurantMember(ctx context.Context, arg DeleteRestaurantMemberParams) error {
+	_, err := q.db.Exec(ctx, deleteRestaurantMember, arg.RestaurantID, arg.UserID)
+	return err
+}
+
+const deleteRestaurantSpecialHours = `-- name: DeleteRestaurantSpecialHours :execrows
+DELETE FROM "restaurant_special_hours" WHERE id = $1 AND restaurant_id = $2
+`
+
+type DeleteRestaurantSpecialHoursParams struct {
+	ID           int32
+	RestaurantID int32
+}
+
+func (q *Queries) DeleteRestaurantSpecialHours(ctx context.Context, arg DeleteRestaurantSpecialHoursParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteRestaurantSpecialHours, arg.ID, arg.RestaurantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getById = `-- name: GetById :many
 SELECT
     rs.id,
@@ -107,31 +179,46 @@ SELECT
     rs.website_url,
     rs.email,
     rs.user_id,
+    rs.latitude,
+    rs.longitude,
+    rs.timezone,
+    rs.status,
+    rs.status_reason,
+    rs.updated_at,
     rsh.day_of_week,
     rsh.open_time,
-    rsh.close_time
+    rsh.close_time,
+    rsh.is_closed
 FROM "restaurant" rs
 INNER JOIN "restaurant_hours" rsh ON rs.id = rsh.restaurant_id
 WHERE id = $1
+ORDER BY rsh.day_of_week, rsh.open_time
 `
 
 type GetByIdRow struct {
-	ID          int32
-	Name        string
-	Description *string
-	Address     *string
-	Category    *string
-	City        *string
-	District    *string
-	LogoUrl     *string
-	BannerUrl   *string
-	PhoneNumber *string
-	WebsiteUrl  *string
-	Email       *string
-	UserID      uuid.UUID
-	DayOfWeek   int32
-	OpenTime    string
-	CloseTime   string
+	ID           int32
+	Name         string
+	Description  *string
+	Address      *string
+	Category     *string
+	City         *string
+	District     *string
+	LogoUrl      *string
+	BannerUrl    *string
+	PhoneNumber  *string
+	WebsiteUrl   *string
+	Email        *string
+	UserID       uuid.UUID
+	Latitude     *float64
+	Longitude    *float64
+	Timezone     string
+	Status       string
+	StatusReason *string
+	UpdatedAt    time.Time
+	DayOfWeek    int32
+	OpenTime     string
+	CloseTime    string
+	IsClosed     bool
 }
 
 func (q *Queries) GetById(ctx context.Context, id int32) ([]GetByIdRow, error) {
@@ -157,9 +244,16 @@ func (q *Queries) GetById(ctx context.Context, id int32) ([]GetByIdRow, error) {
 			&i.WebsiteUrl,
 			&i.Email,
 			&i.UserID,
+			&i.Latitude,
+			&i.Longitude,
+			&i.Timezone,
+			&i.Status,
+			&i.StatusReason,
+			&i.UpdatedAt,
 			&i.DayOfWeek,
 			&i.OpenTime,
 			&i.CloseTime,
+			&i.IsClosed,
 		); err != nil {
 			return nil, err
 		}
@@ -186,15 +280,155 @@ SELECT
     rs.website_url,
     rs.email,
     rs.user_id,
+    rs.latitude,
+    rs.longitude,
+    rs.timezone,
+    rs.status,
+    rs.status_reason,
     rsh.day_of_week,
     rsh.open_time,
-    rsh.close_time
+    rsh.close_time,
+    rsh.is_closed
 FROM "restaurant" rs
 INNER JOIN "restaurant_hours" rsh ON rs.id = rsh.restaurant_id
 WHERE name LIKE $1
+ORDER BY rsh.day_of_week, rsh.open_time
 `
 
 type GetByNameRow struct {
+	ID           int32
+	Name         string
+	Description  *string
+	Address      *string
+	Category     *string
+	City         *string
+	District     *string
+	LogoUrl      *string
+	BannerUrl    *string
+	PhoneNumber  *string
+	WebsiteUrl   *string
+	Email        *string
+	UserID       uuid.UUID
+	Latitude     *float64
+	Longitude    *float64
+	Timezone     string
+	Status       string
+	StatusReason *string
+	DayOfWeek    int32
+	OpenTime     string
+	CloseTime    string
+	IsClosed     bool
+}
+
+func (q *Queries) GetByName(ctx context.Context, name string) ([]GetByNameRow, error) {
+	rows, err := q.db.Query(ctx, getByName, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetByNameRow
+	for rows.Next() {
+		var i GetByNameRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Address,
+			&i.Category,
+			&i.City,
+			&i.District,
+			&i.LogoUrl,
+			&i.BannerUrl,
+			&i.PhoneNumber,
+			&i.WebsiteUrl,
+			&i.Email,
+			&i.UserID,
+			&i.Latitude,
+			&i.Longitude,
+			&i.Timezone,
+			&i.Status,
+			&i.StatusReason,
+			&i.DayOfWeek,
+			&i.OpenTime,
+			&i.CloseTime,
+			&i.IsClosed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRestaurantMember = `-- name: GetRestaurantMember :one
+SELECT restaurant_id, user_id, role, created_at FROM "restaurant_member"
+WHERE restaurant_id = $1 AND user_id = $2 LIMIT 1
+`
+
+type GetRestaurantMemberParams struct {
+	RestaurantID int32
+	UserID       uuid.UUID
+}
+
+type GetRestaurantMemberRow struct {
+	RestaurantID int32
+	UserID       uuid.UUID
+	Role         string
+	CreatedAt    time.Time
+}
+
+func (q *Queries) GetRestaurantMember(ctx context.Context, arg GetRestaurantMemberParams) (GetRestaurantMemberRow, error) {
+	row := q.db.QueryRow(ctx, getRestaurantMember, arg.RestaurantID, arg.UserID)
+	var i GetRestaurantMemberRow
+	err := row.Scan(
+		&i.RestaurantID,
+		&i.UserID,
+		&i.Role,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listNearbyRestaurants = `-- name: ListNearbyRestaurants :many
+-- the bounding box lets the location index narrow the candidates, the haversine distance in
+-- meters then keeps the ones actually within the radius
+SELECT rs.id, rs.name, rs.description, rs.address, rs.category, rs.city, rs.district, rs.logo_url, rs.banner_url, rs.latitude, rs.longitude, rs.created_at,
+  d.distance::float8 AS distance
+FROM "restaurant" rs
+CROSS JOIN LATERAL (
+  SELECT 2 * 6371000 * asin(least(1, sqrt(
+    power(sin(radians(rs.latitude - $1::float8) / 2), 2)
+    + cos(radians($1)) * cos(radians(rs.latitude))
+    * power(sin(radians(rs.longitude - $2::float8) / 2), 2)))) AS distance
+) d
+WHERE rs.latitude BETWEEN $3::float8 AND $4::float8
+  AND rs.longitude BETWEEN $5::float8 AND $6::float8
+  AND d.distance <= $7::float8
+  AND ($8::text IS NULL OR lower(rs.category) = lower($8))
+  -- open_at is read as wall clock time in the timezone of each restaurant
+  AND ($9::timestamptz IS NULL OR restaurant_is_open(rs.id, $9 AT TIME ZONE rs.timezone))
+  AND rs.status = 'active'
+ORDER BY d.distance, rs.id
+LIMIT $10
+`
+
+type ListNearbyRestaurantsParams struct {
+	Latitude     float64
+	Longitude    float64
+	MinLatitude  float64
+	MaxLatitude  float64
+	MinLongitude float64
+	MaxLongitude float64
+	Radius       float64
+	Category     *string
+	OpenAt       *time.Time
+	Limit        int32
+}
+
+type ListNearbyRestaurantsRow struct {
 	ID          int32
 	Name        string
 	Description *string
@@ -204,24 +438,34 @@ type GetByNameRow struct {
 	District    *string
 	LogoUrl     *string
 	BannerUrl   *string
-	PhoneNumber *string
-	WebsiteUrl  *string
-	Email       *string
-	UserID      uuid.UUID
-	DayOfWeek   int32
-	OpenTime    string
-	CloseTime   string
+	Latitude    *float64
+	Longitude   *float64
+	CreatedAt   time.Time
+	Distance    float64
 }
 
-func (q *Queries) GetByName(ctx context.Context, name string) ([]GetByNameRow, error) {
-	rows, err := q.db.Query(ctx, getByName, name)
+// the bounding box lets the location index narrow the candidates, the haversine distance in
+// meters then keeps the ones actually within the radius
+func (q *Queries) ListNearbyRestaurants(ctx context.Context, arg ListNearbyRestaurantsParams) ([]ListNearbyRestaurantsRow, error) {
+	rows, err := q.db.Query(ctx, listNearbyRestaurants,
+		arg.Latitude,
+		arg.Longitude,
+		arg.MinLatitude,
+		arg.MaxLatitude,
+		arg.MinLongitude,
+		arg.MaxLongitude,
+		arg.Radius,
+		arg.Category,
+		arg.OpenAt,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetByNameRow
+	var items []ListNearbyRestaurantsRow
 	for rows.Next() {
-		var i GetByNameRow
+		var i ListNearbyRestaurantsRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Name,
@@ -232,13 +476,280 @@ func (q *Queries) GetByName(ctx context.Context, name string) ([]GetByNameRow, e
 			&i.District,
 			&i.LogoUrl,
 			&i.BannerUrl,
-			&i.PhoneNumber,
-			&i.WebsiteUrl,
-			&i.Email,
+			&i.Latitude,
+			&i.Longitude,
+			&i.CreatedAt,
+			&i.Distance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRestaurantMembers = `-- name: ListRestaurantMembers :many
+SELECT restaurant_id, user_id, role, created_at FROM "restaurant_member"
+WHERE restaurant_id = $1
+ORDER BY created_at
+`
+
+type ListRestaurantMembersRow struct {
+	RestaurantID int32
+	UserID       uuid.UUID
+	Role         string
+	CreatedAt    time.Time
+}
+
+func (q *Queries) ListRestaurantMembers(ctx context.Context, restaurantID int32) ([]ListRestaurantMembersRow, error) {
+	rows, err := q.db.Query(ctx, listRestaurantMembers, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRestaurantMembersRow
+	for rows.Next() {
+		var i ListRestaurantMembersRow
+		if err := rows.Scan(
+			&i.RestaurantID,
 			&i.UserID,
-			&i.DayOfWeek,
+			&i.Role,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRestaurantSpecialHours = `-- name: ListRestaurantSpecialHours :many
+SELECT id, restaurant_id, start_date, end_date, is_closed, open_time, close_time, note, created_at, updated_at
+FROM "restaurant_special_hours"
+WHERE restaurant_id = $1
+  AND ($2::date IS NULL OR end_date >= $2)
+ORDER BY start_date, open_time NULLS FIRST, id
+`
+
+type ListRestaurantSpecialHoursParams struct {
+	RestaurantID int32
+	FromDate     pgtype.Date
+}
+
+func (q *Queries) ListRestaurantSpecialHours(ctx context.Context, arg ListRestaurantSpecialHoursParams) ([]RestaurantSpecialHour, error) {
+	rows, err := q.db.Query(ctx, listRestaurantSpecialHours, arg.RestaurantID, arg.FromDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RestaurantSpecialHour
+	for rows.Next() {
+		var i RestaurantSpecialHour
+		if err := rows.Scan(
+			&i.ID,
+			&i.RestaurantID,
+			&i.StartDate,
+			&i.EndDate,
+			&i.IsClosed,
 			&i.OpenTime,
 			&i.CloseTime,
+			&i.Note,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRestaurants = `-- name: ListRestaurants :many
+SELECT rs.id, rs.name, rs.description, rs.address, rs.category, rs.city, rs.district, rs.logo_url, rs.banner_url, rs.latitude, rs.longitude, rs.created_at, rs.status
+FROM "restaurant" rs
+WHERE ($1::text IS NULL OR lower(rs.category) = lower($1))
+  AND ($2::text IS NULL OR lower(rs.city) = lower($2))
+  AND ($3::text IS NULL OR lower(rs.district) = lower($3))
+  AND ($4::uuid IS NULL OR EXISTS (
+    SELECT 1 FROM "restaurant_member" rm
+    WHERE rm.restaurant_id = rs.id AND rm.user_id = $4 AND rm.role = 'owner'))
+  -- open_at is read as wall clock time in the timezone of each restaurant
+  AND ($5::timestamptz IS NULL OR restaurant_is_open(rs.id, $5 AT TIME ZONE rs.timezone))
+  AND ($6::bigint IS NULL
+    OR ($7::text = 'name' AND (rs.name, rs.id) > ($8::text, $6))
+    OR ($7 = '-name' AND (rs.name, rs.id) < ($8, $6))
+    OR ($7 = 'created_at' AND (rs.created_at, rs.id) > ($9::timestamptz, $6))
+    OR ($7 = '-created_at' AND (rs.created_at, rs.id) < ($9, $6)))
+  AND rs.status = ANY($10::text[])
+ORDER BY
+  CASE WHEN $7 = 'name' THEN rs.name END ASC,
+  CASE WHEN $7 = '-name' THEN rs.name END DESC,
+  CASE WHEN $7 = 'created_at' THEN rs.created_at END ASC,
+  CASE WHEN $7 = '-created_at' THEN rs.created_at END DESC,
+  CASE WHEN $7 IN ('name', 'created_at') THEN rs.id END ASC,
+  rs.id DESC
+LIMIT $11
+`
+
+type ListRestaurantsParams struct {
+	Category        *string
+	City            *string
+	District        *string
+	OwnerID         *uuid.UUID
+	OpenAt          *time.Time
+	CursorID        *int64
+	Sort            string
+	CursorName      *string
+	CursorCreatedAt *time.Time
+	Statuses        []string
+	Limit           int32
+}
+
+type ListRestaurantsRow struct {
+	ID          int32
+	Name        string
+	Description *string
+	Address     *string
+	Category    *string
+	City        *string
+	District    *string
+	LogoUrl     *string
+	BannerUrl   *string
+	Latitude    *float64
+	Longitude   *float64
+	CreatedAt   time.Time
+	Status      string
+}
+
+func (q *Queries) ListRestaurants(ctx context.Context, arg ListRestaurantsParams) ([]ListRestaurantsRow, error) {
+	rows, err := q.db.Query(ctx, listRestaurants,
+		arg.Category,
+		arg.City,
+		arg.District,
+		arg.OwnerID,
+		arg.OpenAt,
+		arg.CursorID,
+		arg.Sort,
+		arg.CursorName,
+		arg.CursorCreatedAt,
+		arg.Statuses,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRestaurantsRow
+	for rows.Next() {
+		var i ListRestaurantsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Address,
+			&i.Category,
+			&i.City,
+			&i.District,
+			&i.LogoUrl,
+			&i.BannerUrl,
+			&i.Latitude,
+			&i.Longitude,
+			&i.CreatedAt,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchRestaurants = `-- name: SearchRestaurants :many
+SELECT rs.id, rs.name, rs.description, rs.address, rs.category, rs.city, rs.district, rs.logo_url, rs.banner_url, rs.latitude, rs.longitude, rs.created_at,
+  ts_rank(rs.search_vector, q.query)::real AS rank,
+  ts_headline('vn_unaccent', rs.name, q.query,
+    'HighlightAll=true, StartSel=' || chr(2) || ', StopSel=' || chr(3))::text AS name_highlight,
+  ts_headline('vn_unaccent', concat_ws(' · ', rs.description, rs.address, rs.district, rs.city), q.query,
+    'MaxFragments=2, MaxWords=20, MinWords=8, FragmentDelimiter=" … ", StartSel=' || chr(2) || ', StopSel=' || chr(3))::text AS snippet
+FROM "restaurant" rs, to_tsquery('vn_unaccent', $1) q(query)
+WHERE rs.search_vector @@ q.query
+  AND ($2::text IS NULL OR lower(rs.category) = lower($2))
+  AND ($3::text IS NULL OR lower(rs.city) = lower($3))
+  AND rs.status = 'active'
+ORDER BY rank DESC, rs.id
+LIMIT $4 OFFSET $5
+`
+
+type SearchRestaurantsParams struct {
+	Query    string
+	Category *string
+	City     *string
+	Limit    int32
+	Offset   int32
+}
+
+type SearchRestaurantsRow struct {
+	ID            int32
+	Name          string
+	Description   *string
+	Address       *string
+	Category      *string
+	City          *string
+	District      *string
+	LogoUrl       *string
+	BannerUrl     *string
+	Latitude      *float64
+	Longitude     *float64
+	CreatedAt     time.Time
+	Rank          float32
+	NameHighlight string
+	Snippet       string
+}
+
+// chr(2) and chr(3) mark the matches in the highlights, the repository turns them into HTML
+func (q *Queries) SearchRestaurants(ctx context.Context, arg SearchRestaurantsParams) ([]SearchRestaurantsRow, error) {
+	rows, err := q.db.Query(ctx, searchRestaurants,
+		arg.Query,
+		arg.Category,
+		arg.City,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchRestaurantsRow
+	for rows.Next() {
+		var i SearchRestaurantsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Address,
+			&i.Category,
+			&i.City,
+			&i.District,
+			&i.LogoUrl,
+			&i.BannerUrl,
+			&i.Latitude,
+			&i.Longitude,
+			&i.CreatedAt,
+			&i.Rank,
+			&i.NameHighlight,
+			&i.Snippet,
 		); err != nil {
 			return nil, err
 		}
@@ -250,13 +761,59 @@ func (q *Queries) GetByName(ctx context.Context, name string) ([]GetByNameRow, e
 	return items, nil
 }
 
+const setRestaurantDayClosed = `-- name: SetRestaurantDayClosed :execrows
+UPDATE "restaurant_hours" SET is_closed = $3
+WHERE restaurant_id = $1 AND day_of_week = $2
+`
+
+type SetRestaurantDayClosedParams struct {
+	RestaurantID int32
+	DayOfWeek    int32
+	IsClosed     bool
+}
+
+func (q *Queries) SetRestaurantDayClosed(ctx context.Context, arg SetRestaurantDayClosedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setRestaurantDayClosed, arg.RestaurantID, arg.DayOfWeek, arg.IsClosed)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setRestaurantStatus = `-- name: SetRestaurantStatus :execrows
+UPDATE "restaurant"
+SET status = $1, status_reason = $2, status_changed_at = NOW()
+WHERE id = $3 AND status = $4
+`
+
+type SetRestaurantStatusParams struct {
+	Status       string
+	StatusReason *string
+	ID           int32
+	FromStatus   string
+}
+
+func (q *Queries) SetRestaurantStatus(ctx context.Context, arg SetRestaurantStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setRestaurantStatus,
+		arg.Status,
+		arg.StatusReason,
+		arg.ID,
+		arg.FromStatus,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateRestaurant = `-- name: UpdateRestaurant :exec
 UPDATE "restaurant"
 SET name = $1, description = $2, address = $3,
     category = $4, city = $5, district = $6,
     logo_url = $7, banner_url = $8, phone_number = $9,
-    website_url = $10, email = $11, user_id = $12
-WHERE id = $13
+    website_url = $10, email = $11, user_id = $12,
+    latitude = $13, longitude = $14, timezone = $15
+WHERE id = $16
 `
 
 type UpdateRestaurantParams struct {
@@ -272,6 +829,9 @@ type UpdateRestaurantParams struct {
 	WebsiteUrl  *string
 	Email       *string
 	UserID      uuid.UUID
+	Latitude    *float64
+	Longitude   *float64
+	Timezone    string
 	ID          int32
 }
 
@@ -289,7 +849,61 @@ func (q *Queries) UpdateRestaurant(ctx context.Context, arg UpdateRestaurantPara
 		arg.WebsiteUrl,
 		arg.Email,
 		arg.UserID,
+		arg.Latitude,
+		arg.Longitude,
+		arg.Timezone,
+		arg.ID,
+	)
+	return err
+}
+
+const updateRestaurantSpecialHours = `-- name: UpdateRestaurantSpecialHours :execrows
+UPDATE "restaurant_special_hours"
+SET start_date = $3, end_date = $4, is_closed = $5, open_time = $6, close_time = $7, note = $8
+WHERE id = $1 AND restaurant_id = $2
+`
+
+type UpdateRestaurantSpecialHoursParams struct {
+	ID           int32
+	RestaurantID int32
+	StartDate    pgtype.Date
+	EndDate      pgtype.Date
+	IsClosed     bool
+	OpenTime     *string
+	CloseTime    *string
+	Note         *string
+}
+
+func (q *Queries) UpdateRestaurantSpecialHours(ctx context.Context, arg UpdateRestaurantSpecialHoursParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateRestaurantSpecialHours,
 		arg.ID,
+		arg.RestaurantID,
+		arg.StartDate,
+		arg.EndDate,
+		arg.IsClosed,
+		arg.OpenTime,
+		arg.CloseTime,
+		arg.Note,
 	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const upsertRestaurantMember = `-- name: UpsertRestaurantMember :exec
+INSERT INTO "restaurant_member" (restaurant_id, user_id, role)
+VALUES($1, $2, $3)
+ON CONFLICT (restaurant_id, user_id) DO UPDATE SET role = EXCLUDED.role
+`
+
+type UpsertRestaurantMemberParams struct {
+	RestaurantID int32
+	UserID       uuid.UUID
+	Role         string
+}
+
+func (q *Queries) UpsertRestaurantMember(ctx context.Context, arg UpsertRestaurantMemberParams) error {
+	_, err := q.db.Exec(ctx, upsertRestaurantMember, arg.RestaurantID, arg.UserID, arg.Role)
 	return err
 }
@@ -10,6 +10,45 @@ import (
 	"github.com/google/uuid"
 )
 
+type ApiKey struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Name       string
+	Prefix     string
+	SecretHash string
+	Scopes     []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+type EmailVerificationToken struct {
+	ID        int64
+	UserID    uuid.UUID
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+type PasswordResetToken struct {
+	ID        int64
+	UserID    uuid.UUID
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+type Permission struct {
+	ID          int32
+	Name        string
+	Description *string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
 type Role struct {
 	ID        int32
 	RoleName  string
@@ -17,13 +56,40 @@ type Role struct {
 	UpdatedAt time.Time
 }
 
+type RolePermission struct {
+	RoleID       int32
+	PermissionID int32
+}
+
 type User struct {
-	ID           uuid.UUID
-	FullName     string
-	Email        *string
-	PasswordHash string
-	RoleID       int
-	IsActive     bool
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID              uuid.UUID
+	FullName        string
+	Email           *string
+	PasswordHash    string
+	RoleID          int
+	IsActive        bool
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	EmailVerifiedAt *time.Time
+	TotpSecret      *string
+	TotpEnabledAt   *time.Time
+	TotpLastStep    *int64
+}
+
+type UserRecoveryCode struct {
+	ID        int64
+	UserID    uuid.UUID
+	CodeHash  string
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+type UserIdentity struct {
+	ID          int64
+	UserID      uuid.UUID
+	Provider    string
+	Subject     string
+	Email       *string
+	CreatedAt   time.Time
+	LastLoginAt *time.Time
 }
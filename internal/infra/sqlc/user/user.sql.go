@@ -12,6 +12,111 @@ import (
 	"github.com/google/uuid"
 )
 
+const countUsers = `-- name: CountUsers :one
+SELECT COUNT(*) FROM "user" u
+LEFT JOIN "role" r ON r.id = u.role_id
+WHERE ($1::text IS NULL OR u.email ILIKE '%' || $1 || '%' ESCAPE '\' OR u.full_name ILIKE '%' || $1 || '%' ESCAPE '\')
+  AND ($2::text IS NULL OR r.role_name = $2)
+  AND ($3::boolean IS NULL OR u.is_active = $3)
+`
+
+type CountUsersParams struct {
+	Search   *string
+	Role     *string
+	IsActive *bool
+}
+
+func (q *Queries) CountUsers(ctx context.Context, arg CountUsersParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countUsers, arg.Search, arg.Role, arg.IsActive)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAPIKey = `-- name: CreateAPIKey :one
+INSERT INTO "api_key" (user_id, name, prefix, secret_hash, scopes, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, user_id, name, prefix, secret_hash, scopes, expires_at, last_used_at, revoked_at, created_at
+`
+
+type CreateAPIKeyParams struct {
+	UserID     uuid.UUID
+	Name       string
+	Prefix     string
+	SecretHash string
+	Scopes     []string
+	ExpiresAt  *time.Time
+}
+
+func (q *Queries) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (ApiKey, error) {
+	row := q.db.QueryRow(ctx, createAPIKey,
+		arg.UserID,
+		arg.Name,
+		arg.Prefix,
+		arg.SecretHash,
+		arg.Scopes,
+		arg.ExpiresAt,
+	)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Prefix,
+		&i.SecretHash,
+		&i.Scopes,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createEmailVerificationToken = `-- name: CreateEmailVerificationToken :exec
+INSERT INTO "email_verification_token" (user_id, token_hash, expires_at) VALUES ($1, $2, $3)
+`
+
+type CreateEmailVerificationTokenParams struct {
+	UserID    uuid.UUID
+	TokenHash string
+	ExpiresAt time.Time
+}
+
+func (q *Queries) CreateEmailVerificationToken(ctx context.Context, arg CreateEmailVerificationTokenParams) error {
+	_, err := q.db.Exec(ctx, createEmailVerificationToken, arg.UserID, arg.TokenHash, arg.ExpiresAt)
+	return err
+}
+
+const createPasswordResetToken = `-- name: CreatePasswordResetToken :exec
+INSERT INTO "password_reset_token" (user_id, token_hash, expires_at) VALUES ($1, $2, $3)
+`
+
+type CreatePasswordResetTokenParams struct {
+	UserID    uuid.UUID
+	TokenHash string
+	ExpiresAt time.Time
+}
+
+func (q *Queries) CreatePasswordResetToken(ctx context.Context, arg CreatePasswordResetTokenParams) error {
+	_, err := q.db.Exec(ctx, createPasswordResetToken, arg.UserID, arg.TokenHash, arg.ExpiresAt)
+	return err
+}
+
+const createRecoveryCode = `-- name: CreateRecoveryCode :exec
+INSERT INTO "user_recovery_code" (user_id, code_hash) VALUES ($1, $2)
+`
+
+type CreateRecoveryCodeParams struct {
+	UserID   uuid.UUID
+	CodeHash string
+}
+
+func (q *Queries) CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) error {
+	_, err := q.db.Exec(ctx, createRecoveryCode, arg.UserID, arg.CodeHash)
+	return err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO "user" (email, full_name, password_hash, role_id) VALUES ($1, $2, $3,(SELECT id FROM role WHERE role_name = 'user'))
 RETURNING id
@@ -30,21 +135,183 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (uuid.UU
 	return id, err
 }
 
+const createUserIdentity = `-- name: CreateUserIdentity :exec
+INSERT INTO "user_identity" (user_id, provider, subject, email, last_login_at) VALUES ($1, $2, $3, $4, NOW())
+`
+
+type CreateUserIdentityParams struct {
+	UserID   uuid.UUID
+	Provider string
+	Subject  string
+	Email    *string
+}
+
+func (q *Queries) CreateUserIdentity(ctx context.Context, arg CreateUserIdentityParams) error {
+	_, err := q.db.Exec(ctx, createUserIdentity,
+		arg.UserID,
+		arg.Provider,
+		arg.Subject,
+		arg.Email,
+	)
+	return err
+}
+
+const deleteUserRecoveryCodes = `-- name: DeleteUserRecoveryCodes :exec
+DELETE FROM "user_recovery_code" WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUserRecoveryCodes(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteUserRecoveryCodes, userID)
+	return err
+}
+
+const disableUserTOTP = `-- name: DisableUserTOTP :exec
+UPDATE "user" SET totp_secret = NULL, totp_enabled_at = NULL WHERE id = $1
+`
+
+func (q *Queries) DisableUserTOTP(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, disableUserTOTP, id)
+	return err
+}
+
+const enableUserTOTP = `-- name: EnableUserTOTP :exec
+UPDATE "user" SET totp_enabled_at = NOW() WHERE id = $1
+`
+
+func (q *Queries) EnableUserTOTP(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, enableUserTOTP, id)
+	return err
+}
+
+const getAPIKeyByPrefix = `-- name: GetAPIKeyByPrefix :one
+SELECT id, user_id, name, prefix, secret_hash, scopes, expires_at, last_used_at, revoked_at, created_at FROM "api_key" WHERE prefix = $1 LIMIT 1
+`
+
+func (q *Queries) GetAPIKeyByPrefix(ctx context.Context, prefix string) (ApiKey, error) {
+	row := q.db.QueryRow(ctx, getAPIKeyByPrefix, prefix)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Prefix,
+		&i.SecretHash,
+		&i.Scopes,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getEmailVerificationTokenByHash = `-- name: GetEmailVerificationTokenByHash :one
+SELECT id, user_id, token_hash, expires_at, used_at FROM "email_verification_token"
+WHERE token_hash = $1 LIMIT 1
+`
+
+type GetEmailVerificationTokenByHashRow struct {
+	ID        int64
+	UserID    uuid.UUID
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+}
+
+func (q *Queries) GetEmailVerificationTokenByHash(ctx context.Context, tokenHash string) (GetEmailVerificationTokenByHashRow, error) {
+	row := q.db.QueryRow(ctx, getEmailVerificationTokenByHash, tokenHash)
+	var i GetEmailVerificationTokenByHashRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.UsedAt,
+	)
+	return i, err
+}
+
+const getPasswordResetTokenByHash = `-- name: GetPasswordResetTokenByHash :one
+SELECT id, user_id, token_hash, expires_at, used_at FROM "password_reset_token"
+WHERE token_hash = $1 LIMIT 1
+`
+
+type GetPasswordResetTokenByHashRow struct {
+	ID        int64
+	UserID    uuid.UUID
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+}
+
+func (q *Queries) GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (GetPasswordResetTokenByHashRow, error) {
+	row := q.db.QueryRow(ctx, getPasswordResetTokenByHash, tokenHash)
+	var i GetPasswordResetTokenByHashRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.UsedAt,
+	)
+	return i, err
+}
+
+const getPermissionsByRole = `-- name: GetPermissionsByRole :many
+SELECT p.name FROM "permission" p
+INNER JOIN "role_permission" rp ON rp.permission_id = p.id
+INNER JOIN "role" r ON r.id = rp.role_id
+WHERE r.role_name = $1
+ORDER BY p.name
+`
+
+func (q *Queries) GetPermissionsByRole(ctx context.Context, roleName string) ([]string, error) {
+	rows, err := q.db.Query(ctx, getPermissionsByRole, roleName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRoleIDByName = `-- name: GetRoleIDByName :one
+SELECT id FROM "role" WHERE role_name = $1 LIMIT 1
+`
+
+func (q *Queries) GetRoleIDByName(ctx context.Context, roleName string) (int32, error) {
+	row := q.db.QueryRow(ctx, getRoleIDByName, roleName)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT u.id, u.email, u.full_name, r.role_name, u.password_hash, u.is_active, u.created_at, u.updated_at FROM "user" u
+SELECT u.id, u.email, u.full_name, r.role_name, u.password_hash, u.is_active, u.email_verified_at, u.created_at, u.updated_at FROM "user" u
 LEFT JOIN  "role" r ON r.id = u.role_id
 WHERE email = $1 LIMIT 1
 `
 
 type GetUserByEmailRow struct {
-	ID           uuid.UUID
-	Email        *string
-	FullName     string
-	RoleName     *string
-	PasswordHash string
-	IsActive     bool
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID              uuid.UUID
+	Email           *string
+	FullName        string
+	RoleName        *string
+	PasswordHash    string
+	IsActive        bool
+	EmailVerifiedAt *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 func (q *Queries) GetUserByEmail(ctx context.Context, email *string) (GetUserByEmailRow, error) {
@@ -57,6 +324,7 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email *string) (GetUserByE
 		&i.RoleName,
 		&i.PasswordHash,
 		&i.IsActive,
+		&i.EmailVerifiedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -64,19 +332,20 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email *string) (GetUserByE
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT u.id, u.email, u.full_name, r.role_name, u.is_active, u.created_at, u.updated_at FROM "user" u
+SELECT u.id, u.email, u.full_name, r.role_name, u.is_active, u.email_verified_at, u.created_at, u.updated_at FROM "user" u
 LEFT JOIN "role" r ON r.id = u.role_id
 WHERE u.id = $1 LIMIT 1
 `
 
 type GetUserByIDRow struct {
-	ID        uuid.UUID
-	Email     *string
-	FullName  string
-	RoleName  *string
-	IsActive  bool
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID              uuid.UUID
+	Email           *string
+	FullName        string
+	RoleName        *string
+	IsActive        bool
+	EmailVerifiedAt *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (GetUserByIDRow, error) {
@@ -88,6 +357,7 @@ func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (GetUserByIDRow
 		&i.FullName,
 		&i.RoleName,
 		&i.IsActive,
+		&i.EmailVerifiedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -95,19 +365,20 @@ func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (GetUserByIDRow
 }
 
 const getUserByName = `-- name: GetUserByName :one
-SELECT u.id, u.email, u.full_name, r.role_name, u.is_active, u.created_at, u.updated_at FROM "user" u
+SELECT u.id, u.email, u.full_name, r.role_name, u.is_active, u.email_verified_at, u.created_at, u.updated_at FROM "user" u
 LEFT JOIN  "role" r ON r.id = u.role_id
 WHERE full_name = $1 LIMIT 1
 `
 
 type GetUserByNameRow struct {
-	ID        uuid.UUID
-	Email     *string
-	FullName  string
-	RoleName  *string
-	IsActive  bool
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID              uuid.UUID
+	Email           *string
+	FullName        string
+	RoleName        *string
+	IsActive        bool
+	EmailVerifiedAt *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 func (q *Queries) GetUserByName(ctx context.Context, fullName string) (GetUserByNameRow, error) {
@@ -119,35 +390,313 @@ func (q *Queries) GetUserByName(ctx context.Context, fullName string) (GetUserBy
 		&i.FullName,
 		&i.RoleName,
 		&i.IsActive,
+		&i.EmailVerifiedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
 
+const getUserEmailForUpdate = `-- name: GetUserEmailForUpdate :one
+SELECT email FROM "user" WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) GetUserEmailForUpdate(ctx context.Context, id uuid.UUID) (*string, error) {
+	row := q.db.QueryRow(ctx, getUserEmailForUpdate, id)
+	var email *string
+	err := row.Scan(&email)
+	return email, err
+}
+
+const getUserIdentity = `-- name: GetUserIdentity :one
+SELECT id, user_id, provider, subject, email, created_at, last_login_at FROM "user_identity" WHERE provider = $1 AND subject = $2 LIMIT 1
+`
+
+type GetUserIdentityParams struct {
+	Provider string
+	Subject  string
+}
+
+func (q *Queries) GetUserIdentity(ctx context.Context, arg GetUserIdentityParams) (UserIdentity, error) {
+	row := q.db.QueryRow(ctx, getUserIdentity, arg.Provider, arg.Subject)
+	var i UserIdentity
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Provider,
+		&i.Subject,
+		&i.Email,
+		&i.CreatedAt,
+		&i.LastLoginAt,
+	)
+	return i, err
+}
+
+const getUserPasswordHash = `-- name: GetUserPasswordHash :one
+SELECT password_hash FROM "user" WHERE id = $1
+`
+
+func (q *Queries) GetUserPasswordHash(ctx context.Context, id uuid.UUID) (string, error) {
+	row := q.db.QueryRow(ctx, getUserPasswordHash, id)
+	var password_hash string
+	err := row.Scan(&password_hash)
+	return password_hash, err
+}
+
+const getUserTOTP = `-- name: GetUserTOTP :one
+SELECT totp_secret, totp_enabled_at FROM "user" WHERE id = $1 LIMIT 1
+`
+
+type GetUserTOTPRow struct {
+	TotpSecret    *string
+	TotpEnabledAt *time.Time
+}
+
+func (q *Queries) GetUserTOTP(ctx context.Context, id uuid.UUID) (GetUserTOTPRow, error) {
+	row := q.db.QueryRow(ctx, getUserTOTP, id)
+	var i GetUserTOTPRow
+	err := row.Scan(&i.TotpSecret, &i.TotpEnabledAt)
+	return i, err
+}
+
+const listAPIKeysByUser = `-- name: ListAPIKeysByUser :many
+SELECT id, user_id, name, prefix, secret_hash, scopes, expires_at, last_used_at, revoked_at, created_at FROM "api_key" WHERE user_id = $1 ORDER BY created_at DESC
+`
+
+func (q *Queries) ListAPIKeysByUser(ctx context.Context, userID uuid.UUID) ([]ApiKey, error) {
+	rows, err := q.db.Query(ctx, listAPIKeysByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ApiKey
+	for rows.Next() {
+		var i ApiKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Prefix,
+			&i.SecretHash,
+			&i.Scopes,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRoles = `-- name: ListRoles :many
+SELECT role_name FROM "role" ORDER BY id
+`
+
+func (q *Queries) ListRoles(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, listRoles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var role_name string
+		if err := rows.Scan(&role_name); err != nil {
+			return nil, err
+		}
+		items = append(items, role_name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsers = `-- name: ListUsers :many
+SELECT u.id, u.email, u.full_name, r.role_name, u.is_active, u.email_verified_at, u.created_at, u.updated_at FROM "user" u
+LEFT JOIN "role" r ON r.id = u.role_id
+WHERE ($1::text IS NULL OR u.email ILIKE '%' || $1 || '%' ESCAPE '\' OR u.full_name ILIKE '%' || $1 || '%' ESCAPE '\')
+  AND ($2::text IS NULL OR r.role_name = $2)
+  AND ($3::boolean IS NULL OR u.is_active = $3)
+ORDER BY u.created_at DESC, u.id
+LIMIT $4 OFFSET $5
+`
+
+type ListUsersParams struct {
+	Search   *string
+	Role     *string
+	IsActive *bool
+	Limit    int32
+	Offset   int32
+}
+
+type ListUsersRow struct {
+	ID              uuid.UUID
+	Email           *string
+	FullName        string
+	RoleName        *string
+	IsActive        bool
+	EmailVerifiedAt *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]ListUsersRow, error) {
+	rows, err := q.db.Query(ctx, listUsers,
+		arg.Search,
+		arg.Role,
+		arg.IsActive,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUsersRow
+	for rows.Next() {
+		var i ListUsersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.FullName,
+			&i.RoleName,
+			&i.IsActive,
+			&i.EmailVerifiedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeAPIKey = `-- name: RevokeAPIKey :execrows
+UPDATE "api_key" SET revoked_at = NOW()
+WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+`
+
+type RevokeAPIKeyParams struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+func (q *Queries) RevokeAPIKey(ctx context.Context, arg RevokeAPIKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeAPIKey, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setUserActive = `-- name: SetUserActive :execrows
+UPDATE "user" SET is_active = $1 WHERE id = $2
+`
+
+type SetUserActiveParams struct {
+	IsActive bool
+	ID       uuid.UUID
+}
+
+func (q *Queries) SetUserActive(ctx context.Context, arg SetUserActiveParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setUserActive, arg.IsActive, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setUserEmailVerified = `-- name: SetUserEmailVerified :exec
+UPDATE "user" SET email_verified_at = NOW() WHERE id = $1 AND email_verified_at IS NULL
+`
+
+func (q *Queries) SetUserEmailVerified(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, setUserEmailVerified, id)
+	return err
+}
+
+const setUserRole = `-- name: SetUserRole :execrows
+UPDATE "user" SET role_id = $1 WHERE id = $2
+`
+
+type SetUserRoleParams struct {
+	RoleID int
+	ID     uuid.UUID
+}
+
+func (q *Queries) SetUserRole(ctx context.Context, arg SetUserRoleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setUserRole, arg.RoleID, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setUserTOTPSecret = `-- name: SetUserTOTPSecret :exec
+UPDATE "user" SET totp_secret = $1, totp_enabled_at = NULL WHERE id = $2
+`
+
+type SetUserTOTPSecretParams struct {
+	TotpSecret *string
+	ID         uuid.UUID
+}
+
+func (q *Queries) SetUserTOTPSecret(ctx context.Context, arg SetUserTOTPSecretParams) error {
+	_, err := q.db.Exec(ctx, setUserTOTPSecret, arg.TotpSecret, arg.ID)
+	return err
+}
+
+const touchAPIKey = `-- name: TouchAPIKey :exec
+UPDATE "api_key" SET last_used_at = NOW() WHERE id = $1
+`
+
+func (q *Queries) TouchAPIKey(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, touchAPIKey, id)
+	return err
+}
+
+const touchUserIdentity = `-- name: TouchUserIdentity :exec
+UPDATE "user_identity" SET last_login_at = NOW(), email = $2 WHERE id = $1
+`
+
+type TouchUserIdentityParams struct {
+	ID    int64
+	Email *string
+}
+
+func (q *Queries) TouchUserIdentity(ctx context.Context, arg TouchUserIdentityParams) error {
+	_, err := q.db.Exec(ctx, touchUserIdentity, arg.ID, arg.Email)
+	return err
+}
+
 const updateUser = `-- name: UpdateUser :one
 UPDATE "user"
-SET full_name = $1, email = $2, password_hash = $3, is_active = $4, updated_at = NOW()
-WHERE id = $5
-RETURNING id, full_name, email, password_hash, role_id, is_active, created_at, updated_at
+SET full_name = $1, email = $2,
+    email_verified_at = CASE WHEN email = $2 THEN email_verified_at END,
+    updated_at = NOW()
+WHERE id = $3
+RETURNING id, full_name, email, password_hash, role_id, is_active, created_at, updated_at, email_verified_at, totp_secret, totp_enabled_at, totp_last_step
 `
 
 type UpdateUserParams struct {
-	FullName     string
-	Email        *string
-	PasswordHash string
-	IsActive     bool
-	ID           uuid.UUID
+	FullName string
+	Email    *string
+	ID       uuid.UUID
 }
 
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error) {
-	row := q.db.QueryRow(ctx, updateUser,
-		arg.FullName,
-		arg.Email,
-		arg.PasswordHash,
-		arg.IsActive,
-		arg.ID,
-	)
+	row := q.db.QueryRow(ctx, updateUser, arg.FullName, arg.Email, arg.ID)
 	var i User
 	err := row.Scan(
 		&i.ID,
@@ -158,6 +707,106 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.EmailVerifiedAt,
+		&i.TotpSecret,
+		&i.TotpEnabledAt,
+		&i.TotpLastStep,
 	)
 	return i, err
 }
+
+const updateUserPassword = `-- name: UpdateUserPassword :exec
+UPDATE "user" SET password_hash = $1 WHERE id = $2
+`
+
+type UpdateUserPasswordParams struct {
+	PasswordHash string
+	ID           uuid.UUID
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error {
+	_, err := q.db.Exec(ctx, updateUserPassword, arg.PasswordHash, arg.ID)
+	return err
+}
+
+const useEmailVerificationToken = `-- name: UseEmailVerificationToken :execrows
+UPDATE "email_verification_token" SET used_at = NOW()
+WHERE id = $1 AND used_at IS NULL AND expires_at > NOW()
+`
+
+func (q *Queries) UseEmailVerificationToken(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, useEmailVerificationToken, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const usePasswordResetToken = `-- name: UsePasswordResetToken :execrows
+UPDATE "password_reset_token" SET used_at = NOW()
+WHERE id = $1 AND used_at IS NULL AND expires_at > NOW()
+`
+
+func (q *Queries) UsePasswordResetToken(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, usePasswordResetToken, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const useRecoveryCode = `-- name: UseRecoveryCode :execrows
+UPDATE "user_recovery_code" SET used_at = NOW()
+WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+`
+
+type UseRecoveryCodeParams struct {
+	UserID   uuid.UUID
+	CodeHash string
+}
+
+func (q *Queries) UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useRecoveryCode, arg.UserID, arg.CodeHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const useUserEmailVerificationTokens = `-- name: UseUserEmailVerificationTokens :exec
+UPDATE "email_verification_token" SET used_at = NOW()
+WHERE user_id = $1 AND used_at IS NULL
+`
+
+func (q *Queries) UseUserEmailVerificationTokens(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, useUserEmailVerificationTokens, userID)
+	return err
+}
+
+const useUserPasswordResetTokens = `-- name: UseUserPasswordResetTokens :exec
+UPDATE "password_reset_token" SET used_at = NOW()
+WHERE user_id = $1 AND used_at IS NULL
+`
+
+func (q *Queries) UseUserPasswordResetTokens(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, useUserPasswordResetTokens, userID)
+	return err
+}
+
+const useUserTOTPStep = `-- name: UseUserTOTPStep :execrows
+UPDATE "user" SET totp_last_step = $2
+WHERE id = $1 AND (totp_last_step IS NULL OR totp_last_step < $2)
+`
+
+type UseUserTOTPStepParams struct {
+	ID           uuid.UUID
+	TotpLastStep *int64
+}
+
+func (q *Queries) UseUserTOTPStep(ctx context.Context, arg UseUserTOTPStepParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useUserTOTPStep, arg.ID, arg.TotpLastStep)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// PutBytes stores generated content, such as a QR code, under objectName and returns its public
+// URL. An existing object of that name is replaced.
+func (m *MinioClient) PutBytes(ctx context.Context, objectName string, data []byte, contentType string) (string, error) {
+	_, err := m.Client.PutObject(ctx,
+		m.Bucket,
+		objectName,
+		bytes.NewReader(data),
+		int64(len(data)),
+		minio.PutObjectOptions{
+			ContentType: contentType,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return m.PublicUrl(objectName), nil
+}
+
+func (m *MinioClient) GetBytes(ctx context.Context, objectName string) ([]byte, error) {
+	object, err := m.Client.GetObject(ctx, m.Bucket, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+	return io.ReadAll(object)
+}
+
+func (m *MinioClient) Remove(ctx context.Context, objectName string) error {
+	return m.Client.RemoveObject(ctx, m.Bucket, objectName, minio.RemoveObjectOptions{})
+}
@@ -42,3 +42,20 @@ func (m *MinioClient) UploadLogo(ctx context.Context, file multipart.File, heade
 	}
 	return m.PublicUrl(objectName), nil
 }
+
+func (m *MinioClient) UploadImage(ctx context.Context, file multipart.File, header *multipart.FileHeader) (string, error) {
+	objectName := fmt.Sprintf("image-%d-%s", time.Now().UnixNano(), header.Filename)
+	_, err := m.Client.PutObject(ctx,
+		m.Bucket,
+		objectName,
+		file,
+		header.Size,
+		minio.PutObjectOptions{
+			ContentType: header.Header.Get("Content-Type"),
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return m.PublicUrl(objectName), nil
+}
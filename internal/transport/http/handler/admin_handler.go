@@ -0,0 +1,198 @@
+package handler
+
+import (
+	authapp "go-ai/internal/application/auth"
+	auth "go-ai/internal/domain/auth"
+	"go-ai/internal/transport/http/response"
+	"go-ai/pkg/logger"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+)
+
+type AdminHandler struct {
+	ListUsersUC     *authapp.ListUsersUseCase
+	SetUserStatusUC *authapp.SetUserStatusUseCase
+	SetUserRoleUC   *authapp.SetUserRoleUseCase
+	ListRolesUC     *authapp.ListRolesUseCase
+	Logger          zerolog.Logger
+}
+
+func NewAdminHandler(
+	listUsersUC *authapp.ListUsersUseCase,
+	setUserStatusUC *authapp.SetUserStatusUseCase,
+	setUserRoleUC *authapp.SetUserRoleUseCase,
+	listRolesUC *authapp.ListRolesUseCase) *AdminHandler {
+	return &AdminHandler{
+		ListUsersUC:     listUsersUC,
+		SetUserStatusUC: setUserStatusUC,
+		SetUserRoleUC:   setUserRoleUC,
+		ListRolesUC:     listRolesUC,
+		Logger:          logger.NewLogger().With().Str("component", "Admin handler").Logger(),
+	}
+}
+
+// ListUsers godoc
+// @Summary List users
+// @Description List users page by page, newest first, optionally filtered by search text, role and status
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param search query string false "Matches email or full name"
+// @Param role query string false "Role name"
+// @Param is_active query bool false "Account status"
+// @Param page query int false "Page number, starting at 1"
+// @Param page_size query int false "Users per page, at most 100"
+// @Success 200 {object} app.ListUsersSuccessResponseDoc "Users retrieved successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/admin/users [get]
+func (h *AdminHandler) ListUsers(c echo.Context) error {
+	in := authapp.ListUsersRequest{
+		Search: c.QueryParam("search"),
+		Role:   c.QueryParam("role"),
+	}
+	if v := c.QueryParam("is_active"); v != "" {
+		isActive, err := strconv.ParseBool(v)
+		if err != nil {
+			details := response.ErrorDetail{
+				Field:   "is_active",
+				Message: "is_active must be true or false",
+			}
+			return response.Error(c, http.StatusBadRequest, "Invalid query parameter", details)
+		}
+		in.IsActive = &isActive
+	}
+	if v := c.QueryParam("page"); v != "" {
+		page, err := strconv.Atoi(v)
+		if err != nil {
+			details := response.ErrorDetail{
+				Field:   "page",
+				Message: "page must be a number",
+			}
+			return response.Error(c, http.StatusBadRequest, "Invalid query parameter", details)
+		}
+		in.Page = page
+	}
+	if v := c.QueryParam("page_size"); v != "" {
+		pageSize, err := strconv.Atoi(v)
+		if err != nil {
+			details := response.ErrorDetail{
+				Field:   "page_size",
+				Message: "page_size must be a number",
+			}
+			return response.Error(c, http.StatusBadRequest, "Invalid query parameter", details)
+		}
+		in.PageSize = pageSize
+	}
+	resp, err := h.ListUsersUC.Execute(c.Request().Context(), in)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to list users")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[authapp.ListUsersResponse](c, resp, "Users retrieved successfully")
+}
+
+// SetUserStatus godoc
+// @Summary Activate or deactivate user
+// @Description Deactivating a user ends all of their sessions immediately
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param body body authapp.SetUserStatusRequest true "Status payload"
+// @Success 200 {object} app.AdminUserSuccessResponseDoc "User status updated successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/admin/users/{id}/status [put]
+func (h *AdminHandler) SetUserStatus(c echo.Context) error {
+	adminUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid user id")
+	}
+	var in authapp.SetUserStatusRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	resp, err := h.SetUserStatusUC.Execute(c.Request().Context(), adminUUID, id, in)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to set user status")
+		switch err {
+		case auth.ErrUserNotFound:
+			return response.Error(c, http.StatusNotFound, err.Error())
+		case auth.ErrCannotModifySelf:
+			return response.Error(c, http.StatusBadRequest, err.Error())
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[authapp.AdminUserResponse](c, resp, "User status updated successfully")
+}
+
+// SetUserRole godoc
+// @Summary Assign role to user
+// @Description Assign one of the existing roles to a user
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param body body authapp.SetUserRoleRequest true "Role payload"
+// @Success 200 {object} app.AdminUserSuccessResponseDoc "User role updated successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/admin/users/{id}/role [put]
+func (h *AdminHandler) SetUserRole(c echo.Context) error {
+	adminUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid user id")
+	}
+	var in authapp.SetUserRoleRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	resp, err := h.SetUserRoleUC.Execute(c.Request().Context(), adminUUID, id, in)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to set user role")
+		switch err {
+		case auth.ErrUserNotFound:
+			return response.Error(c, http.StatusNotFound, err.Error())
+		case auth.ErrRoleNotFound:
+			details := response.ErrorDetail{
+				Field:   "role",
+				Message: "Role must be one of the existing roles",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		case auth.ErrCannotModifySelf:
+			return response.Error(c, http.StatusBadRequest, err.Error())
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[authapp.AdminUserResponse](c, resp, "User role updated successfully")
+}
+
+// ListRoles godoc
+// @Summary List roles
+// @Description List the roles that can be assigned to users
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Success 200 {object} app.ListRolesSuccessResponseDoc "Roles retrieved successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/admin/roles [get]
+func (h *AdminHandler) ListRoles(c echo.Context) error {
+	resp, err := h.ListRolesUC.Execute(c.Request().Context())
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to list roles")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[authapp.ListRolesResponse](c, resp, "Roles retrieved successfully")
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	authapp "go-ai/internal/application/auth"
+	auth "go-ai/internal/domain/auth"
+	"go-ai/internal/transport/http/response"
+	"go-ai/pkg/logger"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+)
+
+type APIKeyHandler struct {
+	CreateUC *authapp.CreateAPIKeyUseCase
+	ListUC   *authapp.ListAPIKeysUseCase
+	RevokeUC *authapp.RevokeAPIKeyUseCase
+	Logger   zerolog.Logger
+}
+
+func NewAPIKeyHandler(
+	createUC *authapp.CreateAPIKeyUseCase,
+	listUC *authapp.ListAPIKeysUseCase,
+	revokeUC *authapp.RevokeAPIKeyUseCase) *APIKeyHandler {
+	return &APIKeyHandler{
+		CreateUC: createUC,
+		ListUC:   listUC,
+		RevokeUC: revokeUC,
+		Logger:   logger.NewLogger().With().Str("component", "API key handler").Logger(),
+	}
+}
+
+// CreateAPIKey godoc
+// @Summary Create API key
+// @Description Issue an API key for machine-to-machine access, sent in the X-API-Key header. The key is only returned in this response
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body authapp.CreateAPIKeyRequest true "API key payload"
+// @Success 200 {object} app.CreateAPIKeySuccessResponseDoc "API key created successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/auth/api-keys [post]
+func (h *APIKeyHandler) Create(c echo.Context) error {
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	var in authapp.CreateAPIKeyRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	resp, err := h.CreateUC.Execute(c.Request().Context(), userUUID, in)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to create api key")
+		switch err {
+		case auth.ErrAPIKeyNameRequired:
+			details := response.ErrorDetail{
+				Field:   "name",
+				Message: "Name is a required field",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		case auth.ErrAPIKeyScopeInvalid:
+			details := response.ErrorDetail{
+				Field:   "scopes",
+				Message: "Scopes must be permissions of your role",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		case auth.ErrAPIKeyExpiryInvalid:
+			details := response.ErrorDetail{
+				Field:   "expires_in_days",
+				Message: "Expiry must be between 0 and 365 days",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[authapp.CreateAPIKeyResponse](c, resp, "API key created successfully")
+}
+
+// ListAPIKeys godoc
+// @Summary List API keys
+// @Description List the API keys of the authenticated user, including revoked ones
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Success 200 {object} app.ListAPIKeysSuccessResponseDoc "API keys retrieved successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/auth/api-keys [get]
+func (h *APIKeyHandler) List(c echo.Context) error {
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.ListUC.Execute(c.Request().Context(), userUUID)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to list api keys")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[authapp.ListAPIKeysResponse](c, resp, "API keys retrieved successfully")
+}
+
+// RevokeAPIKey godoc
+// @Summary Revoke API key
+// @Description Revoke an API key of the authenticated user
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param id path string true "API key ID"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "API key revoked successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/auth/api-keys/{id} [delete]
+func (h *APIKeyHandler) Revoke(c echo.Context) error {
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid API key id")
+	}
+	if err := h.RevokeUC.Execute(c.Request().Context(), userUUID, id); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to revoke api key")
+		switch err {
+		case auth.ErrAPIKeyNotFound:
+			return response.Error(c, http.StatusNotFound, err.Error())
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[any](c, nil, "API key revoked successfully")
+}
@@ -2,12 +2,16 @@ package handler
 
 import (
 	"errors"
+	"fmt"
 	authapp "go-ai/internal/application/auth"
 	auth "go-ai/internal/domain/auth"
 	"go-ai/internal/transport/http/response"
 	"go-ai/internal/transport/http/status"
 	"go-ai/pkg/logger"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -15,24 +19,66 @@ import (
 )
 
 type AuthHandler struct {
-	RegisterUC     *authapp.RegisterUseCase
-	LoginUC        *authapp.LoginUseCase
-	RefreshTokenUC *authapp.RefreshTokenUseCase
-	ProfileUC      *authapp.GetProfileUseCase
-	Logger         zerolog.Logger
+	RegisterUC           *authapp.RegisterUseCase
+	LoginUC              *authapp.LoginUseCase
+	RefreshTokenUC       *authapp.RefreshTokenUseCase
+	ProfileUC            *authapp.GetProfileUseCase
+	LogoutUC             *authapp.LogoutUseCase
+	LogoutAllUC          *authapp.LogoutAllUseCase
+	ListSessionsUC       *authapp.ListSessionsUseCase
+	RevokeSessionUC      *authapp.RevokeSessionUseCase
+	ForgotPasswordUC     *authapp.ForgotPasswordUseCase
+	ResetPasswordUC      *authapp.ResetPasswordUseCase
+	VerifyEmailUC        *authapp.VerifyEmailUseCase
+	ResendVerificationUC *authapp.ResendVerificationUseCase
+	UpdateProfileUC      *authapp.UpdateProfileUseCase
+	ChangePasswordUC     *authapp.ChangePasswordUseCase
+	VerifyMfaUC          *authapp.VerifyMfaUseCase
+	SetupTOTPUC          *authapp.SetupTOTPUseCase
+	ConfirmTOTPUC        *authapp.ConfirmTOTPUseCase
+	DisableTOTPUC        *authapp.DisableTOTPUseCase
+	Logger               zerolog.Logger
 }
 
 func NewAuthHandler(
 	regUC *authapp.RegisterUseCase,
 	loginUC *authapp.LoginUseCase,
 	refreshUC *authapp.RefreshTokenUseCase,
-	profileUC *authapp.GetProfileUseCase) *AuthHandler {
+	profileUC *authapp.GetProfileUseCase,
+	logoutUC *authapp.LogoutUseCase,
+	logoutAllUC *authapp.LogoutAllUseCase,
+	listSessionsUC *authapp.ListSessionsUseCase,
+	revokeSessionUC *authapp.RevokeSessionUseCase,
+	forgotPasswordUC *authapp.ForgotPasswordUseCase,
+	resetPasswordUC *authapp.ResetPasswordUseCase,
+	verifyEmailUC *authapp.VerifyEmailUseCase,
+	resendVerificationUC *authapp.ResendVerificationUseCase,
+	updateProfileUC *authapp.UpdateProfileUseCase,
+	changePasswordUC *authapp.ChangePasswordUseCase,
+	verifyMfaUC *authapp.VerifyMfaUseCase,
+	setupTOTPUC *authapp.SetupTOTPUseCase,
+	confirmTOTPUC *authapp.ConfirmTOTPUseCase,
+	disableTOTPUC *authapp.DisableTOTPUseCase) *AuthHandler {
 	return &AuthHandler{
-		RegisterUC:     regUC,
-		LoginUC:        loginUC,
-		RefreshTokenUC: refreshUC,
-		ProfileUC:      profileUC,
-		Logger:         logger.NewLogger().With().Str("component", "Auth handler").Logger(),
+		RegisterUC:           regUC,
+		LoginUC:              loginUC,
+		RefreshTokenUC:       refreshUC,
+		ProfileUC:            profileUC,
+		LogoutUC:             logoutUC,
+		LogoutAllUC:          logoutAllUC,
+		ListSessionsUC:       listSessionsUC,
+		RevokeSessionUC:      revokeSessionUC,
+		ForgotPasswordUC:     forgotPasswordUC,
+		ResetPasswordUC:      resetPasswordUC,
+		VerifyEmailUC:        verifyEmailUC,
+		ResendVerificationUC: resendVerificationUC,
+		UpdateProfileUC:      updateProfileUC,
+		ChangePasswordUC:     changePasswordUC,
+		VerifyMfaUC:          verifyMfaUC,
+		SetupTOTPUC:          setupTOTPUC,
+		ConfirmTOTPUC:        confirmTOTPUC,
+		DisableTOTPUC:        disableTOTPUC,
+		Logger:               logger.NewLogger().With().Str("component", "Auth handler").Logger(),
 	}
 }
 
@@ -100,9 +146,15 @@ func (h *AuthHandler) Login(c echo.Context) error {
 	if err := c.Bind(&in); err != nil {
 		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
 	}
+	in.UserAgent = c.Request().UserAgent()
+	in.IpAddress = c.RealIP()
 	responseData, err := h.LoginUC.Execute(c.Request().Context(), in)
 	if err != nil {
 		h.Logger.Error().Err(err).Msg("failed to login user")
+		var lockErr *auth.LockoutError
+		if errors.As(err, &lockErr) {
+			return lockoutError(c, lockErr)
+		}
 		switch err {
 		case status.ErrInvalidEmail, status.ErrInvalidPassword, status.ErrNotFound, auth.ErrPasswordVerifyFail, status.ErrUserInactive:
 			details := response.ErrorDetail{}
@@ -119,10 +171,15 @@ func (h *AuthHandler) Login(c echo.Context) error {
 				}
 			}
 			return response.Error(c, http.StatusBadRequest, "Invalid email or password", details)
+		case auth.ErrEmailNotVerified:
+			return response.Error(c, http.StatusForbidden, err.Error())
 		default:
 			return response.Error(c, http.StatusInternalServerError, "Internal server error")
 		}
 	}
+	if responseData.MfaRequired {
+		return response.Success[authapp.LoginResponse](c, responseData, "mfa required")
+	}
 	if responseData.AccessToken == "" || responseData.RefreshToken == "" {
 		h.Logger.Error().Msg("Failed to login user: invalid credentials")
 		return response.Error(c, http.StatusBadRequest, "Invalid email or password")
@@ -130,6 +187,57 @@ func (h *AuthHandler) Login(c echo.Context) error {
 	return response.Success[authapp.LoginResponse](c, responseData, "login success")
 }
 
+// VerifyMfa godoc
+// @Summary Complete login with a second factor
+// @Description Exchange the MFA challenge token returned by login and a TOTP or recovery code for access and refresh tokens
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body authapp.VerifyMfaRequest true "MFA payload"
+// @Success 200 {object} app.LoginSuccessResponseDoc "login success"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/auth/login/mfa [post]
+func (h *AuthHandler) VerifyMfa(c echo.Context) error {
+	var in authapp.VerifyMfaRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	in.UserAgent = c.Request().UserAgent()
+	in.IpAddress = c.RealIP()
+	responseData, err := h.VerifyMfaUC.Execute(c.Request().Context(), in)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to verify mfa")
+		var lockErr *auth.LockoutError
+		if errors.As(err, &lockErr) {
+			return lockoutError(c, lockErr)
+		}
+		switch err {
+		case auth.ErrMfaChallengeInvalid, status.ErrUserInactive:
+			return response.Error(c, http.StatusUnauthorized, err.Error())
+		case auth.ErrMfaCodeInvalid:
+			details := response.ErrorDetail{
+				Field:   "code",
+				Message: "Code is invalid",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[authapp.LoginResponse](c, responseData, "login success")
+}
+
+// lockoutError answers a locked login with 429 and the seconds left in Retry-After.
+func lockoutError(c echo.Context, lockErr *auth.LockoutError) error {
+	retryAfter := int(math.Ceil(lockErr.RetryAfter.Seconds()))
+	c.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(retryAfter))
+	details := response.ErrorDetail{
+		Field:   "retry_after",
+		Message: fmt.Sprintf("Try again in %d seconds", retryAfter),
+	}
+	return response.Error(c, http.StatusTooManyRequests, lockErr.Error(), details)
+}
+
 // RefreshToken godoc
 // @Summary Refresh access token
 // @Description Generate a new access token using a valid refresh token
@@ -146,7 +254,8 @@ func (h *AuthHandler) RefreshToken(c echo.Context) error {
 		h.Logger.Error().Err(err).Msg("")
 		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
 	}
-
+	in.UserAgent = c.Request().UserAgent()
+	in.IpAddress = c.RealIP()
 	responseData, err := h.RefreshTokenUC.Execute(c.Request().Context(), in)
 	if err != nil {
 		h.Logger.Error().Err(err).Msg("Failed to refresh token")
@@ -160,8 +269,10 @@ func (h *AuthHandler) RefreshToken(c echo.Context) error {
 				}
 			}
 			return response.Error(c, http.StatusBadRequest, "Invalid refresh token", details)
-		case auth.ErrTokenInvalid, auth.ErrTokenExpired, auth.ErrTokenMalformed:
+		case auth.ErrTokenInvalid, auth.ErrTokenExpired, auth.ErrTokenMalformed, auth.ErrSessionNotFound:
 			return response.Error(c, http.StatusBadRequest, err.Error())
+		case auth.ErrRefreshTokenReused:
+			return response.Error(c, http.StatusUnauthorized, err.Error())
 		default:
 			return response.Error(c, http.StatusInternalServerError, "Internal server error")
 		}
@@ -206,3 +317,431 @@ func (h *AuthHandler) GetProfile(c echo.Context) error {
 	}
 	return response.Success[authapp.GetProfileResponse](c, resp, "Profile retrieved successfully")
 }
+
+// UpdateProfile godoc
+// @Summary Update user profile
+// @Description Update the full name and email of the authenticated user. A new email is unverified until the link mailed to it is opened
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body authapp.UpdateProfileRequest true "Profile payload"
+// @Success 200 {object} app.GetProfileSuccessResponseDoc "Profile updated successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/auth/profile [put]
+func (h *AuthHandler) UpdateProfile(c echo.Context) error {
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	var in authapp.UpdateProfileRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	profile, err := h.UpdateProfileUC.Execute(c.Request().Context(), userUUID, in)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to update profile")
+		switch err {
+		case status.ErrInvalidEmail:
+			details := response.ErrorDetail{
+				Field:   "email",
+				Message: "Email is a required field",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		case status.ErrInvalidName:
+			details := response.ErrorDetail{
+				Field:   "full_name",
+				Message: "Full name is a required field",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		case status.ErrEmailAlreadyExists, status.ErrNameAlreadyExists:
+			return response.Error(c, http.StatusConflict, err.Error())
+		case status.ErrNotFound:
+			return response.Error(c, http.StatusNotFound, "User not found")
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[authapp.GetProfileResponse](c, profile, "Profile updated successfully")
+}
+
+// ChangePassword godoc
+// @Summary Change password
+// @Description Change the password of the authenticated user after confirming the current one. Every session, this one included, is signed out
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body authapp.ChangePasswordRequest true "Change password payload"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Password changed successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/auth/password/change [post]
+func (h *AuthHandler) ChangePassword(c echo.Context) error {
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	var in authapp.ChangePasswordRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	if err := h.ChangePasswordUC.Execute(c.Request().Context(), userUUID, in); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to change password")
+		switch err {
+		case status.ErrInvalidPassword:
+			details := response.ErrorDetail{
+				Field:   "new_password",
+				Message: "New password is a required field",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		case auth.ErrPasswordVerifyFail:
+			details := response.ErrorDetail{
+				Field:   "current_password",
+				Message: "Current password is incorrect",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		case status.ErrNotFound:
+			return response.Error(c, http.StatusNotFound, "User not found")
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[any](c, nil, "Password changed successfully")
+}
+
+// Logout godoc
+// @Summary Logout current session
+// @Description Revoke the access token used for this request and drop the refresh token
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Success 200 {object} app.LogoutSuccessResponseDoc "Logout successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/auth/logout [post]
+func (h *AuthHandler) Logout(c echo.Context) error {
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	sessionId, _ := c.Get("session_id").(string)
+	tokenId, _ := c.Get("token_id").(string)
+	expiresAt, _ := c.Get("token_expires_at").(time.Time)
+	if err := h.LogoutUC.Execute(c.Request().Context(), userUUID, sessionId, tokenId, expiresAt); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to logout")
+		switch err {
+		case auth.ErrTokenMissing:
+			return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[any](c, nil, "Logout successfully")
+}
+
+// LogoutAll godoc
+// @Summary Logout everywhere
+// @Description Revoke every access and refresh token issued to the authenticated user
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Success 200 {object} app.LogoutSuccessResponseDoc "Logout all sessions successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/auth/logout-all [post]
+func (h *AuthHandler) LogoutAll(c echo.Context) error {
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.LogoutAllUC.Execute(c.Request().Context(), userUUID); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to logout all sessions")
+		switch err {
+		case auth.ErrTokenMissing:
+			return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[any](c, nil, "Logout all sessions successfully")
+}
+
+// ListSessions godoc
+// @Summary List sessions
+// @Description List the devices the authenticated user is logged in on
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Success 200 {object} app.ListSessionsSuccessResponseDoc "Sessions retrieved successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/auth/sessions [get]
+func (h *AuthHandler) ListSessions(c echo.Context) error {
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	sessionId, _ := c.Get("session_id").(string)
+	sessions, err := h.ListSessionsUC.Execute(c.Request().Context(), userUUID, sessionId)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to list sessions")
+		switch err {
+		case auth.ErrTokenMissing:
+			return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[authapp.ListSessionsResponse](c, sessions, "Sessions retrieved successfully")
+}
+
+// RevokeSession godoc
+// @Summary Revoke session
+// @Description Log out a single device of the authenticated user
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param id path string true "Session ID"
+// @Success 200 {object} app.LogoutSuccessResponseDoc "Session revoked successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/auth/sessions/{id} [delete]
+func (h *AuthHandler) RevokeSession(c echo.Context) error {
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	id := c.Param("id")
+	if id == "" {
+		return response.Error(c, http.StatusBadRequest, "missing session id")
+	}
+	if err := h.RevokeSessionUC.Execute(c.Request().Context(), userUUID, id); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to revoke session")
+		switch err {
+		case auth.ErrSessionNotFound:
+			return response.Error(c, http.StatusNotFound, "Session not found")
+		case auth.ErrTokenMissing:
+			return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[any](c, nil, "Session revoked successfully")
+}
+
+// ForgotPassword godoc
+// @Summary Request a password reset
+// @Description Send a single-use password reset link to the email if it belongs to an account
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body authapp.ForgotPasswordRequest true "Forgot password payload"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Reset link sent"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/auth/password/forgot [post]
+func (h *AuthHandler) ForgotPassword(c echo.Context) error {
+	var in authapp.ForgotPasswordRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	if err := h.ForgotPasswordUC.Execute(c.Request().Context(), in); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to request password reset")
+		switch err {
+		case status.ErrInvalidEmail:
+			details := response.ErrorDetail{
+				Field:   "email",
+				Message: "Email is a required field",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[any](c, nil, "If the email is registered, a reset link has been sent")
+}
+
+// ResetPassword godoc
+// @Summary Reset password
+// @Description Set a new password with a reset token and log out every session
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body authapp.ResetPasswordRequest true "Reset password payload"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Password reset successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/auth/password/reset [post]
+func (h *AuthHandler) ResetPassword(c echo.Context) error {
+	var in authapp.ResetPasswordRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	if err := h.ResetPasswordUC.Execute(c.Request().Context(), in); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to reset password")
+		switch err {
+		case status.ErrInvalidPassword:
+			details := response.ErrorDetail{
+				Field:   "new_password",
+				Message: "New password is a required field",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		case auth.ErrResetTokenInvalid:
+			return response.Error(c, http.StatusBadRequest, err.Error())
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[any](c, nil, "Password reset successfully")
+}
+
+// VerifyEmail godoc
+// @Summary Verify email
+// @Description Confirm the email address of an account with the token from the verification email
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param token query string true "Verification token"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Email verified successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/auth/verify-email [get]
+func (h *AuthHandler) VerifyEmail(c echo.Context) error {
+	token := c.QueryParam("token")
+	if err := h.VerifyEmailUC.Execute(c.Request().Context(), token); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to verify email")
+		switch err {
+		case auth.ErrVerifyTokenInvalid:
+			return response.Error(c, http.StatusBadRequest, err.Error())
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[any](c, nil, "Email verified successfully")
+}
+
+// ResendVerification godoc
+// @Summary Resend verification email
+// @Description Send a new email verification link if the account is not verified yet
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body authapp.ResendVerificationRequest true "Resend verification payload"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Verification email sent"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/auth/verify-email/resend [post]
+func (h *AuthHandler) ResendVerification(c echo.Context) error {
+	var in authapp.ResendVerificationRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	if err := h.ResendVerificationUC.Execute(c.Request().Context(), in); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to resend verification email")
+		switch err {
+		case status.ErrInvalidEmail:
+			details := response.ErrorDetail{
+				Field:   "email",
+				Message: "Email is a required field",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[any](c, nil, "If the account needs verification, an email has been sent")
+}
+
+// SetupTOTP godoc
+// @Summary Start TOTP enrolment
+// @Description Generate a TOTP secret and the otpauth URI to render as a QR code. Two-factor is enabled after confirmation
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Success 200 {object} app.SetupTOTPSuccessResponseDoc "TOTP setup started"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/auth/mfa/totp/setup [post]
+func (h *AuthHandler) SetupTOTP(c echo.Context) error {
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.SetupTOTPUC.Execute(c.Request().Context(), userUUID)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to setup totp")
+		switch err {
+		case auth.ErrMfaAlreadyEnabled:
+			return response.Error(c, http.StatusConflict, err.Error())
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[authapp.SetupTOTPResponse](c, resp, "TOTP setup started")
+}
+
+// ConfirmTOTP godoc
+// @Summary Confirm TOTP enrolment
+// @Description Enable two-factor with a code from the authenticator app and return the recovery codes
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body authapp.TOTPCodeRequest true "TOTP code"
+// @Success 200 {object} app.ConfirmTOTPSuccessResponseDoc "Two-factor authentication enabled"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/auth/mfa/totp/confirm [post]
+func (h *AuthHandler) ConfirmTOTP(c echo.Context) error {
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	var in authapp.TOTPCodeRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	resp, err := h.ConfirmTOTPUC.Execute(c.Request().Context(), userUUID, in)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to confirm totp")
+		switch err {
+		case auth.ErrMfaAlreadyEnabled:
+			return response.Error(c, http.StatusConflict, err.Error())
+		case auth.ErrMfaSetupRequired:
+			return response.Error(c, http.StatusBadRequest, err.Error())
+		case auth.ErrMfaCodeInvalid:
+			details := response.ErrorDetail{
+				Field:   "code",
+				Message: "Code is invalid",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[authapp.ConfirmTOTPResponse](c, resp, "Two-factor authentication enabled")
+}
+
+// DisableTOTP godoc
+// @Summary Disable TOTP
+// @Description Turn off two-factor with a TOTP or recovery code and drop the remaining recovery codes
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body authapp.TOTPCodeRequest true "TOTP or recovery code"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Two-factor authentication disabled"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/auth/mfa/totp/disable [post]
+func (h *AuthHandler) DisableTOTP(c echo.Context) error {
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	var in authapp.TOTPCodeRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	if err := h.DisableTOTPUC.Execute(c.Request().Context(), userUUID, in); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to disable totp")
+		switch err {
+		case auth.ErrMfaNotEnabled:
+			return response.Error(c, http.StatusBadRequest, err.Error())
+		case auth.ErrMfaCodeInvalid:
+			details := response.ErrorDetail{
+				Field:   "code",
+				Message: "Code is invalid",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[any](c, nil, "Two-factor authentication disabled")
+}
@@ -0,0 +1,226 @@
+package handler
+
+import (
+	orderapp "go-ai/internal/application/order"
+	"go-ai/internal/transport/http/response"
+	"go-ai/pkg/logger"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+)
+
+type CartHandler struct {
+	GetUC        *orderapp.GetCartUseCase
+	AddItemUC    *orderapp.AddCartItemUseCase
+	UpdateItemUC *orderapp.UpdateCartItemUseCase
+	DeleteItemUC *orderapp.DeleteCartItemUseCase
+	DeleteUC     *orderapp.DeleteCartUseCase
+	PlaceUC      *orderapp.PlaceOrderUseCase
+	Logger       zerolog.Logger
+}
+
+func NewCartHandler(
+	getUC *orderapp.GetCartUseCase,
+	addItemUC *orderapp.AddCartItemUseCase,
+	updateItemUC *orderapp.UpdateCartItemUseCase,
+	deleteItemUC *orderapp.DeleteCartItemUseCase,
+	deleteUC *orderapp.DeleteCartUseCase,
+	placeUC *orderapp.PlaceOrderUseCase) *CartHandler {
+	return &CartHandler{
+		GetUC:        getUC,
+		AddItemUC:    addItemUC,
+		UpdateItemUC: updateItemUC,
+		DeleteItemUC: deleteItemUC,
+		DeleteUC:     deleteUC,
+		PlaceUC:      placeUC,
+		Logger:       logger.NewLogger().With().Str("component", "Cart handler").Logger(),
+	}
+}
+
+// GetCart godoc
+// @Summary Get cart
+// @Description Get the cart of the signed in customer at a restaurant. Prices are in VND
+// @Tags Order
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Success 200 {object} app.OrderSuccessResponseDoc "Get cart successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/cart [get]
+func (h *CartHandler) Get(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.GetUC.Execute(c.Request().Context(), userUUID, id)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to get cart")
+		return orderError(c, err)
+	}
+	return response.Success[orderapp.OrderResponse](c, resp, "Get cart successfully")
+}
+
+// AddCartItem godoc
+// @Summary Add cart item
+// @Description Add a menu item with the options picked from its groups to the cart of the signed in customer at an active restaurant, starting the cart when there is none. Prices are taken from the menu
+// @Tags Order
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param body body orderapp.CartItemRequest true "Cart item payload"
+// @Success 200 {object} app.OrderSuccessResponseDoc "Add cart item successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/cart/items [post]
+func (h *CartHandler) AddItem(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	var in orderapp.CartItemRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.AddItemUC.Execute(c.Request().Context(), in, userUUID, id)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to add cart item")
+		return orderError(c, err)
+	}
+	return response.Success[orderapp.OrderResponse](c, resp, "Add cart item successfully")
+}
+
+// UpdateCartItem godoc
+// @Summary Update cart item
+// @Description Replace a line of the cart of the signed in customer, priced again from the menu
+// @Tags Order
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param item_id path string true "Cart item ID"
+// @Param body body orderapp.CartItemRequest true "Cart item payload"
+// @Success 200 {object} app.OrderSuccessResponseDoc "Update cart item successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/cart/items/{item_id} [put]
+func (h *CartHandler) UpdateItem(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	itemID, ok := int64Param(c, "item_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid cart item id format")
+	}
+	var in orderapp.CartItemRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.UpdateItemUC.Execute(c.Request().Context(), in, userUUID, id, itemID)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to update cart item")
+		return orderError(c, err)
+	}
+	return response.Success[orderapp.OrderResponse](c, resp, "Update cart item successfully")
+}
+
+// DeleteCartItem godoc
+// @Summary Delete cart item
+// @Description Remove a line from the cart of the signed in customer
+// @Tags Order
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param item_id path string true "Cart item ID"
+// @Success 200 {object} app.OrderSuccessResponseDoc "Delete cart item successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/cart/items/{item_id} [delete]
+func (h *CartHandler) DeleteItem(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	itemID, ok := int64Param(c, "item_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid cart item id format")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.DeleteItemUC.Execute(c.Request().Context(), userUUID, id, itemID)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to delete cart item")
+		return orderError(c, err)
+	}
+	return response.Success[orderapp.OrderResponse](c, resp, "Delete cart item successfully")
+}
+
+// DeleteCart godoc
+// @Summary Delete cart
+// @Description Empty the cart of the signed in customer at a restaurant
+// @Tags Order
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Delete cart successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/cart [delete]
+func (h *CartHandler) Delete(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.DeleteUC.Execute(c.Request().Context(), userUUID, id); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to delete cart")
+		return orderError(c, err)
+	}
+	return response.Success[any](c, nil, "Delete cart successfully")
+}
+
+// PlaceOrder godoc
+// @Summary Place order
+// @Description Place the cart of the signed in customer as a dine-in or takeaway order while the restaurant is open. Every line is priced again from the current menu. A dine-in order from the QR code of a table passes the table_id and sig of its link
+// @Tags Order
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param body body orderapp.PlaceOrderRequest true "Place order payload"
+// @Success 200 {object} app.OrderSuccessResponseDoc "Place order successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/cart/place [post]
+func (h *CartHandler) Place(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	var in orderapp.PlaceOrderRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.PlaceUC.Execute(c.Request().Context(), in, userUUID, id)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to place order")
+		return orderError(c, err)
+	}
+	return response.Success[orderapp.OrderResponse](c, resp, "Place order successfully")
+}
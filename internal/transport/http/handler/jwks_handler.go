@@ -0,0 +1,31 @@
+package handler
+
+import (
+	uilts "go-ai/pkg/utils"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type JWKSHandler struct {
+	Keys *uilts.KeySet
+}
+
+func NewJWKSHandler(keys *uilts.KeySet) *JWKSHandler {
+	return &JWKSHandler{
+		Keys: keys,
+	}
+}
+
+// JWKS godoc
+// @Summary JSON Web Key Set
+// @Description Public keys that verify the access tokens issued by go-ai, in RFC 7517 form
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} utils.JWKS "Key set"
+// @Router /.well-known/jwks.json [get]
+func (h *JWKSHandler) JWKS(c echo.Context) error {
+	// verifiers cache the set; keep it short so a newly published key is picked up quickly
+	c.Response().Header().Set("Cache-Control", "public, max-age=300")
+	return c.JSON(http.StatusOK, h.Keys.JWKS())
+}
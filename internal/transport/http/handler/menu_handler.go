@@ -0,0 +1,430 @@
+package handler
+
+import (
+	menuapp "go-ai/internal/application/menu"
+	"go-ai/internal/domain/menu"
+	"go-ai/internal/domain/restaurant"
+	"go-ai/internal/transport/http/response"
+	"go-ai/pkg/logger"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+)
+
+type MenuHandler struct {
+	GetMenuUC             *menuapp.GetMenuUseCase
+	CreateCategoryUC      *menuapp.CreateCategoryUseCase
+	UpdateCategoryUC      *menuapp.UpdateCategoryUseCase
+	DeleteCategoryUC      *menuapp.DeleteCategoryUseCase
+	CreateItemUC          *menuapp.CreateItemUseCase
+	GetItemUC             *menuapp.GetItemUseCase
+	UpdateItemUC          *menuapp.UpdateItemUseCase
+	SetItemAvailabilityUC *menuapp.SetItemAvailabilityUseCase
+	DeleteItemUC          *menuapp.DeleteItemUseCase
+	Logger                zerolog.Logger
+}
+
+func NewMenuHandler(
+	getMenuUC *menuapp.GetMenuUseCase,
+	createCategoryUC *menuapp.CreateCategoryUseCase,
+	updateCategoryUC *menuapp.UpdateCategoryUseCase,
+	deleteCategoryUC *menuapp.DeleteCategoryUseCase,
+	createItemUC *menuapp.CreateItemUseCase,
+	getItemUC *menuapp.GetItemUseCase,
+	updateItemUC *menuapp.UpdateItemUseCase,
+	setItemAvailabilityUC *menuapp.SetItemAvailabilityUseCase,
+	deleteItemUC *menuapp.DeleteItemUseCase) *MenuHandler {
+	return &MenuHandler{
+		GetMenuUC:             getMenuUC,
+		CreateCategoryUC:      createCategoryUC,
+		UpdateCategoryUC:      updateCategoryUC,
+		DeleteCategoryUC:      deleteCategoryUC,
+		CreateItemUC:          createItemUC,
+		GetItemUC:             getItemUC,
+		UpdateItemUC:          updateItemUC,
+		SetItemAvailabilityUC: setItemAvailabilityUC,
+		DeleteItemUC:          deleteItemUC,
+		Logger:                logger.NewLogger().With().Str("component", "Menu handler").Logger(),
+	}
+}
+
+// int64Param reads a numeric path parameter such as a menu item id.
+func int64Param(c echo.Context, name string) (int64, bool) {
+	v, err := strconv.ParseInt(c.Param(name), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// GetMenu godoc
+// @Summary Get restaurant menu
+// @Description Get the menu of a restaurant: its categories in order with their items, then the items without a category. Prices are in VND. Only members see the inactive categories
+// @Tags Menu
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Success 200 {object} app.GetMenuSuccessResponseDoc "Get menu successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/menu [get]
+func (h *MenuHandler) GetMenu(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.GetMenuUC.Execute(c.Request().Context(), id, userUUID)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to get menu")
+		return h.error(c, err)
+	}
+	return response.Success[menuapp.MenuResponse](c, resp, "Get menu successfully")
+}
+
+// CreateMenuCategory godoc
+// @Summary Create menu category
+// @Description Add a category to the menu of a restaurant. Names are unique within the restaurant, ignoring case and accents
+// @Tags Menu
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param body body menuapp.CategoryRequest true "Category payload"
+// @Success 200 {object} app.CreateMenuCategorySuccessResponseDoc "Create category successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/menu/categories [post]
+func (h *MenuHandler) CreateCategory(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	var in menuapp.CategoryRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.CreateCategoryUC.Execute(c.Request().Context(), in, userUUID, id)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to create menu category")
+		return h.error(c, err)
+	}
+	return response.Success[menuapp.CreateCategoryResponse](c, resp, "Create category successfully")
+}
+
+// UpdateMenuCategory godoc
+// @Summary Update menu category
+// @Description Rename, reorder, hide or show a menu category
+// @Tags Menu
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param category_id path string true "Category ID"
+// @Param body body menuapp.CategoryRequest true "Category payload"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Update category successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/menu/categories/{category_id} [put]
+func (h *MenuHandler) UpdateCategory(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	categoryID, ok := int64Param(c, "category_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid category id format")
+	}
+	var in menuapp.CategoryRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.UpdateCategoryUC.Execute(c.Request().Context(), in, userUUID, id, categoryID); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to update menu category")
+		return h.error(c, err)
+	}
+	return response.Success[any](c, nil, "Update category successfully")
+}
+
+// DeleteMenuCategory godoc
+// @Summary Delete menu category
+// @Description Delete a menu category. Its items stay on the menu without a category
+// @Tags Menu
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param category_id path string true "Category ID"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Delete category successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/menu/categories/{category_id} [delete]
+func (h *MenuHandler) DeleteCategory(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	categoryID, ok := int64Param(c, "category_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid category id format")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.DeleteCategoryUC.Execute(c.Request().Context(), userUUID, id, categoryID); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to delete menu category")
+		return h.error(c, err)
+	}
+	return response.Success[any](c, nil, "Delete category successfully")
+}
+
+// CreateMenuItem godoc
+// @Summary Create menu item
+// @Description Add a dish, beverage, extra or combo to the menu of a restaurant. Price is in VND, image_url comes from /api/upload/image
+// @Tags Menu
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param body body menuapp.ItemRequest true "Menu item payload"
+// @Success 200 {object} app.CreateMenuItemSuccessResponseDoc "Create menu item successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/menu/items [post]
+func (h *MenuHandler) CreateItem(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	var in menuapp.ItemRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.CreateItemUC.Execute(c.Request().Context(), in, userUUID, id)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to create menu item")
+		return h.error(c, err)
+	}
+	return response.Success[menuapp.CreateItemResponse](c, resp, "Create menu item successfully")
+}
+
+// GetMenuItem godoc
+// @Summary Get menu item
+// @Description Get one item of the menu of a restaurant
+// @Tags Menu
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param item_id path string true "Menu item ID"
+// @Success 200 {object} app.GetMenuItemSuccessResponseDoc "Get menu item successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/menu/items/{item_id} [get]
+func (h *MenuHandler) GetItem(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	itemID, ok := int64Param(c, "item_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid menu item id format")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.GetItemUC.Execute(c.Request().Context(), userUUID, id, itemID)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to get menu item")
+		return h.error(c, err)
+	}
+	return response.Success[menuapp.ItemResponse](c, resp, "Get menu item successfully")
+}
+
+// UpdateMenuItem godoc
+// @Summary Update menu item
+// @Description Replace a menu item of a restaurant
+// @Tags Menu
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param item_id path string true "Menu item ID"
+// @Param body body menuapp.ItemRequest true "Menu item payload"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Update menu item successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/menu/items/{item_id} [put]
+func (h *MenuHandler) UpdateItem(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	itemID, ok := int64Param(c, "item_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid menu item id format")
+	}
+	var in menuapp.ItemRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.UpdateItemUC.Execute(c.Request().Context(), in, userUUID, id, itemID); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to update menu item")
+		return h.error(c, err)
+	}
+	return response.Success[any](c, nil, "Update menu item successfully")
+}
+
+// SetMenuItemAvailability godoc
+// @Summary Set menu item availability
+// @Description Mark a menu item sold out or available again. Any member of the restaurant may do it
+// @Tags Menu
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param item_id path string true "Menu item ID"
+// @Param body body menuapp.SetItemAvailabilityRequest true "Availability"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Update menu item successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/menu/items/{item_id}/availability [put]
+func (h *MenuHandler) SetItemAvailability(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	itemID, ok := int64Param(c, "item_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid menu item id format")
+	}
+	var in menuapp.SetItemAvailabilityRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.SetItemAvailabilityUC.Execute(c.Request().Context(), in, userUUID, id, itemID); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to set menu item availability")
+		return h.error(c, err)
+	}
+	return response.Success[any](c, nil, "Update menu item successfully")
+}
+
+// DeleteMenuItem godoc
+// @Summary Delete menu item
+// @Description Remove an item from the menu of a restaurant
+// @Tags Menu
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param item_id path string true "Menu item ID"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Delete menu item successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/menu/items/{item_id} [delete]
+func (h *MenuHandler) DeleteItem(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	itemID, ok := int64Param(c, "item_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid menu item id format")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.DeleteItemUC.Execute(c.Request().Context(), userUUID, id, itemID); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to delete menu item")
+		return h.error(c, err)
+	}
+	return response.Success[any](c, nil, "Delete menu item successfully")
+}
+
+func (h *MenuHandler) error(c echo.Context, err error) error {
+	return menuError(c, err)
+}
+
+// menuError maps the errors of the menu use cases, option groups included, to responses.
+func menuError(c echo.Context, err error) error {
+	switch err {
+	case menu.ErrInvalidCategoryName:
+		details := response.ErrorDetail{
+			Field:   "name",
+			Message: "name is required, at most 120 characters",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case menu.ErrInvalidItemName:
+		details := response.ErrorDetail{
+			Field:   "name",
+			Message: "name is required, at most 120 characters",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case menu.ErrInvalidItemType:
+		details := response.ErrorDetail{
+			Field:   "type",
+			Message: "type must be one of dish, extra, beverage, combo",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case menu.ErrInvalidPrice:
+		details := response.ErrorDetail{
+			Field:   "price",
+			Message: "price must be from 0 to 9999999999 VND",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case menu.ErrInvalidImage:
+		details := response.ErrorDetail{
+			Field:   "image_url",
+			Message: "image_url must be an http or https URL",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case menu.ErrInvalidOptionGroupName, menu.ErrInvalidOptionName:
+		details := response.ErrorDetail{
+			Field:   "name",
+			Message: "name is required, at most 120 characters",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case menu.ErrInvalidOptions:
+		details := response.ErrorDetail{
+			Field:   "options",
+			Message: "options must have between 1 and 50 entries",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case menu.ErrInvalidSelectRange:
+		details := response.ErrorDetail{
+			Field:   "max_select",
+			Message: "min_select must not exceed max_select nor what the options allow, max_select must be at least 1",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case menu.ErrInvalidOptionQuantity:
+		details := response.ErrorDetail{
+			Field:   "quantity",
+			Message: "quantity must be from 1 to the max_quantity of the option, at most 99",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case menu.ErrUnknownOption, menu.ErrDuplicateOption, menu.ErrTooFewOptions, menu.ErrTooManyOptions:
+		return response.Error(c, http.StatusBadRequest, err.Error())
+	case menu.ErrOptionUnavailable:
+		return response.Error(c, http.StatusConflict, err.Error())
+	case menu.ErrCategoryExists:
+		return response.Error(c, http.StatusConflict, err.Error())
+	case menu.ErrCategoryNotFound, menu.ErrItemNotFound, menu.ErrOptionGroupNotFound, restaurant.ErrRestaurantNoExitis:
+		return response.Error(c, http.StatusNotFound, err.Error())
+	case restaurant.ErrRestaurantForbidden:
+		return response.Error(c, http.StatusForbidden, err.Error())
+	default:
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+}
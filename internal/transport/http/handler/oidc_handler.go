@@ -0,0 +1,98 @@
+package handler
+
+import (
+	authapp "go-ai/internal/application/auth"
+	auth "go-ai/internal/domain/auth"
+	"go-ai/internal/transport/http/response"
+	"go-ai/internal/transport/http/status"
+	"go-ai/pkg/logger"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+)
+
+type OIDCHandler struct {
+	AuthorizeUC *authapp.OIDCAuthorizeUseCase
+	CallbackUC  *authapp.OIDCCallbackUseCase
+	Logger      zerolog.Logger
+}
+
+func NewOIDCHandler(
+	authorizeUC *authapp.OIDCAuthorizeUseCase,
+	callbackUC *authapp.OIDCCallbackUseCase) *OIDCHandler {
+	return &OIDCHandler{
+		AuthorizeUC: authorizeUC,
+		CallbackUC:  callbackUC,
+		Logger:      logger.NewLogger().With().Str("component", "OIDC handler").Logger(),
+	}
+}
+
+// Authorize godoc
+// @Summary Start social login
+// @Description Redirect the browser to the OpenID Connect provider to sign in
+// @Tags Auth
+// @Param provider path string true "Provider name, as configured in OIDC_PROVIDERS"
+// @Success 302 "Redirect to the provider"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/auth/oidc/{provider}/authorize [get]
+func (h *OIDCHandler) Authorize(c echo.Context) error {
+	authURL, err := h.AuthorizeUC.Execute(c.Request().Context(), c.Param("provider"))
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to start oidc login")
+		switch err {
+		case auth.ErrOIDCProviderNotFound:
+			return response.Error(c, http.StatusNotFound, err.Error())
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return c.Redirect(http.StatusFound, authURL)
+}
+
+// Callback godoc
+// @Summary Finish social login
+// @Description Exchange the authorization code returned by the provider for access and refresh tokens
+// @Tags Auth
+// @Produce json
+// @Param provider path string true "Provider name, as configured in OIDC_PROVIDERS"
+// @Param code query string true "Authorization code"
+// @Param state query string true "State from the authorization request"
+// @Success 200 {object} app.LoginSuccessResponseDoc "login success"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/auth/oidc/{provider}/callback [get]
+func (h *OIDCHandler) Callback(c echo.Context) error {
+	if providerErr := c.QueryParam("error"); providerErr != "" {
+		h.Logger.Warn().Str("error", providerErr).Str("description", c.QueryParam("error_description")).Msg("oidc provider returned an error")
+		return response.Error(c, http.StatusUnauthorized, auth.ErrOIDCLoginFailed.Error())
+	}
+	in := authapp.OIDCCallbackRequest{
+		Provider:  c.Param("provider"),
+		Code:      c.QueryParam("code"),
+		State:     c.QueryParam("state"),
+		UserAgent: c.Request().UserAgent(),
+		IpAddress: c.RealIP(),
+	}
+	responseData, err := h.CallbackUC.Execute(c.Request().Context(), in)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to finish oidc login")
+		switch err {
+		case auth.ErrOIDCProviderNotFound:
+			return response.Error(c, http.StatusNotFound, err.Error())
+		case auth.ErrOIDCStateInvalid, auth.ErrOIDCEmailRequired:
+			return response.Error(c, http.StatusBadRequest, err.Error())
+		case auth.ErrOIDCLoginFailed, status.ErrUserInactive:
+			return response.Error(c, http.StatusUnauthorized, err.Error())
+		case status.ErrEmailAlreadyExists, auth.ErrOIDCAccountUnverified:
+			return response.Error(c, http.StatusConflict, err.Error())
+		case auth.ErrEmailNotVerified:
+			return response.Error(c, http.StatusForbidden, err.Error())
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	if responseData.MfaRequired {
+		return response.Success[authapp.LoginResponse](c, responseData, "mfa required")
+	}
+	return response.Success[authapp.LoginResponse](c, responseData, "login success")
+}
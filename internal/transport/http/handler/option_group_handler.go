@@ -0,0 +1,239 @@
+package handler
+
+import (
+	menuapp "go-ai/internal/application/menu"
+	"go-ai/internal/transport/http/response"
+	"go-ai/pkg/logger"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+)
+
+type OptionGroupHandler struct {
+	ListOptionGroupsUC    *menuapp.ListOptionGroupsUseCase
+	CreateOptionGroupUC   *menuapp.CreateOptionGroupUseCase
+	UpdateOptionGroupUC   *menuapp.UpdateOptionGroupUseCase
+	DeleteOptionGroupUC   *menuapp.DeleteOptionGroupUseCase
+	SetItemOptionGroupsUC *menuapp.SetItemOptionGroupsUseCase
+	PriceItemUC           *menuapp.PriceItemUseCase
+	Logger                zerolog.Logger
+}
+
+func NewOptionGroupHandler(
+	listOptionGroupsUC *menuapp.ListOptionGroupsUseCase,
+	createOptionGroupUC *menuapp.CreateOptionGroupUseCase,
+	updateOptionGroupUC *menuapp.UpdateOptionGroupUseCase,
+	deleteOptionGroupUC *menuapp.DeleteOptionGroupUseCase,
+	setItemOptionGroupsUC *menuapp.SetItemOptionGroupsUseCase,
+	priceItemUC *menuapp.PriceItemUseCase) *OptionGroupHandler {
+	return &OptionGroupHandler{
+		ListOptionGroupsUC:    listOptionGroupsUC,
+		CreateOptionGroupUC:   createOptionGroupUC,
+		UpdateOptionGroupUC:   updateOptionGroupUC,
+		DeleteOptionGroupUC:   deleteOptionGroupUC,
+		SetItemOptionGroupsUC: setItemOptionGroupsUC,
+		PriceItemUC:           priceItemUC,
+		Logger:                logger.NewLogger().With().Str("component", "Option group handler").Logger(),
+	}
+}
+
+// ListOptionGroups godoc
+// @Summary List option groups
+// @Description List the option groups of a restaurant with their options, whether attached to an item or not
+// @Tags Menu
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Success 200 {object} app.ListOptionGroupsSuccessResponseDoc "List option groups successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/menu/option-groups [get]
+func (h *OptionGroupHandler) List(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.ListOptionGroupsUC.Execute(c.Request().Context(), userUUID, id)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to list option groups")
+		return menuError(c, err)
+	}
+	return response.Success[menuapp.ListOptionGroupsResponse](c, resp, "List option groups successfully")
+}
+
+// CreateOptionGroup godoc
+// @Summary Create option group
+// @Description Add a group of modifiers, such as sizes or toppings, to a restaurant. min_select and max_select bound the total quantity picked across the group, max_select null means no upper bound. Price deltas are in VND
+// @Tags Menu
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param body body menuapp.OptionGroupRequest true "Option group payload"
+// @Success 200 {object} app.CreateOptionGroupSuccessResponseDoc "Create option group successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/menu/option-groups [post]
+func (h *OptionGroupHandler) Create(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	var in menuapp.OptionGroupRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.CreateOptionGroupUC.Execute(c.Request().Context(), in, userUUID, id)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to create option group")
+		return menuError(c, err)
+	}
+	return response.Success[menuapp.CreateOptionGroupResponse](c, resp, "Create option group successfully")
+}
+
+// UpdateOptionGroup godoc
+// @Summary Update option group
+// @Description Replace an option group of a restaurant along with all of its options
+// @Tags Menu
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param group_id path string true "Option group ID"
+// @Param body body menuapp.OptionGroupRequest true "Option group payload"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Update option group successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/menu/option-groups/{group_id} [put]
+func (h *OptionGroupHandler) Update(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	groupID, ok := int64Param(c, "group_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid option group id format")
+	}
+	var in menuapp.OptionGroupRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.UpdateOptionGroupUC.Execute(c.Request().Context(), in, userUUID, id, groupID); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to update option group")
+		return menuError(c, err)
+	}
+	return response.Success[any](c, nil, "Update option group successfully")
+}
+
+// DeleteOptionGroup godoc
+// @Summary Delete option group
+// @Description Remove an option group of a restaurant, detaching it from every item
+// @Tags Menu
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param group_id path string true "Option group ID"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Delete option group successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/menu/option-groups/{group_id} [delete]
+func (h *OptionGroupHandler) Delete(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	groupID, ok := int64Param(c, "group_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid option group id format")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.DeleteOptionGroupUC.Execute(c.Request().Context(), userUUID, id, groupID); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to delete option group")
+		return menuError(c, err)
+	}
+	return response.Success[any](c, nil, "Delete option group successfully")
+}
+
+// SetMenuItemOptionGroups godoc
+// @Summary Set menu item option groups
+// @Description Attach option groups of the restaurant to a menu item in place of its current ones, in display order
+// @Tags Menu
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param item_id path string true "Menu item ID"
+// @Param body body menuapp.SetItemOptionGroupsRequest true "Option group ids"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Update menu item successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/menu/items/{item_id}/option-groups [put]
+func (h *OptionGroupHandler) SetItemOptionGroups(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	itemID, ok := int64Param(c, "item_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid menu item id format")
+	}
+	var in menuapp.SetItemOptionGroupsRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.SetItemOptionGroupsUC.Execute(c.Request().Context(), in, userUUID, id, itemID); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to set menu item option groups")
+		return menuError(c, err)
+	}
+	return response.Success[any](c, nil, "Update menu item successfully")
+}
+
+// PriceMenuItem godoc
+// @Summary Price menu item configuration
+// @Description Check the options picked for a menu item against the rules of its option groups and return the unit price in VND
+// @Tags Menu
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param item_id path string true "Menu item ID"
+// @Param body body menuapp.PriceItemRequest true "Options picked"
+// @Success 200 {object} app.PriceMenuItemSuccessResponseDoc "Price menu item successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/menu/items/{item_id}/price [post]
+func (h *OptionGroupHandler) PriceItem(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	itemID, ok := int64Param(c, "item_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid menu item id format")
+	}
+	var in menuapp.PriceItemRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.PriceItemUC.Execute(c.Request().Context(), in, userUUID, id, itemID)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to price menu item")
+		return menuError(c, err)
+	}
+	return response.Success[menuapp.PriceItemResponse](c, resp, "Price menu item successfully")
+}
@@ -0,0 +1,295 @@
+package handler
+
+import (
+	orderapp "go-ai/internal/application/order"
+	"go-ai/internal/domain/order"
+	"go-ai/internal/domain/table"
+	"go-ai/internal/transport/http/response"
+	"go-ai/pkg/logger"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+)
+
+type OrderHandler struct {
+	ListUC           *orderapp.ListOrdersUseCase
+	GetUC            *orderapp.GetOrderUseCase
+	CancelUC         *orderapp.CancelOrderUseCase
+	ListRestaurantUC *orderapp.ListRestaurantOrdersUseCase
+	UpdateStatusUC   *orderapp.UpdateOrderStatusUseCase
+	Logger           zerolog.Logger
+}
+
+func NewOrderHandler(
+	listUC *orderapp.ListOrdersUseCase,
+	getUC *orderapp.GetOrderUseCase,
+	cancelUC *orderapp.CancelOrderUseCase,
+	listRestaurantUC *orderapp.ListRestaurantOrdersUseCase,
+	updateStatusUC *orderapp.UpdateOrderStatusUseCase) *OrderHandler {
+	return &OrderHandler{
+		ListUC:           listUC,
+		GetUC:            getUC,
+		CancelUC:         cancelUC,
+		ListRestaurantUC: listRestaurantUC,
+		UpdateStatusUC:   updateStatusUC,
+		Logger:           logger.NewLogger().With().Str("component", "Order handler").Logger(),
+	}
+}
+
+// ListOrders godoc
+// @Summary List my orders
+// @Description List the orders the signed in customer placed, at any restaurant, newest first
+// @Tags Order
+// @Accept json
+// @Produce json
+// @Param status query string false "placed, accepted, preparing, ready, served, picked_up, completed or cancelled; every status when omitted"
+// @Param cursor query string false "Cursor from the previous page"
+// @Param limit query int false "Orders per page, at most 100"
+// @Success 200 {object} app.ListOrdersSuccessResponseDoc "List orders successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/orders [get]
+func (h *OrderHandler) List(c echo.Context) error {
+	in, ok := listOrdersRequest(c)
+	if !ok {
+		details := response.ErrorDetail{
+			Field:   "limit",
+			Message: "limit must be a number",
+		}
+		return response.Error(c, http.StatusBadRequest, "Invalid query parameter", details)
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.ListUC.Execute(c.Request().Context(), in, userUUID)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to list orders")
+		return orderError(c, err)
+	}
+	meta := &response.PageMeta{
+		Limit:      resp.Limit,
+		NextCursor: resp.NextCursor,
+		HasMore:    resp.HasMore,
+	}
+	return response.Paginated[orderapp.ListOrdersResponse](c, resp, meta, "List orders successfully")
+}
+
+// GetOrder godoc
+// @Summary Get order
+// @Description Get an order placed by the signed in customer, or placed at a restaurant the user is a member of
+// @Tags Order
+// @Accept json
+// @Produce json
+// @Param order_id path string true "Order ID"
+// @Success 200 {object} app.OrderSuccessResponseDoc "Get order successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/orders/{order_id} [get]
+func (h *OrderHandler) Get(c echo.Context) error {
+	id, ok := int64Param(c, "order_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid order id format")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.GetUC.Execute(c.Request().Context(), userUUID, id)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to get order")
+		return orderError(c, err)
+	}
+	return response.Success[orderapp.OrderResponse](c, resp, "Get order successfully")
+}
+
+// CancelOrder godoc
+// @Summary Cancel order
+// @Description Take back an order of the signed in customer, until the restaurant accepts it
+// @Tags Order
+// @Accept json
+// @Produce json
+// @Param order_id path string true "Order ID"
+// @Param body body orderapp.CancelOrderRequest false "Cancellation reason"
+// @Success 200 {object} app.OrderSuccessResponseDoc "Cancel order successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/orders/{order_id}/cancel [post]
+func (h *OrderHandler) Cancel(c echo.Context) error {
+	id, ok := int64Param(c, "order_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid order id format")
+	}
+	var in orderapp.CancelOrderRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.CancelUC.Execute(c.Request().Context(), in, userUUID, id)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to cancel order")
+		return orderError(c, err)
+	}
+	return response.Success[orderapp.OrderResponse](c, resp, "Cancel order successfully")
+}
+
+// ListRestaurantOrders godoc
+// @Summary List restaurant orders
+// @Description List the orders placed at a restaurant, newest first, for its members. Pass status=placed for the orders waiting to be accepted
+// @Tags Order
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param status query string false "placed, accepted, preparing, ready, served, picked_up, completed or cancelled; every status when omitted"
+// @Param cursor query string false "Cursor from the previous page"
+// @Param limit query int false "Orders per page, at most 100"
+// @Success 200 {object} app.ListOrdersSuccessResponseDoc "List orders successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/orders [get]
+func (h *OrderHandler) ListRestaurant(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	in, ok := listOrdersRequest(c)
+	if !ok {
+		details := response.ErrorDetail{
+			Field:   "limit",
+			Message: "limit must be a number",
+		}
+		return response.Error(c, http.StatusBadRequest, "Invalid query parameter", details)
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.ListRestaurantUC.Execute(c.Request().Context(), in, userUUID, id)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to list restaurant orders")
+		return orderError(c, err)
+	}
+	meta := &response.PageMeta{
+		Limit:      resp.Limit,
+		NextCursor: resp.NextCursor,
+		HasMore:    resp.HasMore,
+	}
+	return response.Paginated[orderapp.ListOrdersResponse](c, resp, meta, "List orders successfully")
+}
+
+// UpdateOrderStatus godoc
+// @Summary Update order status
+// @Description Move an order of a restaurant along placed, accepted, preparing, ready, then served for dine-in or picked_up for takeaway, then completed. Orders are cancelled up to preparing, with an optional reason shown to the customer
+// @Tags Order
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param order_id path string true "Order ID"
+// @Param body body orderapp.UpdateOrderStatusRequest true "New status"
+// @Success 200 {object} app.OrderSuccessResponseDoc "Update order status successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/orders/{order_id}/status [put]
+func (h *OrderHandler) UpdateStatus(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	orderID, ok := int64Param(c, "order_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid order id format")
+	}
+	var in orderapp.UpdateOrderStatusRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.UpdateStatusUC.Execute(c.Request().Context(), in, userUUID, id, orderID)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to update order status")
+		return orderError(c, err)
+	}
+	return response.Success[orderapp.OrderResponse](c, resp, "Update order status successfully")
+}
+
+// listOrdersRequest reads the query of an order list; it fails on a limit that is not a number.
+func listOrdersRequest(c echo.Context) (orderapp.ListOrdersRequest, bool) {
+	in := orderapp.ListOrdersRequest{
+		Status: c.QueryParam("status"),
+		Cursor: c.QueryParam("cursor"),
+	}
+	if v := c.QueryParam("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return in, false
+		}
+		in.Limit = limit
+	}
+	return in, true
+}
+
+// orderError maps the errors of the cart and order use cases, the menu ones included.
+func orderError(c echo.Context, err error) error {
+	switch err {
+	case order.ErrInvalidQuantity:
+		details := response.ErrorDetail{
+			Field:   "quantity",
+			Message: "quantity must be from 1 to 99",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case order.ErrInvalidNote:
+		details := response.ErrorDetail{
+			Field:   "note",
+			Message: "note must be at most 200 characters",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case order.ErrInvalidReason:
+		details := response.ErrorDetail{
+			Field:   "reason",
+			Message: "reason must be at most 200 characters",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case order.ErrTooManyItems:
+		details := response.ErrorDetail{
+			Field:   "menu_item_id",
+			Message: "a cart holds at most 50 items",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case order.ErrInvalidOrderType:
+		details := response.ErrorDetail{
+			Field:   "type",
+			Message: "type must be dine_in or takeaway",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case order.ErrTableNotAllowed:
+		details := response.ErrorDetail{
+			Field:   "table_id",
+			Message: "table_id is only set on dine_in orders",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case order.ErrInvalidStatus:
+		details := response.ErrorDetail{
+			Field:   "status",
+			Message: "status must be one of placed, accepted, preparing, ready, served, picked_up, completed, cancelled",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case order.ErrInvalidCursor:
+		details := response.ErrorDetail{
+			Field:   "cursor",
+			Message: "cursor is invalid",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case order.ErrEmptyCart, table.ErrInvalidTableLink:
+		return response.Error(c, http.StatusBadRequest, err.Error())
+	case order.ErrItemUnavailable, order.ErrInvalidStatusTransition, order.ErrOrderChanged, order.ErrRestaurantClosed:
+		return response.Error(c, http.StatusConflict, err.Error())
+	case order.ErrOrderNotFound, order.ErrCartNotFound, order.ErrCartItemNotFound:
+		return response.Error(c, http.StatusNotFound, err.Error())
+	default:
+		return menuError(c, err)
+	}
+}
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	menuapp "go-ai/internal/application/menu"
+	restaurantapp "go-ai/internal/application/restaurant"
+	"go-ai/internal/domain/restaurant"
+	"go-ai/internal/domain/table"
+	"go-ai/internal/transport/http/response"
+	"go-ai/pkg/logger"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+)
+
+// publicMaxAge is how long shared caches may serve a public page before revalidating it.
+const publicMaxAge = time.Minute
+
+// PublicHandler serves the pages of active restaurants to anyone, signed in or not.
+type PublicHandler struct {
+	GetRestaurantUC *restaurantapp.GetPublicUseCase
+	GetMenuUC       *menuapp.GetPublicMenuUseCase
+	Logger          zerolog.Logger
+}
+
+func NewPublicHandler(
+	getRestaurantUC *restaurantapp.GetPublicUseCase,
+	getMenuUC *menuapp.GetPublicMenuUseCase) *PublicHandler {
+	return &PublicHandler{
+		GetRestaurantUC: getRestaurantUC,
+		GetMenuUC:       getMenuUC,
+		Logger:          logger.NewLogger().With().Str("component", "Public handler").Logger(),
+	}
+}
+
+// GetPublicRestaurant godoc
+// @Summary Get public restaurant page
+// @Description Get the profile, hours and upcoming special hours of an active restaurant, no sign in needed. The slug ends with the restaurant id; a stale slug is redirected to the current one. Supports If-None-Match and If-Modified-Since
+// @Tags Public
+// @Accept json
+// @Produce json
+// @Param slug path string true "Restaurant slug, such as com-tam-ba-ghien-42"
+// @Success 200 {object} app.GetPublicRestaurantSuccessResponseDoc "Get restaurant successfully"
+// @Success 301 "Moved to the current slug"
+// @Success 304 "Not modified"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/public/restaurants/{slug} [get]
+func (h *PublicHandler) GetRestaurant(c echo.Context) error {
+	slug := c.Param("slug")
+	resp, err := h.GetRestaurantUC.Execute(c.Request().Context(), slug)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to get public restaurant")
+		return h.error(c, err)
+	}
+	if resp.Slug != slug {
+		return c.Redirect(http.StatusMovedPermanently, withQuery("/api/public/restaurants/"+resp.Slug, c.QueryString()))
+	}
+	return response.Cacheable[restaurantapp.PublicRestaurantResponse](c, resp, "Get restaurant successfully", resp.LastModified, publicMaxAge)
+}
+
+// GetPublicMenu godoc
+// @Summary Get public restaurant menu
+// @Description Get the menu of an active restaurant as diners see it, no sign in needed. Prices are in VND. The table and sig of a table QR code select that table. A stale slug is redirected to the current one. Supports If-None-Match and If-Modified-Since
+// @Tags Public
+// @Accept json
+// @Produce json
+// @Param slug path string true "Restaurant slug, such as com-tam-ba-ghien-42"
+// @Param table query int false "Table id, from the QR code of a table"
+// @Param sig query string false "Signature of the table link, from the QR code of a table"
+// @Success 200 {object} app.GetPublicMenuSuccessResponseDoc "Get menu successfully"
+// @Success 301 "Moved to the current slug"
+// @Success 304 "Not modified"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/public/restaurants/{slug}/menu [get]
+func (h *PublicHandler) GetMenu(c echo.Context) error {
+	slug := c.Param("slug")
+	var tableID int32
+	if v := c.QueryParam("table"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || id <= 0 {
+			return h.error(c, table.ErrInvalidTableLink)
+		}
+		tableID = int32(id)
+	}
+	resp, err := h.GetMenuUC.Execute(c.Request().Context(), slug, tableID, c.QueryParam("sig"))
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to get public menu")
+		return h.error(c, err)
+	}
+	if resp.Slug != slug {
+		// printed QR codes carry the slug of their time, keep their table
+		return c.Redirect(http.StatusMovedPermanently, withQuery("/api/public/restaurants/"+resp.Slug+"/menu", c.QueryString()))
+	}
+	return response.Cacheable[menuapp.PublicMenuResponse](c, resp, "Get menu successfully", resp.LastModified, publicMaxAge)
+}
+
+func (h *PublicHandler) error(c echo.Context, err error) error {
+	switch err {
+	case restaurant.ErrRestaurantNoExitis:
+		return response.Error(c, http.StatusNotFound, err.Error())
+	case table.ErrInvalidTableLink:
+		return response.Error(c, http.StatusBadRequest, err.Error())
+	default:
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+}
+
+func withQuery(path, query string) string {
+	if query == "" {
+		return path
+	}
+	return path + "?" + query
+}
@@ -16,24 +16,42 @@ import (
 )
 
 type RestaurantHandler struct {
-	CreateUC  *restaurantapp.CreateRestaurantUseCase
-	GetByIdUC *restaurantapp.GetByIDUseCase
-	UpdateUC  *restaurantapp.UpdateRestaurantUseCase
-	DeleteUC  *restaurantapp.DeleteUseCase
-	Logger    zerolog.Logger
+	CreateUC       *restaurantapp.CreateRestaurantUseCase
+	GetByIdUC      *restaurantapp.GetByIDUseCase
+	ListUC         *restaurantapp.ListRestaurantsUseCase
+	SearchUC       *restaurantapp.SearchRestaurantsUseCase
+	NearbyUC       *restaurantapp.NearbyRestaurantsUseCase
+	UpdateUC       *restaurantapp.UpdateRestaurantUseCase
+	DeleteUC       *restaurantapp.DeleteUseCase
+	AddMemberUC    *restaurantapp.AddMemberUseCase
+	ListMembersUC  *restaurantapp.ListMembersUseCase
+	RemoveMemberUC *restaurantapp.RemoveMemberUseCase
+	Logger         zerolog.Logger
 }
 
 func NewRestaurantHandler(
 	createUC *restaurantapp.CreateRestaurantUseCase,
 	getByIDUC *restaurantapp.GetByIDUseCase,
+	listUC *restaurantapp.ListRestaurantsUseCase,
+	searchUC *restaurantapp.SearchRestaurantsUseCase,
+	nearbyUC *restaurantapp.NearbyRestaurantsUseCase,
 	updateUC *restaurantapp.UpdateRestaurantUseCase,
-	deleteUC *restaurantapp.DeleteUseCase) *RestaurantHandler {
+	deleteUC *restaurantapp.DeleteUseCase,
+	addMemberUC *restaurantapp.AddMemberUseCase,
+	listMembersUC *restaurantapp.ListMembersUseCase,
+	removeMemberUC *restaurantapp.RemoveMemberUseCase) *RestaurantHandler {
 	return &RestaurantHandler{
-		CreateUC:  createUC,
-		GetByIdUC: getByIDUC,
-		UpdateUC:  updateUC,
-		DeleteUC:  deleteUC,
-		Logger:    logger.NewLogger().With().Str("component", "Restaurant handler").Logger(),
+		CreateUC:       createUC,
+		GetByIdUC:      getByIDUC,
+		ListUC:         listUC,
+		SearchUC:       searchUC,
+		NearbyUC:       nearbyUC,
+		UpdateUC:       updateUC,
+		DeleteUC:       deleteUC,
+		AddMemberUC:    addMemberUC,
+		ListMembersUC:  listMembersUC,
+		RemoveMemberUC: removeMemberUC,
+		Logger:         logger.NewLogger().With().Str("component", "Restaurant handler").Logger(),
 	}
 }
 
@@ -102,6 +120,24 @@ func (h *RestaurantHandler) Create(c echo.Context) error {
 				Message: "Phone number is a required field",
 			}
 			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		case restaurant.ErrInvalidLocation:
+			details = response.ErrorDetail{
+				Field:   "latitude",
+				Message: "Latitude and longitude must be set together and be valid coordinates",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		case restaurant.ErrInvalidTimezone:
+			details = response.ErrorDetail{
+				Field:   "timezone",
+				Message: "Timezone must be an IANA name such as Asia/Ho_Chi_Minh",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		case restaurant.ErrInvalidHours, restaurant.ErrOverlappingHours:
+			details = response.ErrorDetail{
+				Field:   "hours",
+				Message: "Each shift needs a day from 0 to 6 and HH:MM times, shifts may not overlap",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
 		case restaurant.ErrRestaurantNameExitis:
 			return response.Error(c, http.StatusBadRequest, restaurant.ErrRestaurantNameExitis.Error())
 		default:
@@ -115,7 +151,7 @@ func (h *RestaurantHandler) Create(c echo.Context) error {
 
 // GetRestaurant godoc
 // @Summary Get restaurant by ID
-// @Description Get detailed information of a restaurant using its ID
+// @Description Get detailed information of a restaurant using its ID, with whether it is open now by its hours and timezone. Restaurants that are not active are only found by their members
 // @Tags Restaurant
 // @Accept json
 // @Produce json
@@ -124,6 +160,24 @@ func (h *RestaurantHandler) Create(c echo.Context) error {
 // @Failure default {object} app.ErrorResponseDoc "Errors"
 // @Router /api/restaurant/{id} [get]
 func (h *RestaurantHandler) GetByID(c echo.Context) error {
+	return h.getByID(c, false)
+}
+
+// ReviewGetRestaurant godoc
+// @Summary Get restaurant by ID for review
+// @Description Get detailed information of a restaurant in any status
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Success 200 {object} app.GetRestaurantByIDSuccessResponseDoc "Get restaurant successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/admin/restaurants/{id} [get]
+func (h *RestaurantHandler) ReviewGetByID(c echo.Context) error {
+	return h.getByID(c, true)
+}
+
+func (h *RestaurantHandler) getByID(c echo.Context, reviewer bool) error {
 	id := c.Param("id")
 	if id == "" {
 		return response.Error(c, http.StatusBadRequest, "missing restaurant id")
@@ -135,11 +189,306 @@ func (h *RestaurantHandler) GetByID(c echo.Context) error {
 	if idInt > math.MaxInt32 || idInt < math.MinInt32 {
 		return response.Error(c, http.StatusBadRequest, "restaurant id out of int32 range")
 	}
-	restaurant, err := h.GetByIdUC.Execute(c.Request().Context(), int32(idInt))
-	if restaurant == nil {
-		return response.Error(c, http.StatusNotFound, "restaurant not found")
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	viewer := restaurantapp.Viewer{UserID: userUUID, Reviewer: reviewer}
+	record, err := h.GetByIdUC.Execute(c.Request().Context(), int32(idInt), viewer)
+	if err != nil {
+		switch err {
+		case restaurant.ErrRestaurantNoExitis:
+			return response.Error(c, http.StatusNotFound, "restaurant not found")
+		case status.ErrInvalidField:
+			return response.Error(c, http.StatusBadRequest, err.Error())
+		default:
+			h.Logger.Error().Err(err).Msg("failed to get restaurant")
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
 	}
-	return response.Success[restaurantapp.GetRestaurantByIDResponse](c, restaurant, "Get restaurant successfully")
+	return response.Success[restaurantapp.GetRestaurantByIDResponse](c, record, "Get restaurant successfully")
+}
+
+// ListRestaurants godoc
+// @Summary List restaurants
+// @Description List active restaurants page by page with filters and sorting. Pass meta.next_cursor as cursor to get the next page. Your own restaurants in any status are listed with owner_id set to your user ID
+// @Tags Restaurant
+// @Accept json
+// @Produce json
+// @Param category query string false "Category"
+// @Param city query string false "City"
+// @Param district query string false "District"
+// @Param owner_id query string false "User ID of the owner"
+// @Param status query string false "draft, pending_review, active, suspended or closed; other than active only with owner_id of yourself"
+// @Param open_now query bool false "Only restaurants open at the moment"
+// @Param sort query string false "name, -name, created_at or -created_at (default)"
+// @Param cursor query string false "Cursor from the previous page"
+// @Param limit query int false "Restaurants per page, at most 100"
+// @Success 200 {object} app.ListRestaurantsSuccessResponseDoc "List restaurants successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant [get]
+func (h *RestaurantHandler) List(c echo.Context) error {
+	return h.list(c, false)
+}
+
+// ReviewListRestaurants godoc
+// @Summary List restaurants for review
+// @Description List restaurants in every status page by page, with the same filters as the public list. Pass status=pending_review for the review queue
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param status query string false "draft, pending_review, active, suspended or closed; every status when omitted"
+// @Param category query string false "Category"
+// @Param city query string false "City"
+// @Param district query string false "District"
+// @Param owner_id query string false "User ID of the owner"
+// @Param open_now query bool false "Only restaurants open at the moment"
+// @Param sort query string false "name, -name, created_at or -created_at (default)"
+// @Param cursor query string false "Cursor from the previous page"
+// @Param limit query int false "Restaurants per page, at most 100"
+// @Success 200 {object} app.ListRestaurantsSuccessResponseDoc "List restaurants successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/admin/restaurants [get]
+func (h *RestaurantHandler) ReviewList(c echo.Context) error {
+	return h.list(c, true)
+}
+
+func (h *RestaurantHandler) list(c echo.Context, reviewer bool) error {
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	in := restaurantapp.ListRestaurantsRequest{
+		Viewer:   restaurantapp.Viewer{UserID: userUUID, Reviewer: reviewer},
+		Status:   c.QueryParam("status"),
+		Category: c.QueryParam("category"),
+		City:     c.QueryParam("city"),
+		District: c.QueryParam("district"),
+		Sort:     c.QueryParam("sort"),
+		Cursor:   c.QueryParam("cursor"),
+	}
+	if v := c.QueryParam("owner_id"); v != "" {
+		ownerId, err := uuid.Parse(v)
+		if err != nil {
+			details := response.ErrorDetail{
+				Field:   "owner_id",
+				Message: "owner_id must be a user ID",
+			}
+			return response.Error(c, http.StatusBadRequest, "Invalid query parameter", details)
+		}
+		in.OwnerId = &ownerId
+	}
+	if v := c.QueryParam("open_now"); v != "" {
+		openNow, err := strconv.ParseBool(v)
+		if err != nil {
+			details := response.ErrorDetail{
+				Field:   "open_now",
+				Message: "open_now must be true or false",
+			}
+			return response.Error(c, http.StatusBadRequest, "Invalid query parameter", details)
+		}
+		in.OpenNow = openNow
+	}
+	if v := c.QueryParam("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			details := response.ErrorDetail{
+				Field:   "limit",
+				Message: "limit must be a number",
+			}
+			return response.Error(c, http.StatusBadRequest, "Invalid query parameter", details)
+		}
+		in.Limit = limit
+	}
+	resp, err := h.ListUC.Execute(c.Request().Context(), in)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to list restaurants")
+		switch err {
+		case restaurant.ErrInvalidSort:
+			details := response.ErrorDetail{
+				Field:   "sort",
+				Message: "sort must be one of name, -name, created_at, -created_at",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		case restaurant.ErrInvalidCursor:
+			details := response.ErrorDetail{
+				Field:   "cursor",
+				Message: "cursor is invalid or was made for another sort",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		case restaurant.ErrInvalidStatus:
+			details := response.ErrorDetail{
+				Field:   "status",
+				Message: "status must be one of draft, pending_review, active, suspended, closed",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		case restaurant.ErrRestaurantForbidden:
+			return response.Error(c, http.StatusForbidden, err.Error())
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	meta := &response.PageMeta{
+		Limit:      resp.Limit,
+		NextCursor: resp.NextCursor,
+		HasMore:    resp.HasMore,
+	}
+	return response.Paginated[restaurantapp.ListRestaurantsResponse](c, resp, meta, "List restaurants successfully")
+}
+
+// SearchRestaurants godoc
+// @Summary Search restaurants
+// @Description Full-text search over name, description, category, address, district and city. Words match by prefix and without diacritics, so "pho" finds "Phở". Pass meta.next_cursor as cursor to get the next page
+// @Tags Restaurant
+// @Accept json
+// @Produce json
+// @Param q query string true "Search text"
+// @Param category query string false "Category"
+// @Param city query string false "City"
+// @Param cursor query string false "Cursor from the previous page"
+// @Param limit query int false "Results per page, at most 100"
+// @Success 200 {object} app.SearchRestaurantsSuccessResponseDoc "Search restaurants successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/search [get]
+func (h *RestaurantHandler) Search(c echo.Context) error {
+	in := restaurantapp.SearchRestaurantsRequest{
+		Query:    c.QueryParam("q"),
+		Category: c.QueryParam("category"),
+		City:     c.QueryParam("city"),
+		Cursor:   c.QueryParam("cursor"),
+	}
+	if v := c.QueryParam("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			details := response.ErrorDetail{
+				Field:   "limit",
+				Message: "limit must be a number",
+			}
+			return response.Error(c, http.StatusBadRequest, "Invalid query parameter", details)
+		}
+		in.Limit = limit
+	}
+	resp, err := h.SearchUC.Execute(c.Request().Context(), in)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to search restaurants")
+		switch err {
+		case restaurant.ErrInvalidSearchQuery:
+			details := response.ErrorDetail{
+				Field:   "q",
+				Message: err.Error(),
+			}
+			return response.Error(c, http.StatusBadRequest, "Invalid query parameter", details)
+		case restaurant.ErrInvalidCursor:
+			details := response.ErrorDetail{
+				Field:   "cursor",
+				Message: "cursor is invalid",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	meta := &response.PageMeta{
+		Limit:      resp.Limit,
+		NextCursor: resp.NextCursor,
+		HasMore:    resp.HasMore,
+	}
+	return response.Paginated[restaurantapp.SearchRestaurantsResponse](c, resp, meta, "Search restaurants successfully")
+}
+
+// NearbyRestaurants godoc
+// @Summary Restaurants near a location
+// @Description List the restaurants within radius_m meters of a point, nearest first, with their distance in meters. Restaurants without a location are not listed
+// @Tags Restaurant
+// @Accept json
+// @Produce json
+// @Param lat query number true "Latitude"
+// @Param lng query number true "Longitude"
+// @Param radius_m query int false "Radius in meters, 5000 by default and at most 50000"
+// @Param category query string false "Category"
+// @Param open_now query bool false "Only restaurants open now"
+// @Param limit query int false "Results, at most 100"
+// @Success 200 {object} app.NearbyRestaurantsSuccessResponseDoc "List nearby restaurants successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/nearby [get]
+func (h *RestaurantHandler) Nearby(c echo.Context) error {
+	in := restaurantapp.NearbyRestaurantsRequest{
+		Category: c.QueryParam("category"),
+	}
+	if v := c.QueryParam("lat"); v != "" {
+		lat, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			details := response.ErrorDetail{
+				Field:   "lat",
+				Message: "lat must be a number",
+			}
+			return response.Error(c, http.StatusBadRequest, "Invalid query parameter", details)
+		}
+		in.Latitude = &lat
+	}
+	if v := c.QueryParam("lng"); v != "" {
+		lng, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			details := response.ErrorDetail{
+				Field:   "lng",
+				Message: "lng must be a number",
+			}
+			return response.Error(c, http.StatusBadRequest, "Invalid query parameter", details)
+		}
+		in.Longitude = &lng
+	}
+	if v := c.QueryParam("radius_m"); v != "" {
+		radius, err := strconv.Atoi(v)
+		if err != nil {
+			details := response.ErrorDetail{
+				Field:   "radius_m",
+				Message: "radius_m must be a number",
+			}
+			return response.Error(c, http.StatusBadRequest, "Invalid query parameter", details)
+		}
+		in.RadiusMeters = radius
+	}
+	if v := c.QueryParam("open_now"); v != "" {
+		openNow, err := strconv.ParseBool(v)
+		if err != nil {
+			details := response.ErrorDetail{
+				Field:   "open_now",
+				Message: "open_now must be true or false",
+			}
+			return response.Error(c, http.StatusBadRequest, "Invalid query parameter", details)
+		}
+		in.OpenNow = openNow
+	}
+	if v := c.QueryParam("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			details := response.ErrorDetail{
+				Field:   "limit",
+				Message: "limit must be a number",
+			}
+			return response.Error(c, http.StatusBadRequest, "Invalid query parameter", details)
+		}
+		in.Limit = limit
+	}
+	resp, err := h.NearbyUC.Execute(c.Request().Context(), in)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to list nearby restaurants")
+		switch err {
+		case restaurant.ErrInvalidLocation:
+			details := response.ErrorDetail{
+				Field:   "lat",
+				Message: "lat and lng are required and must be valid coordinates",
+			}
+			return response.Error(c, http.StatusBadRequest, "Invalid query parameter", details)
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	meta := &response.PageMeta{
+		Limit:   resp.Limit,
+		HasMore: resp.HasMore,
+	}
+	return response.Paginated[restaurantapp.NearbyRestaurantsResponse](c, resp, meta, "List nearby restaurants successfully")
 }
 
 // UpdateRestaurant godoc
@@ -219,8 +568,28 @@ func (h *RestaurantHandler) Update(c echo.Context) error {
 				Message: "Phone number is a required field",
 			}
 			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		case restaurant.ErrInvalidLocation:
+			details = response.ErrorDetail{
+				Field:   "latitude",
+				Message: "Latitude and longitude must be set together and be valid coordinates",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		case restaurant.ErrInvalidTimezone:
+			details = response.ErrorDetail{
+				Field:   "timezone",
+				Message: "Timezone must be an IANA name such as Asia/Ho_Chi_Minh",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		case restaurant.ErrInvalidHours, restaurant.ErrOverlappingHours:
+			details = response.ErrorDetail{
+				Field:   "hours",
+				Message: "Each shift needs a day from 0 to 6 and HH:MM times, shifts may not overlap",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
 		case restaurant.ErrRestaurantNoExitis:
-			return response.Error(c, http.StatusBadRequest, restaurant.ErrRestaurantNoExitis.Error())
+			return response.Error(c, http.StatusNotFound, err.Error())
+		case restaurant.ErrRestaurantForbidden:
+			return response.Error(c, http.StatusForbidden, err.Error())
 		default:
 			return response.Error(c, http.StatusInternalServerError, "Internal server error")
 		}
@@ -250,9 +619,166 @@ func (h *RestaurantHandler) Delete(c echo.Context) error {
 	if idInt > math.MaxInt32 || idInt < math.MinInt32 {
 		return response.Error(c, http.StatusBadRequest, "restaurant id out of int32 range")
 	}
-	err = h.DeleteUC.Execute(c.Request().Context(), int32(idInt))
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	err = h.DeleteUC.Execute(c.Request().Context(), int32(idInt), userUUID)
 	if err != nil {
-		return response.Error(c, http.StatusInternalServerError, err.Error())
+		h.Logger.Error().Err(err).Msg("failed delete restaurant")
+		switch err {
+		case restaurant.ErrRestaurantNoExitis:
+			return response.Error(c, http.StatusNotFound, err.Error())
+		case restaurant.ErrRestaurantForbidden:
+			return response.Error(c, http.StatusForbidden, err.Error())
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
 	}
 	return response.Success[any](c, nil, "Restaurant deleted successfully")
 }
+
+// ListMembers godoc
+// @Summary List restaurant members
+// @Description List the owner, managers and staff of a restaurant
+// @Tags Restaurant
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Success 200 {object} app.ListMembersSuccessResponseDoc "Get members successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/members [get]
+func (h *RestaurantHandler) ListMembers(c echo.Context) error {
+	id := c.Param("id")
+	if id == "" {
+		return response.Error(c, http.StatusBadRequest, "missing restaurant id")
+	}
+	idInt, err := strconv.Atoi(id)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	if idInt > math.MaxInt32 || idInt < math.MinInt32 {
+		return response.Error(c, http.StatusBadRequest, "restaurant id out of int32 range")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	members, err := h.ListMembersUC.Execute(c.Request().Context(), userUUID, int32(idInt))
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed list restaurant members")
+		switch err {
+		case restaurant.ErrRestaurantForbidden:
+			return response.Error(c, http.StatusForbidden, err.Error())
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[restaurantapp.ListMembersResponse](c, members, "Get members successfully")
+}
+
+// AddMember godoc
+// @Summary Add restaurant member
+// @Description Add a manager or staff member to a restaurant, or change the role of an existing member
+// @Tags Restaurant
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param body body restaurantapp.AddMemberRequest true "Member payload"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Add member successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/members [post]
+func (h *RestaurantHandler) AddMember(c echo.Context) error {
+	id := c.Param("id")
+	if id == "" {
+		return response.Error(c, http.StatusBadRequest, "missing restaurant id")
+	}
+	idInt, err := strconv.Atoi(id)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	if idInt > math.MaxInt32 || idInt < math.MinInt32 {
+		return response.Error(c, http.StatusBadRequest, "restaurant id out of int32 range")
+	}
+	var in restaurantapp.AddMemberRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.AddMemberUC.Execute(c.Request().Context(), in, userUUID, int32(idInt)); err != nil {
+		h.Logger.Error().Err(err).Msg("failed add restaurant member")
+		switch err {
+		case restaurant.ErrInvalidMemberRole:
+			details := response.ErrorDetail{
+				Field:   "role",
+				Message: "Role must be manager or staff",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		case restaurant.ErrMemberNotFound:
+			details := response.ErrorDetail{
+				Field:   "user_id",
+				Message: "User id is a required field",
+			}
+			return response.Error(c, http.StatusBadRequest, err.Error(), details)
+		case restaurant.ErrCannotChangeOwnRole:
+			return response.Error(c, http.StatusBadRequest, err.Error())
+		case restaurant.ErrUserNotFound:
+			return response.Error(c, http.StatusNotFound, err.Error())
+		case restaurant.ErrRestaurantForbidden:
+			return response.Error(c, http.StatusForbidden, err.Error())
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[any](c, nil, "Add member successfully")
+}
+
+// RemoveMember godoc
+// @Summary Remove restaurant member
+// @Description Remove a manager or staff member from a restaurant
+// @Tags Restaurant
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param user_id path string true "Member user ID"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Remove member successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/members/{user_id} [delete]
+func (h *RestaurantHandler) RemoveMember(c echo.Context) error {
+	id := c.Param("id")
+	if id == "" {
+		return response.Error(c, http.StatusBadRequest, "missing restaurant id")
+	}
+	idInt, err := strconv.Atoi(id)
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	if idInt > math.MaxInt32 || idInt < math.MinInt32 {
+		return response.Error(c, http.StatusBadRequest, "restaurant id out of int32 range")
+	}
+	memberUUID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "invalid user id format")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.RemoveMemberUC.Execute(c.Request().Context(), userUUID, int32(idInt), memberUUID); err != nil {
+		h.Logger.Error().Err(err).Msg("failed remove restaurant member")
+		switch err {
+		case restaurant.ErrMemberNotFound:
+			return response.Error(c, http.StatusNotFound, err.Error())
+		case restaurant.ErrCannotRemoveOwner:
+			return response.Error(c, http.StatusBadRequest, err.Error())
+		case restaurant.ErrRestaurantForbidden:
+			return response.Error(c, http.StatusForbidden, err.Error())
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[any](c, nil, "Remove member successfully")
+}
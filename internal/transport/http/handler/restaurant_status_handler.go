@@ -0,0 +1,159 @@
+package handler
+
+import (
+	restaurantapp "go-ai/internal/application/restaurant"
+	"go-ai/internal/domain/restaurant"
+	"go-ai/internal/transport/http/response"
+	"go-ai/pkg/logger"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+)
+
+type RestaurantStatusHandler struct {
+	UpdateStatusUC *restaurantapp.UpdateStatusUseCase
+	ReviewUC       *restaurantapp.ReviewRestaurantUseCase
+	SetDayClosedUC *restaurantapp.SetDayClosedUseCase
+	Logger         zerolog.Logger
+}
+
+func NewRestaurantStatusHandler(
+	updateStatusUC *restaurantapp.UpdateStatusUseCase,
+	reviewUC *restaurantapp.ReviewRestaurantUseCase,
+	setDayClosedUC *restaurantapp.SetDayClosedUseCase) *RestaurantStatusHandler {
+	return &RestaurantStatusHandler{
+		UpdateStatusUC: updateStatusUC,
+		ReviewUC:       reviewUC,
+		SetDayClosedUC: setDayClosedUC,
+		Logger:         logger.NewLogger().With().Str("component", "Restaurant status handler").Logger(),
+	}
+}
+
+// UpdateRestaurantStatus godoc
+// @Summary Update restaurant status
+// @Description Owner side of the restaurant lifecycle: submit a draft for review (pending_review), withdraw it (draft), close the restaurant (closed) or submit a closed one again (pending_review)
+// @Tags Restaurant
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param body body restaurantapp.UpdateStatusRequest true "New status"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Update restaurant status successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/status [put]
+func (h *RestaurantStatusHandler) UpdateStatus(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	var in restaurantapp.UpdateStatusRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.UpdateStatusUC.Execute(c.Request().Context(), in, userUUID, id); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to update restaurant status")
+		return h.error(c, err)
+	}
+	return response.Success[any](c, nil, "Update restaurant status successfully")
+}
+
+// ReviewRestaurant godoc
+// @Summary Review restaurant
+// @Description Approve (active) or reject (draft) a restaurant pending review, suspend or reinstate an active one, or close it. Rejecting and suspending need a reason, shown to the restaurant members
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param body body restaurantapp.ReviewRestaurantRequest true "Decision"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Review restaurant successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/admin/restaurants/{id}/status [put]
+func (h *RestaurantStatusHandler) Review(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	var in restaurantapp.ReviewRestaurantRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	if err := h.ReviewUC.Execute(c.Request().Context(), in, id); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to review restaurant")
+		return h.error(c, err)
+	}
+	return response.Success[any](c, nil, "Review restaurant successfully")
+}
+
+// SetDayClosed godoc
+// @Summary Close or reopen a day
+// @Description Close every shift of one day of the week, or reopen them, keeping their times
+// @Tags Restaurant
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param day path int true "Day of week, 0 for Sunday to 6 for Saturday"
+// @Param body body restaurantapp.SetDayClosedRequest true "Whether the day is closed"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Update opening hours successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/hours/{day}/closed [put]
+func (h *RestaurantStatusHandler) SetDayClosed(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	dayInt, ok := int32Param(c, "day")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid day format")
+	}
+	day, err := restaurant.ParseDayOfWeek(dayInt)
+	if err != nil {
+		details := response.ErrorDetail{
+			Field:   "day",
+			Message: "day must be from 0 (Sunday) to 6 (Saturday)",
+		}
+		return response.Error(c, http.StatusBadRequest, "Invalid path parameter", details)
+	}
+	var in restaurantapp.SetDayClosedRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.SetDayClosedUC.Execute(c.Request().Context(), in, userUUID, id, day); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to set day closed")
+		return h.error(c, err)
+	}
+	return response.Success[any](c, nil, "Update opening hours successfully")
+}
+
+func (h *RestaurantStatusHandler) error(c echo.Context, err error) error {
+	switch err {
+	case restaurant.ErrInvalidStatus:
+		details := response.ErrorDetail{
+			Field:   "status",
+			Message: "status must be one of draft, pending_review, active, suspended, closed",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case restaurant.ErrStatusReasonRequired:
+		details := response.ErrorDetail{
+			Field:   "reason",
+			Message: "reason is required",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case restaurant.ErrInvalidStatusTransition, restaurant.ErrStatusChanged:
+		return response.Error(c, http.StatusConflict, err.Error())
+	case restaurant.ErrRestaurantNoExitis, restaurant.ErrHoursNotFound:
+		return response.Error(c, http.StatusNotFound, err.Error())
+	case restaurant.ErrRestaurantForbidden:
+		return response.Error(c, http.StatusForbidden, err.Error())
+	default:
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+}
@@ -0,0 +1,208 @@
+package handler
+
+import (
+	restaurantapp "go-ai/internal/application/restaurant"
+	"go-ai/internal/domain/restaurant"
+	"go-ai/internal/transport/http/response"
+	"go-ai/pkg/logger"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+)
+
+type SpecialHoursHandler struct {
+	ListUC   *restaurantapp.ListSpecialHoursUseCase
+	CreateUC *restaurantapp.CreateSpecialHoursUseCase
+	UpdateUC *restaurantapp.UpdateSpecialHoursUseCase
+	DeleteUC *restaurantapp.DeleteSpecialHoursUseCase
+	Logger   zerolog.Logger
+}
+
+func NewSpecialHoursHandler(
+	listUC *restaurantapp.ListSpecialHoursUseCase,
+	createUC *restaurantapp.CreateSpecialHoursUseCase,
+	updateUC *restaurantapp.UpdateSpecialHoursUseCase,
+	deleteUC *restaurantapp.DeleteSpecialHoursUseCase) *SpecialHoursHandler {
+	return &SpecialHoursHandler{
+		ListUC:   listUC,
+		CreateUC: createUC,
+		UpdateUC: updateUC,
+		DeleteUC: deleteUC,
+		Logger:   logger.NewLogger().With().Str("component", "Special hours handler").Logger(),
+	}
+}
+
+// int32Param reads a numeric path parameter such as a restaurant id.
+func int32Param(c echo.Context, name string) (int32, bool) {
+	v, err := strconv.Atoi(c.Param(name))
+	if err != nil || v > math.MaxInt32 || v < math.MinInt32 {
+		return 0, false
+	}
+	return int32(v), true
+}
+
+// ListSpecialHours godoc
+// @Summary List special hours
+// @Description List the date specific rules overriding the weekly hours of a restaurant: holidays, events and temporary closures
+// @Tags Restaurant
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param upcoming query bool false "Leave out the rules that ended before today"
+// @Success 200 {object} app.ListSpecialHoursSuccessResponseDoc "List special hours successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/special-hours [get]
+func (h *SpecialHoursHandler) List(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	var in restaurantapp.ListSpecialHoursRequest
+	if v := c.QueryParam("upcoming"); v != "" {
+		upcoming, err := strconv.ParseBool(v)
+		if err != nil {
+			details := response.ErrorDetail{
+				Field:   "upcoming",
+				Message: "upcoming must be true or false",
+			}
+			return response.Error(c, http.StatusBadRequest, "Invalid query parameter", details)
+		}
+		in.Upcoming = upcoming
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	viewer := restaurantapp.Viewer{UserID: userUUID}
+	resp, err := h.ListUC.Execute(c.Request().Context(), in, viewer, id)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to list special hours")
+		switch err {
+		case restaurant.ErrRestaurantNoExitis:
+			return response.Error(c, http.StatusNotFound, err.Error())
+		default:
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+	}
+	return response.Success[restaurantapp.ListSpecialHoursResponse](c, resp, "List special hours successfully")
+}
+
+// CreateSpecialHours godoc
+// @Summary Create special hours
+// @Description Close a restaurant or give it alternate hours on a range of dates. The rules of a date replace its weekly hours
+// @Tags Restaurant
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param body body restaurantapp.SpecialHoursRequest true "Special hours payload"
+// @Success 200 {object} app.CreateSpecialHoursSuccessResponseDoc "Create special hours successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/special-hours [post]
+func (h *SpecialHoursHandler) Create(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	var in restaurantapp.SpecialHoursRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.CreateUC.Execute(c.Request().Context(), in, userUUID, id)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to create special hours")
+		return h.error(c, err)
+	}
+	return response.Success[restaurantapp.CreateSpecialHoursResponse](c, resp, "Create special hours successfully")
+}
+
+// UpdateSpecialHours godoc
+// @Summary Update special hours
+// @Description Replace a special hours rule of a restaurant
+// @Tags Restaurant
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param special_id path string true "Special hours ID"
+// @Param body body restaurantapp.SpecialHoursRequest true "Special hours payload"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Update special hours successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/special-hours/{special_id} [put]
+func (h *SpecialHoursHandler) Update(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	specialID, ok := int32Param(c, "special_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid special hours id format")
+	}
+	var in restaurantapp.SpecialHoursRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.UpdateUC.Execute(c.Request().Context(), in, userUUID, id, specialID); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to update special hours")
+		return h.error(c, err)
+	}
+	return response.Success[any](c, nil, "Update special hours successfully")
+}
+
+// DeleteSpecialHours godoc
+// @Summary Delete special hours
+// @Description Remove a special hours rule, the weekly hours apply again on its dates
+// @Tags Restaurant
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param special_id path string true "Special hours ID"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Delete special hours successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/special-hours/{special_id} [delete]
+func (h *SpecialHoursHandler) Delete(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	specialID, ok := int32Param(c, "special_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid special hours id format")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.DeleteUC.Execute(c.Request().Context(), userUUID, id, specialID); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to delete special hours")
+		return h.error(c, err)
+	}
+	return response.Success[any](c, nil, "Delete special hours successfully")
+}
+
+func (h *SpecialHoursHandler) error(c echo.Context, err error) error {
+	switch err {
+	case restaurant.ErrInvalidSpecialHours:
+		details := response.ErrorDetail{
+			Field:   "start_date",
+			Message: "Dates must be YYYY-MM-DD with end_date from start_date and at most a year later, open_time and close_time HH:MM unless is_closed",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case restaurant.ErrSpecialHoursNotFound:
+		return response.Error(c, http.StatusNotFound, err.Error())
+	case restaurant.ErrRestaurantForbidden:
+		return response.Error(c, http.StatusForbidden, err.Error())
+	default:
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+}
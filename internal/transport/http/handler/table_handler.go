@@ -0,0 +1,252 @@
+package handler
+
+import (
+	tableapp "go-ai/internal/application/table"
+	"go-ai/internal/domain/restaurant"
+	"go-ai/internal/domain/table"
+	"go-ai/internal/transport/http/response"
+	"go-ai/pkg/logger"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+)
+
+type TableHandler struct {
+	ListUC       *tableapp.ListTablesUseCase
+	CreateUC     *tableapp.CreateTableUseCase
+	UpdateUC     *tableapp.UpdateTableUseCase
+	DeleteUC     *tableapp.DeleteTableUseCase
+	GenerateQRUC *tableapp.GenerateQRUseCase
+	DownloadQRUC *tableapp.DownloadQRUseCase
+	Logger       zerolog.Logger
+}
+
+func NewTableHandler(
+	listUC *tableapp.ListTablesUseCase,
+	createUC *tableapp.CreateTableUseCase,
+	updateUC *tableapp.UpdateTableUseCase,
+	deleteUC *tableapp.DeleteTableUseCase,
+	generateQRUC *tableapp.GenerateQRUseCase,
+	downloadQRUC *tableapp.DownloadQRUseCase) *TableHandler {
+	return &TableHandler{
+		ListUC:       listUC,
+		CreateUC:     createUC,
+		UpdateUC:     updateUC,
+		DeleteUC:     deleteUC,
+		GenerateQRUC: generateQRUC,
+		DownloadQRUC: downloadQRUC,
+		Logger:       logger.NewLogger().With().Str("component", "Table handler").Logger(),
+	}
+}
+
+// ListTables godoc
+// @Summary List tables
+// @Description List the tables of a restaurant with the links to their QR code images
+// @Tags Table
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Success 200 {object} app.ListTablesSuccessResponseDoc "List tables successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/tables [get]
+func (h *TableHandler) List(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.ListUC.Execute(c.Request().Context(), userUUID, id)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to list tables")
+		return h.error(c, err)
+	}
+	return response.Success[tableapp.ListTablesResponse](c, resp, "List tables successfully")
+}
+
+// CreateTable godoc
+// @Summary Create table
+// @Description Add a table to a restaurant. Its QR code is generated separately
+// @Tags Table
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param body body tableapp.TableRequest true "Table payload"
+// @Success 200 {object} app.CreateTableSuccessResponseDoc "Create table successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/tables [post]
+func (h *TableHandler) Create(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	var in tableapp.TableRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.CreateUC.Execute(c.Request().Context(), in, userUUID, id)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to create table")
+		return h.error(c, err)
+	}
+	return response.Success[tableapp.CreateTableResponse](c, resp, "Create table successfully")
+}
+
+// UpdateTable godoc
+// @Summary Update table
+// @Description Rename a table, change its seats or deactivate it. Its QR code keeps working
+// @Tags Table
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param table_id path string true "Table ID"
+// @Param body body tableapp.TableRequest true "Table payload"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Update table successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/tables/{table_id} [put]
+func (h *TableHandler) Update(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	tableID, ok := int32Param(c, "table_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid table id format")
+	}
+	var in tableapp.TableRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.UpdateUC.Execute(c.Request().Context(), in, userUUID, id, tableID); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to update table")
+		return h.error(c, err)
+	}
+	return response.Success[any](c, nil, "Update table successfully")
+}
+
+// DeleteTable godoc
+// @Summary Delete table
+// @Description Remove a table and its QR code images. Printed QR codes of the table then open the menu without selecting it
+// @Tags Table
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param table_id path string true "Table ID"
+// @Success 200 {object} app.SuccecssResponseBaseDoc "Delete table successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/tables/{table_id} [delete]
+func (h *TableHandler) Delete(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	tableID, ok := int32Param(c, "table_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid table id format")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.DeleteUC.Execute(c.Request().Context(), userUUID, id, tableID); err != nil {
+		h.Logger.Error().Err(err).Msg("failed to delete table")
+		return h.error(c, err)
+	}
+	return response.Success[any](c, nil, "Delete table successfully")
+}
+
+// GenerateTableQR godoc
+// @Summary Generate table QR code
+// @Description Generate the PNG and SVG QR code of a table, replacing the previous images. The code opens the public menu with the table selected
+// @Tags Table
+// @Accept json
+// @Produce json
+// @Param id path string true "Restaurant ID"
+// @Param table_id path string true "Table ID"
+// @Success 200 {object} app.TableQRSuccessResponseDoc "Generate QR code successfully"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/tables/{table_id}/qr [post]
+func (h *TableHandler) GenerateQR(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	tableID, ok := int32Param(c, "table_id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid table id format")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.GenerateQRUC.Execute(c.Request().Context(), userUUID, id, tableID)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to generate table QR code")
+		return h.error(c, err)
+	}
+	return response.Success[tableapp.TableQRResponse](c, resp, "Generate QR code successfully")
+}
+
+// DownloadTableQRCodes godoc
+// @Summary Download table QR codes
+// @Description Download the PNG and SVG QR codes of every active table of a restaurant as a zip archive, for printing. Missing codes are generated first
+// @Tags Table
+// @Produce application/zip
+// @Param id path string true "Restaurant ID"
+// @Success 200 {file} file "Zip archive of the QR codes"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/restaurant/{id}/tables/qr-codes [get]
+func (h *TableHandler) DownloadQR(c echo.Context) error {
+	id, ok := int32Param(c, "id")
+	if !ok {
+		return response.Error(c, http.StatusBadRequest, "invalid restaurant id format")
+	}
+	userUUID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	data, filename, err := h.DownloadQRUC.Execute(c.Request().Context(), userUUID, id)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to download table QR codes")
+		return h.error(c, err)
+	}
+	c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="`+filename+`"`)
+	return c.Blob(http.StatusOK, "application/zip", data)
+}
+
+func (h *TableHandler) error(c echo.Context, err error) error {
+	switch err {
+	case table.ErrInvalidTableName:
+		details := response.ErrorDetail{
+			Field:   "name",
+			Message: "name is required, at most 60 characters",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case table.ErrInvalidSeats:
+		details := response.ErrorDetail{
+			Field:   "seats",
+			Message: "seats must be from 0 to 100",
+		}
+		return response.Error(c, http.StatusBadRequest, err.Error(), details)
+	case table.ErrTableExists:
+		return response.Error(c, http.StatusConflict, err.Error())
+	case table.ErrTableNotFound, restaurant.ErrRestaurantNoExitis:
+		return response.Error(c, http.StatusNotFound, err.Error())
+	case restaurant.ErrRestaurantForbidden:
+		return response.Error(c, http.StatusForbidden, err.Error())
+	default:
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+}
@@ -56,3 +56,37 @@ func (h *UpLoadHandler) UploadLogoHandler() echo.HandlerFunc {
 		}, "Upload logo successfully")
 	}
 }
+
+// UploadImageHandler godoc
+// @Summary Upload image file
+// @Description Upload an image, such as a menu item photo, to storage and return the public URL
+// @Tags Upload
+// @Accept multipart/form-data
+// @Produce json
+// @Param image formData file true "Image file (png, jpg, jpeg)"
+// @Success 200 {object} app.UploadImageSuccessResponseDoc "Upload image success"
+// @Failure default {object} app.ErrorResponseDoc "Errors"
+// @Router /api/upload/image [post]
+func (h *UpLoadHandler) UploadImageHandler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		fileHeader, err := c.FormFile("image")
+		if err != nil {
+			h.Logger.Error().Err(err).Msg("Upload image: missing file")
+			return response.Error(c, http.StatusBadRequest, "image file is required")
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			h.Logger.Error().Err(err).Msg("Upload image: cannot open file")
+			return response.Error(c, http.StatusBadRequest, "unable to open file")
+		}
+		defer file.Close()
+		url, err := h.MC.UploadImage(c.Request().Context(), file, fileHeader)
+		if err != nil {
+			h.Logger.Error().Err(err).Msg("Upload image: MinIO upload error")
+			return response.Error(c, http.StatusBadRequest, "upload to storage failed")
+		}
+		return response.Success(c, &uploadapp.UploadImageResponse{
+			Url: url,
+		}, "Upload image successfully")
+	}
+}
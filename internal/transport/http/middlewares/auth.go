@@ -1,10 +1,13 @@
 package middlewares
 
 import (
+	"context"
+	"crypto/subtle"
 	"fmt"
-	"go-ai/internal/config"
+	"go-ai/internal/domain/auth"
 	"go-ai/internal/infra/cache"
 	"go-ai/internal/transport/http/response"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,20 +17,28 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+// HeaderAPIKey carries an API key as an alternative to the Authorization bearer token.
+const HeaderAPIKey = "X-API-Key"
+
 type AuthMiddleware struct {
 	Cache *cache.AuthCache
+	Repo  auth.Repository
+	Keys  *uilts.KeySet
 }
 
-func NewAuthMiddleware(cache *cache.AuthCache) *AuthMiddleware {
+func NewAuthMiddleware(cache *cache.AuthCache, repo auth.Repository, keys *uilts.KeySet) *AuthMiddleware {
 	return &AuthMiddleware{
 		Cache: cache,
+		Repo:  repo,
+		Keys:  keys,
 	}
 }
 
 func (m *AuthMiddleware) Handle(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		config, _ := config.LoadConfig()
-		// Implement authentication logic here
+		if apiKey := c.Request().Header.Get(HeaderAPIKey); apiKey != "" {
+			return m.handleAPIKey(c, next, apiKey)
+		}
 		authHeader := c.Request().Header.Get("Authorization")
 		if authHeader == "" {
 			return response.Error(c, 401, "Missing Authorization header")
@@ -37,7 +48,7 @@ func (m *AuthMiddleware) Handle(next echo.HandlerFunc) echo.HandlerFunc {
 			return response.Error(c, 401, "Invalid Authorization header format")
 		}
 		token := parts[1]
-		claims, err := uilts.VerifyToken(token, config.JwtAccessSecret)
+		claims, err := uilts.VerifyToken(m.Keys, uilts.TokenUseAccess, token)
 		if err != nil || claims == nil {
 			return response.Error(c, 401, "Invalid token")
 		}
@@ -52,12 +63,147 @@ func (m *AuthMiddleware) Handle(next echo.HandlerFunc) echo.HandlerFunc {
 		if userId == uuid.Nil {
 			return response.Error(c, 401, "Unauthorized access")
 		}
+		revoked, err := m.Cache.IsRevokedTokenCache(fmt.Sprintf("revoked_token_%s", claims.ID))
+		if err != nil || revoked {
+			return response.Error(c, 401, "Token has been revoked")
+		}
+		revokedBefore, err := m.Cache.GetRevokedBeforeCache(fmt.Sprintf("revoked_before_%s", claims.UserId.String()))
+		if err != nil {
+			return response.Error(c, 401, "Unauthorized access")
+		}
+		// both instants are whole seconds, a token issued in the second of the logout is revoked too
+		if claims.IssuedAt != nil && !claims.IssuedAt.Time.After(revokedBefore) {
+			return response.Error(c, 401, "Token has been revoked")
+		}
+		if claims.SessionId != "" {
+			keySession := fmt.Sprintf("session_%s_%s", claims.UserId.String(), claims.SessionId)
+			session, err := m.Cache.GetSessionCache(keySession)
+			if err != nil || session == nil {
+				return response.Error(c, 401, "Session has been revoked")
+			}
+		}
 		keyAuth := fmt.Sprintf("profile_%s", claims.UserId.String())
 		authData, err := m.Cache.GetAuthCache(keyAuth)
 		if err != nil || authData == nil {
 			return response.Error(c, 401, "Unauthorized access")
 		}
 		c.Set("user_id", claims.UserId)
+		c.Set("role", authData.Role)
+		c.Set("session_id", claims.SessionId)
+		c.Set("token_id", claims.ID)
+		c.Set("token_expires_at", exp.Time)
 		return next(c)
 	}
 }
+
+// Require allows the request only when the role of the authenticated user holds every given
+// permission. It must run after Handle.
+func (m *AuthMiddleware) Require(permissions ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			role, ok := c.Get("role").(string)
+			if !ok || role == "" {
+				return response.Error(c, 403, "Forbidden")
+			}
+			granted, err := m.rolePermissions(c.Request().Context(), role)
+			if err != nil {
+				return response.Error(c, 500, "Internal server error")
+			}
+			// an API key only carries the permissions it was scoped to
+			scopes, isAPIKey := c.Get("api_key_scopes").([]string)
+			for _, permission := range permissions {
+				if !slices.Contains(granted, permission) {
+					return response.Error(c, 403, "Forbidden")
+				}
+				if isAPIKey && !slices.Contains(scopes, permission) {
+					return response.Error(c, 403, "Forbidden")
+				}
+			}
+			return next(c)
+		}
+	}
+}
+
+// SessionOnly rejects requests authenticated with an API key, for endpoints that manage the
+// account itself. It must run after Handle.
+func (m *AuthMiddleware) SessionOnly(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if c.Get("api_key_id") != nil {
+			return response.Error(c, 403, "This endpoint requires a user session")
+		}
+		return next(c)
+	}
+}
+
+func (m *AuthMiddleware) rolePermissions(ctx context.Context, role string) ([]string, error) {
+	keyPermissions := fmt.Sprintf("role_permissions_%s", role)
+	permissions, err := m.Cache.GetPermissionsCache(keyPermissions)
+	if err != nil {
+		return nil, err
+	}
+	if permissions != nil {
+		return permissions, nil
+	}
+	permissions, err = m.Repo.GetPermissionsByRole(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+	if permissions == nil {
+		permissions = []string{}
+	}
+	m.Cache.SetPermissionsCache(keyPermissions, permissions, 10*time.Minute)
+	return permissions, nil
+}
+
+// handleAPIKey authenticates a request carrying an API key instead of a bearer token.
+func (m *AuthMiddleware) handleAPIKey(c echo.Context, next echo.HandlerFunc, apiKey string) error {
+	ctx := c.Request().Context()
+	prefix, secret, ok := uilts.ParseAPIKey(apiKey)
+	if !ok {
+		return response.Error(c, 401, "Invalid API key")
+	}
+	key, err := m.Repo.GetAPIKeyByPrefix(ctx, prefix)
+	if err != nil {
+		return response.Error(c, 401, "Invalid API key")
+	}
+	if subtle.ConstantTimeCompare([]byte(uilts.HashToken(secret)), []byte(key.SecretHash)) != 1 {
+		return response.Error(c, 401, "Invalid API key")
+	}
+	if key.RevokedAt != nil {
+		return response.Error(c, 401, "API key has been revoked")
+	}
+	if key.ExpiresAt != nil && time.Now().After(*key.ExpiresAt) {
+		return response.Error(c, 401, "API key has expired")
+	}
+	keyAuth := fmt.Sprintf("profile_%s", key.UserID.String())
+	authData, err := m.Cache.GetAuthCache(keyAuth)
+	if err != nil {
+		return response.Error(c, 401, "Unauthorized access")
+	}
+	if authData == nil {
+		record, err := m.Repo.GetById(ctx, key.UserID)
+		if err != nil {
+			return response.Error(c, 401, "Unauthorized access")
+		}
+		authData = &cache.AuthData{
+			UserId:   record.ID,
+			Email:    record.Email,
+			FullName: record.FullName,
+			Role:     record.Role,
+			IsActive: record.IsActive,
+		}
+		m.Cache.SetAuthCache(keyAuth, authData, time.Duration(60*int(time.Minute)))
+	}
+	if !authData.IsActive {
+		return response.Error(c, 401, "Unauthorized access")
+	}
+	// last_used_at only needs minute precision, skip the write on busy keys
+	if key.LastUsedAt == nil || time.Since(*key.LastUsedAt) > time.Minute {
+		m.Repo.TouchAPIKey(ctx, key.ID)
+	}
+	c.Set("user_id", key.UserID)
+	c.Set("role", authData.Role)
+	c.Set("api_key_id", key.ID)
+	c.Set("api_key_scopes", key.Scopes)
+	return next(c)
+}
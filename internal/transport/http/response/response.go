@@ -1,8 +1,13 @@
 package response
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 )
@@ -11,9 +16,18 @@ type ResponseDTO[T any] struct {
 	Message      string    `json:"message"`
 	ResponseCode string    `json:"response_code,omitempty"`
 	Data         *T        `json:"data,omitempty"`
+	Meta         *PageMeta `json:"meta,omitempty"`
 	Error        *ErrorObj `json:"error,omitempty"`
 }
 
+// PageMeta describes a page of a cursor paginated list. Pass NextCursor back as the cursor
+// query parameter to get the next page; it is empty on the last page.
+type PageMeta struct {
+	Limit      int    `json:"limit"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
+}
+
 type ErrorDetail struct {
 	Field   string `json:"field,omitempty"`
 	Message string `json:"message,omitempty"`
@@ -33,6 +47,60 @@ func Success[T any](ctx echo.Context, data *T, message string) error {
 	return ctx.JSON(http.StatusOK, resp)
 }
 
+func Paginated[T any](ctx echo.Context, data *T, meta *PageMeta, message string) error {
+	setJSON(ctx)
+	resp := &ResponseDTO[T]{
+		Data:         data,
+		Meta:         meta,
+		ResponseCode: strconv.Itoa(http.StatusOK),
+		Message:      message,
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// Cacheable sends data like Success along with the validators of conditional requests, a strong
+// ETag over the body and Last-Modified, and answers 304 Not Modified when the copy of the client is
+// current. Shared caches may serve the response for maxAge without asking again.
+func Cacheable[T any](ctx echo.Context, data *T, message string, lastModified time.Time, maxAge time.Duration) error {
+	resp := &ResponseDTO[T]{
+		Data:         data,
+		ResponseCode: strconv.Itoa(http.StatusOK),
+		Message:      message,
+	}
+	body, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	lastModified = lastModified.UTC().Truncate(time.Second)
+
+	header := ctx.Response().Header()
+	header.Set("ETag", etag)
+	header.Set(echo.HeaderLastModified, lastModified.Format(http.TimeFormat))
+	header.Set(echo.HeaderCacheControl, "public, max-age="+strconv.Itoa(int(maxAge.Seconds())))
+	if notModified(ctx.Request(), etag, lastModified) {
+		return ctx.NoContent(http.StatusNotModified)
+	}
+	return ctx.JSONBlob(http.StatusOK, body)
+}
+
+// notModified evaluates If-None-Match, or If-Modified-Since when the request has no
+// If-None-Match, the precedence RFC 9110 gives them.
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if match := r.Header.Get("If-None-Match"); match != "" {
+		for _, tag := range strings.Split(match, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == etag || tag == "*" {
+				return true
+			}
+		}
+		return false
+	}
+	since, err := http.ParseTime(r.Header.Get(echo.HeaderIfModifiedSince))
+	return err == nil && !lastModified.After(since)
+}
+
 func Error(ctx echo.Context, code int, msg string, details ...ErrorDetail) error {
 	setJSON(ctx)
 	resp := &ResponseDTO[any]{
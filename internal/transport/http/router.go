@@ -2,41 +2,136 @@ package http
 
 import (
 	authapp "go-ai/internal/application/auth"
+	menuapp "go-ai/internal/application/menu"
+	orderapp "go-ai/internal/application/order"
 	restaurantapp "go-ai/internal/application/restaurant"
+	tableapp "go-ai/internal/application/table"
+	"go-ai/internal/domain/auth"
 	"go-ai/internal/infra/cache"
 	authrepo "go-ai/internal/infra/db/auth"
+	menurepo "go-ai/internal/infra/db/menu"
+	orderrepo "go-ai/internal/infra/db/order"
 	restaurantrepo "go-ai/internal/infra/db/restaurant"
+	tablerepo "go-ai/internal/infra/db/table"
+	"go-ai/internal/infra/geocode"
+	"go-ai/internal/infra/mail"
+	"go-ai/internal/infra/oidc"
 	"go-ai/internal/infra/storage"
 	"go-ai/internal/transport/http/handler"
 	"go-ai/internal/transport/http/middlewares"
+	uilts "go-ai/pkg/utils"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/labstack/echo/v4"
 	"github.com/redis/go-redis/v9"
 )
 
-func Router(pool *pgxpool.Pool, e *echo.Echo, redis *redis.Client) {
+func Router(pool *pgxpool.Pool, e *echo.Echo, redis *redis.Client, keys *uilts.KeySet) {
 	api := e.Group("/api")
 
+	jwksHandler := handler.NewJWKSHandler(keys)
+	e.GET("/.well-known/jwks.json", jwksHandler.JWKS)
+
 	authRepo := authrepo.NewAuthRepo(pool)
 	authCache := cache.NewAuthCache(redis)
-	authMiddleware := middlewares.NewAuthMiddleware(authCache)
-	registerUC := authapp.NewRegisterUseCase(authRepo, authCache)
-	loginUC := authapp.NewLoginUseCase(authRepo, authCache)
-	refreshUC := authapp.NewRefreshTokenUseCase(authRepo, authCache)
+	authMiddleware := middlewares.NewAuthMiddleware(authCache, authRepo, keys)
+	mailer := mail.NewMailer()
+	registerUC := authapp.NewRegisterUseCase(authRepo, authCache, mailer)
+	loginUC := authapp.NewLoginUseCase(authRepo, authCache, keys)
+	refreshUC := authapp.NewRefreshTokenUseCase(authRepo, authCache, keys)
 	profileUC := authapp.NewGetProfileUseCase(authRepo, authCache)
+	logoutUC := authapp.NewLogoutUseCase(authCache)
+	logoutAllUC := authapp.NewLogoutAllUseCase(authCache)
+	listSessionsUC := authapp.NewListSessionsUseCase(authCache)
+	revokeSessionUC := authapp.NewRevokeSessionUseCase(authCache)
+	forgotPasswordUC := authapp.NewForgotPasswordUseCase(authRepo, mailer)
+	resetPasswordUC := authapp.NewResetPasswordUseCase(authRepo, authCache)
+	verifyEmailUC := authapp.NewVerifyEmailUseCase(authRepo)
+	resendVerificationUC := authapp.NewResendVerificationUseCase(authRepo, mailer)
+	updateProfileUC := authapp.NewUpdateProfileUseCase(authRepo, authCache, mailer)
+	changePasswordUC := authapp.NewChangePasswordUseCase(authRepo, authCache)
+	verifyMfaUC := authapp.NewVerifyMfaUseCase(authRepo, authCache, keys)
+	setupTOTPUC := authapp.NewSetupTOTPUseCase(authRepo)
+	confirmTOTPUC := authapp.NewConfirmTOTPUseCase(authRepo)
+	disableTOTPUC := authapp.NewDisableTOTPUseCase(authRepo)
 	authHandler := handler.NewAuthHandler(
 		registerUC,
 		loginUC,
 		refreshUC,
 		profileUC,
+		logoutUC,
+		logoutAllUC,
+		listSessionsUC,
+		revokeSessionUC,
+		forgotPasswordUC,
+		resetPasswordUC,
+		verifyEmailUC,
+		resendVerificationUC,
+		updateProfileUC,
+		changePasswordUC,
+		verifyMfaUC,
+		setupTOTPUC,
+		confirmTOTPUC,
+		disableTOTPUC,
+	)
+	createAPIKeyUC := authapp.NewCreateAPIKeyUseCase(authRepo)
+	listAPIKeysUC := authapp.NewListAPIKeysUseCase(authRepo)
+	revokeAPIKeyUC := authapp.NewRevokeAPIKeyUseCase(authRepo)
+	apiKeyHandler := handler.NewAPIKeyHandler(
+		createAPIKeyUC,
+		listAPIKeysUC,
+		revokeAPIKeyUC,
+	)
+	oidcProviders := oidc.NewProviders()
+	oidcAuthorizeUC := authapp.NewOIDCAuthorizeUseCase(authCache, oidcProviders)
+	oidcCallbackUC := authapp.NewOIDCCallbackUseCase(authRepo, authCache, keys, oidcProviders)
+	oidcHandler := handler.NewOIDCHandler(
+		oidcAuthorizeUC,
+		oidcCallbackUC,
 	)
 	authGroup := api.Group("/auth")
 	{
 		authGroup.POST("/register", authHandler.Register)
 		authGroup.POST("/login", authHandler.Login)
+		authGroup.POST("/login/mfa", authHandler.VerifyMfa)
+		authGroup.GET("/oidc/:provider/authorize", oidcHandler.Authorize)
+		authGroup.GET("/oidc/:provider/callback", oidcHandler.Callback)
 		authGroup.POST("/refresh-token", authHandler.RefreshToken)
 		authGroup.GET("/profile", authHandler.GetProfile, authMiddleware.Handle)
+		authGroup.PUT("/profile", authHandler.UpdateProfile, authMiddleware.Handle, authMiddleware.SessionOnly)
+		authGroup.POST("/logout", authHandler.Logout, authMiddleware.Handle, authMiddleware.SessionOnly)
+		authGroup.POST("/logout-all", authHandler.LogoutAll, authMiddleware.Handle, authMiddleware.SessionOnly)
+		authGroup.POST("/password/forgot", authHandler.ForgotPassword)
+		authGroup.POST("/password/reset", authHandler.ResetPassword)
+		authGroup.POST("/password/change", authHandler.ChangePassword, authMiddleware.Handle, authMiddleware.SessionOnly)
+		authGroup.GET("/verify-email", authHandler.VerifyEmail)
+		authGroup.POST("/verify-email/resend", authHandler.ResendVerification)
+		authGroup.GET("/sessions", authHandler.ListSessions, authMiddleware.Handle, authMiddleware.SessionOnly)
+		authGroup.DELETE("/sessions/:id", authHandler.RevokeSession, authMiddleware.Handle, authMiddleware.SessionOnly)
+		authGroup.POST("/mfa/totp/setup", authHandler.SetupTOTP, authMiddleware.Handle, authMiddleware.SessionOnly)
+		authGroup.POST("/mfa/totp/confirm", authHandler.ConfirmTOTP, authMiddleware.Handle, authMiddleware.SessionOnly)
+		authGroup.POST("/mfa/totp/disable", authHandler.DisableTOTP, authMiddleware.Handle, authMiddleware.SessionOnly)
+		authGroup.GET("/api-keys", apiKeyHandler.List, authMiddleware.Handle, authMiddleware.SessionOnly)
+		authGroup.POST("/api-keys", apiKeyHandler.Create, authMiddleware.Handle, authMiddleware.SessionOnly)
+		authGroup.DELETE("/api-keys/:id", apiKeyHandler.Revoke, authMiddleware.Handle, authMiddleware.SessionOnly)
+	}
+
+	listUsersUC := authapp.NewListUsersUseCase(authRepo)
+	setUserStatusUC := authapp.NewSetUserStatusUseCase(authRepo, authCache)
+	setUserRoleUC := authapp.NewSetUserRoleUseCase(authRepo, authCache)
+	listRolesUC := authapp.NewListRolesUseCase(authRepo)
+	adminHandler := handler.NewAdminHandler(
+		listUsersUC,
+		setUserStatusUC,
+		setUserRoleUC,
+		listRolesUC,
+	)
+	adminGroup := api.Group("/admin")
+	{
+		adminGroup.GET("/users", adminHandler.ListUsers, authMiddleware.Handle, authMiddleware.Require(auth.PermissionUserRead))
+		adminGroup.PUT("/users/:id/status", adminHandler.SetUserStatus, authMiddleware.Handle, authMiddleware.Require(auth.PermissionUserManage))
+		adminGroup.PUT("/users/:id/role", adminHandler.SetUserRole, authMiddleware.Handle, authMiddleware.Require(auth.PermissionUserManage))
+		adminGroup.GET("/roles", adminHandler.ListRoles, authMiddleware.Handle, authMiddleware.Require(auth.PermissionUserRead))
 	}
 
 	minioClient := storage.NewMinioClient()
@@ -45,20 +140,203 @@ func Router(pool *pgxpool.Pool, e *echo.Echo, redis *redis.Client) {
 	)
 	uploadGroup := api.Group("/upload")
 	{
-		uploadGroup.POST("/logo", uploadHandler.UploadLogoHandler(), authMiddleware.Handle)
+		uploadGroup.POST("/logo", uploadHandler.UploadLogoHandler(), authMiddleware.Handle, authMiddleware.Require(auth.PermissionUploadCreate))
+		uploadGroup.POST("/image", uploadHandler.UploadImageHandler(), authMiddleware.Handle, authMiddleware.Require(auth.PermissionUploadCreate))
 	}
 
 	restaurantRepo := restaurantrepo.NewRestaurantRepo(pool)
-	createRestaurantUC := restaurantapp.NewCreateRestaurantUseCase(restaurantRepo)
-	getByIdUC := restaurantapp.NewGetByIDUseCase(restaurantRepo, authCache)
-	updateRestaurantUC := restaurantapp.NewUpdateRestaurantUseCase(restaurantRepo)
+	geocoder := geocode.NewGeocoder()
+	createRestaurantUC := restaurantapp.NewCreateRestaurantUseCase(restaurantRepo, geocoder)
+	getByIdUC := restaurantapp.NewGetByIDUseCase(restaurantRepo, authRepo, authCache)
+	listRestaurantsUC := restaurantapp.NewListRestaurantsUseCase(restaurantRepo)
+	searchRestaurantsUC := restaurantapp.NewSearchRestaurantsUseCase(restaurantRepo)
+	nearbyRestaurantsUC := restaurantapp.NewNearbyRestaurantsUseCase(restaurantRepo)
+	updateRestaurantUC := restaurantapp.NewUpdateRestaurantUseCase(restaurantRepo, geocoder)
 	deleteRestaurantUC := restaurantapp.NewDeleteUseCase(restaurantRepo)
-	restaurantHandler := handler.NewRestaurantHandler(createRestaurantUC, getByIdUC, updateRestaurantUC, deleteRestaurantUC)
+	addMemberUC := restaurantapp.NewAddMemberUseCase(restaurantRepo)
+	listMembersUC := restaurantapp.NewListMembersUseCase(restaurantRepo)
+	removeMemberUC := restaurantapp.NewRemoveMemberUseCase(restaurantRepo)
+	restaurantHandler := handler.NewRestaurantHandler(
+		createRestaurantUC,
+		getByIdUC,
+		listRestaurantsUC,
+		searchRestaurantsUC,
+		nearbyRestaurantsUC,
+		updateRestaurantUC,
+		deleteRestaurantUC,
+		addMemberUC,
+		listMembersUC,
+		removeMemberUC,
+	)
+	listSpecialHoursUC := restaurantapp.NewListSpecialHoursUseCase(restaurantRepo)
+	createSpecialHoursUC := restaurantapp.NewCreateSpecialHoursUseCase(restaurantRepo)
+	updateSpecialHoursUC := restaurantapp.NewUpdateSpecialHoursUseCase(restaurantRepo)
+	deleteSpecialHoursUC := restaurantapp.NewDeleteSpecialHoursUseCase(restaurantRepo)
+	specialHoursHandler := handler.NewSpecialHoursHandler(
+		listSpecialHoursUC,
+		createSpecialHoursUC,
+		updateSpecialHoursUC,
+		deleteSpecialHoursUC,
+	)
+	updateStatusUC := restaurantapp.NewUpdateStatusUseCase(restaurantRepo)
+	reviewRestaurantUC := restaurantapp.NewReviewRestaurantUseCase(restaurantRepo)
+	setDayClosedUC := restaurantapp.NewSetDayClosedUseCase(restaurantRepo)
+	restaurantStatusHandler := handler.NewRestaurantStatusHandler(
+		updateStatusUC,
+		reviewRestaurantUC,
+		setDayClosedUC,
+	)
+	menuRepo := menurepo.NewMenuRepo(pool)
+	getMenuUC := menuapp.NewGetMenuUseCase(menuRepo, restaurantRepo)
+	createCategoryUC := menuapp.NewCreateCategoryUseCase(menuRepo, restaurantRepo)
+	updateCategoryUC := menuapp.NewUpdateCategoryUseCase(menuRepo, restaurantRepo)
+	deleteCategoryUC := menuapp.NewDeleteCategoryUseCase(menuRepo, restaurantRepo)
+	createItemUC := menuapp.NewCreateItemUseCase(menuRepo, restaurantRepo)
+	getItemUC := menuapp.NewGetItemUseCase(menuRepo, restaurantRepo)
+	updateItemUC := menuapp.NewUpdateItemUseCase(menuRepo, restaurantRepo)
+	setItemAvailabilityUC := menuapp.NewSetItemAvailabilityUseCase(menuRepo, restaurantRepo)
+	deleteItemUC := menuapp.NewDeleteItemUseCase(menuRepo, restaurantRepo)
+	menuHandler := handler.NewMenuHandler(
+		getMenuUC,
+		createCategoryUC,
+		updateCategoryUC,
+		deleteCategoryUC,
+		createItemUC,
+		getItemUC,
+		updateItemUC,
+		setItemAvailabilityUC,
+		deleteItemUC,
+	)
+	listOptionGroupsUC := menuapp.NewListOptionGroupsUseCase(menuRepo, restaurantRepo)
+	createOptionGroupUC := menuapp.NewCreateOptionGroupUseCase(menuRepo, restaurantRepo)
+	updateOptionGroupUC := menuapp.NewUpdateOptionGroupUseCase(menuRepo, restaurantRepo)
+	deleteOptionGroupUC := menuapp.NewDeleteOptionGroupUseCase(menuRepo, restaurantRepo)
+	setItemOptionGroupsUC := menuapp.NewSetItemOptionGroupsUseCase(menuRepo, restaurantRepo)
+	priceItemUC := menuapp.NewPriceItemUseCase(menuRepo, restaurantRepo)
+	optionGroupHandler := handler.NewOptionGroupHandler(
+		listOptionGroupsUC,
+		createOptionGroupUC,
+		updateOptionGroupUC,
+		deleteOptionGroupUC,
+		setItemOptionGroupsUC,
+		priceItemUC,
+	)
+	tableRepo := tablerepo.NewTableRepo(pool)
+	tableSigner := tableapp.NewTableSigner()
+	listTablesUC := tableapp.NewListTablesUseCase(tableRepo, restaurantRepo, minioClient)
+	createTableUC := tableapp.NewCreateTableUseCase(tableRepo, restaurantRepo)
+	updateTableUC := tableapp.NewUpdateTableUseCase(tableRepo, restaurantRepo)
+	deleteTableUC := tableapp.NewDeleteTableUseCase(tableRepo, restaurantRepo, minioClient)
+	generateQRUC := tableapp.NewGenerateQRUseCase(tableRepo, restaurantRepo, minioClient, tableSigner)
+	downloadQRUC := tableapp.NewDownloadQRUseCase(tableRepo, restaurantRepo, minioClient, tableSigner)
+	tableHandler := handler.NewTableHandler(
+		listTablesUC,
+		createTableUC,
+		updateTableUC,
+		deleteTableUC,
+		generateQRUC,
+		downloadQRUC,
+	)
+	orderRepo := orderrepo.NewOrderRepo(pool)
+	getCartUC := orderapp.NewGetCartUseCase(orderRepo)
+	addCartItemUC := orderapp.NewAddCartItemUseCase(orderRepo, menuRepo, restaurantRepo)
+	updateCartItemUC := orderapp.NewUpdateCartItemUseCase(orderRepo, menuRepo, restaurantRepo)
+	deleteCartItemUC := orderapp.NewDeleteCartItemUseCase(orderRepo)
+	deleteCartUC := orderapp.NewDeleteCartUseCase(orderRepo)
+	placeOrderUC := orderapp.NewPlaceOrderUseCase(orderRepo, menuRepo, restaurantRepo, tableRepo, tableSigner)
+	cartHandler := handler.NewCartHandler(
+		getCartUC,
+		addCartItemUC,
+		updateCartItemUC,
+		deleteCartItemUC,
+		deleteCartUC,
+		placeOrderUC,
+	)
+	listOrdersUC := orderapp.NewListOrdersUseCase(orderRepo)
+	getOrderUC := orderapp.NewGetOrderUseCase(orderRepo, restaurantRepo)
+	cancelOrderUC := orderapp.NewCancelOrderUseCase(orderRepo)
+	listRestaurantOrdersUC := orderapp.NewListRestaurantOrdersUseCase(orderRepo, restaurantRepo)
+	updateOrderStatusUC := orderapp.NewUpdateOrderStatusUseCase(orderRepo, restaurantRepo)
+	orderHandler := handler.NewOrderHandler(
+		listOrdersUC,
+		getOrderUC,
+		cancelOrderUC,
+		listRestaurantOrdersUC,
+		updateOrderStatusUC,
+	)
 	restaurantGroup := api.Group("/restaurant")
 	{
-		restaurantGroup.POST("", restaurantHandler.Create, authMiddleware.Handle)
-		restaurantGroup.GET("/:id", restaurantHandler.GetByID, authMiddleware.Handle)
-		restaurantGroup.PUT("/:id", restaurantHandler.Update, authMiddleware.Handle)
-		restaurantGroup.DELETE("/:id", restaurantHandler.Delete, authMiddleware.Handle)
+		restaurantGroup.GET("", restaurantHandler.List, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantRead))
+		restaurantGroup.GET("/search", restaurantHandler.Search, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantRead))
+		restaurantGroup.GET("/nearby", restaurantHandler.Nearby, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantRead))
+		restaurantGroup.POST("", restaurantHandler.Create, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantCreate))
+		restaurantGroup.GET("/:id", restaurantHandler.GetByID, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantRead))
+		restaurantGroup.PUT("/:id", restaurantHandler.Update, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.DELETE("/:id", restaurantHandler.Delete, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantDelete))
+		restaurantGroup.PUT("/:id/status", restaurantStatusHandler.UpdateStatus, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.PUT("/:id/hours/:day/closed", restaurantStatusHandler.SetDayClosed, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.GET("/:id/members", restaurantHandler.ListMembers, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantRead))
+		restaurantGroup.POST("/:id/members", restaurantHandler.AddMember, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.DELETE("/:id/members/:user_id", restaurantHandler.RemoveMember, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.GET("/:id/special-hours", specialHoursHandler.List, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantRead))
+		restaurantGroup.POST("/:id/special-hours", specialHoursHandler.Create, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.PUT("/:id/special-hours/:special_id", specialHoursHandler.Update, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.DELETE("/:id/special-hours/:special_id", specialHoursHandler.Delete, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.GET("/:id/menu", menuHandler.GetMenu, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantRead))
+		restaurantGroup.POST("/:id/menu/categories", menuHandler.CreateCategory, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.PUT("/:id/menu/categories/:category_id", menuHandler.UpdateCategory, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.DELETE("/:id/menu/categories/:category_id", menuHandler.DeleteCategory, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.POST("/:id/menu/items", menuHandler.CreateItem, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.GET("/:id/menu/items/:item_id", menuHandler.GetItem, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantRead))
+		restaurantGroup.PUT("/:id/menu/items/:item_id", menuHandler.UpdateItem, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		// staff mark items sold out, the use case checks the membership
+		restaurantGroup.PUT("/:id/menu/items/:item_id/availability", menuHandler.SetItemAvailability, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantRead))
+		restaurantGroup.DELETE("/:id/menu/items/:item_id", menuHandler.DeleteItem, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.PUT("/:id/menu/items/:item_id/option-groups", optionGroupHandler.SetItemOptionGroups, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.POST("/:id/menu/items/:item_id/price", optionGroupHandler.PriceItem, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantRead))
+		restaurantGroup.GET("/:id/menu/option-groups", optionGroupHandler.List, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantRead))
+		restaurantGroup.POST("/:id/menu/option-groups", optionGroupHandler.Create, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.PUT("/:id/menu/option-groups/:group_id", optionGroupHandler.Update, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.DELETE("/:id/menu/option-groups/:group_id", optionGroupHandler.Delete, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.GET("/:id/tables", tableHandler.List, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantRead))
+		restaurantGroup.POST("/:id/tables", tableHandler.Create, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.GET("/:id/tables/qr-codes", tableHandler.DownloadQR, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.PUT("/:id/tables/:table_id", tableHandler.Update, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.DELETE("/:id/tables/:table_id", tableHandler.Delete, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		restaurantGroup.POST("/:id/tables/:table_id/qr", tableHandler.GenerateQR, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantUpdate))
+		// reading stays on restaurant:read, changing carts and orders needs the order permissions
+		restaurantGroup.GET("/:id/cart", cartHandler.Get, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantRead))
+		restaurantGroup.DELETE("/:id/cart", cartHandler.Delete, authMiddleware.Handle, authMiddleware.Require(auth.PermissionOrderWrite))
+		restaurantGroup.POST("/:id/cart/items", cartHandler.AddItem, authMiddleware.Handle, authMiddleware.Require(auth.PermissionOrderWrite))
+		restaurantGroup.PUT("/:id/cart/items/:item_id", cartHandler.UpdateItem, authMiddleware.Handle, authMiddleware.Require(auth.PermissionOrderWrite))
+		restaurantGroup.DELETE("/:id/cart/items/:item_id", cartHandler.DeleteItem, authMiddleware.Handle, authMiddleware.Require(auth.PermissionOrderWrite))
+		restaurantGroup.POST("/:id/cart/place", cartHandler.Place, authMiddleware.Handle, authMiddleware.Require(auth.PermissionOrderWrite))
+		// staff handle the orders, the use cases check the membership
+		restaurantGroup.GET("/:id/orders", orderHandler.ListRestaurant, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantRead))
+		restaurantGroup.PUT("/:id/orders/:order_id/status", orderHandler.UpdateStatus, authMiddleware.Handle, authMiddleware.Require(auth.PermissionOrderManage))
+	}
+
+	orderGroup := api.Group("/orders")
+	{
+		orderGroup.GET("", orderHandler.List, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantRead))
+		orderGroup.GET("/:order_id", orderHandler.Get, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantRead))
+		orderGroup.POST("/:order_id/cancel", orderHandler.Cancel, authMiddleware.Handle, authMiddleware.Require(auth.PermissionOrderWrite))
+	}
+
+	adminRestaurantGroup := adminGroup.Group("/restaurants")
+	{
+		adminRestaurantGroup.GET("", restaurantHandler.ReviewList, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantReview))
+		adminRestaurantGroup.GET("/:id", restaurantHandler.ReviewGetByID, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantReview))
+		adminRestaurantGroup.PUT("/:id/status", restaurantStatusHandler.Review, authMiddleware.Handle, authMiddleware.Require(auth.PermissionRestaurantReview))
+	}
+
+	getPublicRestaurantUC := restaurantapp.NewGetPublicUseCase(restaurantRepo)
+	getPublicMenuUC := menuapp.NewGetPublicMenuUseCase(menuRepo, restaurantRepo, tableRepo, tableSigner)
+	publicHandler := handler.NewPublicHandler(getPublicRestaurantUC, getPublicMenuUC)
+	// no auth: the pages of active restaurants are open to anyone and cacheable
+	publicGroup := api.Group("/public")
+	{
+		publicGroup.GET("/restaurants/:slug", publicHandler.GetRestaurant)
+		publicGroup.GET("/restaurants/:slug/menu", publicHandler.GetMenu)
 	}
 }
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"strings"
+)
+
+const apiKeyScheme = "goai"
+
+var apiKeyPrefixEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateAPIKey returns a new key of the form goai_<prefix>_<secret> together with its parts. The
+// prefix is stored in clear to look the key up, the secret only as a hash.
+func GenerateAPIKey() (key, prefix, secret string, err error) {
+	b := make([]byte, 5)
+	if _, err = rand.Read(b); err != nil {
+		return "", "", "", err
+	}
+	prefix = strings.ToLower(apiKeyPrefixEncoding.EncodeToString(b))
+	secret, err = GenerateOpaqueToken(32)
+	if err != nil {
+		return "", "", "", err
+	}
+	return apiKeyScheme + "_" + prefix + "_" + secret, prefix, secret, nil
+}
+
+// ParseAPIKey splits a key produced by GenerateAPIKey. The secret is base64url and may itself
+// contain underscores, so only the first two separators count.
+func ParseAPIKey(key string) (prefix, secret string, ok bool) {
+	parts := strings.SplitN(key, "_", 3)
+	if len(parts) != 3 || parts[0] != apiKeyScheme || parts[1] == "" || parts[2] == "" {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
@@ -0,0 +1,230 @@
+package utils
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Signing keys live in one directory, one PEM file per key, and the file name without extension
+// is the kid:
+//
+//	<kid>.pem      PKCS#8 private key (RSA or Ed25519), can sign and verify
+//	<kid>.pub.pem  PKIX public key, verify only
+//
+// Every key in the directory is published on the JWKS endpoint. The signing key is the one named
+// by JWT_SIGNING_KID, or the private key with the greatest kid when it is empty, so kids should sort
+// by age (e.g. 20261018-ed25519). Rotation:
+//
+//  1. Add the new private key and pin JWT_SIGNING_KID to the current kid, deploy. The new key is
+//     now published but unused, which gives verifiers time to refresh their JWKS cache.
+//  2. Set JWT_SIGNING_KID to the new kid (or clear it), deploy.
+//  3. Replace the old private key with its .pub.pem once its refresh tokens may still be in use,
+//     and delete it after JWT_REFRESH_EXPIRES_IN has passed.
+
+var (
+	ErrNoSigningKey    = errors.New("no jwt signing key")
+	ErrUnknownKid      = errors.New("unknown jwt kid")
+	ErrUnsupportedKey  = errors.New("unsupported jwt key type")
+	ErrWrongTokenUse   = errors.New("token used for the wrong purpose")
+	ErrWrongSignMethod = errors.New("token signed with an unexpected method")
+)
+
+type jwtKey struct {
+	kid     string
+	method  jwt.SigningMethod
+	private crypto.Signer
+	public  crypto.PublicKey
+}
+
+// KeySet holds the key that signs new tokens and every key tokens are verified against.
+type KeySet struct {
+	signing *jwtKey
+	keys    map[string]*jwtKey
+}
+
+// JWK is the public half of a key in RFC 7517 form.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// LoadKeySet reads every key in dir and picks the signing key as described above.
+func LoadKeySet(dir, signingKid string) (*KeySet, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	ks := &KeySet{keys: map[string]*jwtKey{}}
+	var privateKids []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".pem") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		var key *jwtKey
+		if kid, ok := strings.CutSuffix(name, ".pub.pem"); ok {
+			key, err = parsePublicKey(kid, data)
+		} else {
+			kid := strings.TrimSuffix(name, ".pem")
+			key, err = parsePrivateKey(kid, data)
+			privateKids = append(privateKids, kid)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if _, exists := ks.keys[key.kid]; exists && key.private == nil {
+			// a private key already covers this kid
+			continue
+		}
+		ks.keys[key.kid] = key
+	}
+	if signingKid == "" {
+		if len(privateKids) == 0 {
+			return nil, ErrNoSigningKey
+		}
+		sort.Strings(privateKids)
+		signingKid = privateKids[len(privateKids)-1]
+	}
+	signing, ok := ks.keys[signingKid]
+	if !ok || signing.private == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNoSigningKey, signingKid)
+	}
+	ks.signing = signing
+	return ks, nil
+}
+
+// NewEphemeralKeySet returns a key set with a random Ed25519 key. Tokens it signs do not survive a
+// restart and are not accepted by other instances, so it is only meant for local development.
+func NewEphemeralKeySet() (*KeySet, error) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	key := &jwtKey{
+		kid:     "ephemeral-" + base64.RawURLEncoding.EncodeToString(public[:6]),
+		method:  jwt.SigningMethodEdDSA,
+		private: private,
+		public:  public,
+	}
+	return &KeySet{
+		signing: key,
+		keys:    map[string]*jwtKey{key.kid: key},
+	}, nil
+}
+
+func (ks *KeySet) SigningKid() string {
+	return ks.signing.kid
+}
+
+func (ks *KeySet) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(ks.signing.method, claims)
+	token.Header["kid"] = ks.signing.kid
+	return token.SignedString(ks.signing.private)
+}
+
+// keyfunc resolves the verification key from the kid header and refuses any algorithm other than
+// the one of that key, so a token cannot pick its own verification method.
+func (ks *KeySet) keyfunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := ks.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKid
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, ErrWrongSignMethod
+	}
+	return key.public, nil
+}
+
+// JWKS returns the public keys in a stable order.
+func (ks *KeySet) JWKS() JWKS {
+	kids := make([]string, 0, len(ks.keys))
+	for kid := range ks.keys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+	set := JWKS{Keys: make([]JWK, 0, len(kids))}
+	for _, kid := range kids {
+		key := ks.keys[kid]
+		jwk := JWK{Kid: kid, Use: "sig", Alg: key.method.Alg()}
+		switch pub := key.public.(type) {
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set
+}
+
+func parsePrivateKey(kid string, data []byte) (*jwtKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	switch private := parsed.(type) {
+	case ed25519.PrivateKey:
+		return &jwtKey{kid: kid, method: jwt.SigningMethodEdDSA, private: private, public: private.Public()}, nil
+	case *rsa.PrivateKey:
+		if private.N.BitLen() < 2048 {
+			return nil, errors.New("RSA key shorter than 2048 bits")
+		}
+		return &jwtKey{kid: kid, method: jwt.SigningMethodRS256, private: private, public: &private.PublicKey}, nil
+	}
+	return nil, ErrUnsupportedKey
+}
+
+func parsePublicKey(kid string, data []byte) (*jwtKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block")
+	}
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	switch public := parsed.(type) {
+	case ed25519.PublicKey:
+		return &jwtKey{kid: kid, method: jwt.SigningMethodEdDSA, public: public}, nil
+	case *rsa.PublicKey:
+		return &jwtKey{kid: kid, method: jwt.SigningMethodRS256, public: public}, nil
+	}
+	return nil, ErrUnsupportedKey
+}
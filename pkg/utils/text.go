@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// FoldAccents strips diacritics so Vietnamese text can be compared the way people type it without
+// accents: "Quận Bình Thạnh" becomes "Quan Binh Thanh". Đ is a letter of its own rather than an
+// accented D, so it is mapped explicitly.
+func FoldAccents(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range norm.NFD.String(s) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+		case r == 'đ':
+			b.WriteRune('d')
+		case r == 'Đ':
+			b.WriteRune('D')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Slugify turns a name into a lowercase ASCII slug for URLs: "Cơm Tấm Sài Gòn" becomes
+// "com-tam-sai-gon". Runs of anything but letters and digits become a single dash.
+func Slugify(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	dash := false
+	for _, r := range strings.ToLower(FoldAccents(s)) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+		default:
+			dash = true
+		}
+	}
+	return b.String()
+}
@@ -1,47 +1,75 @@
 package utils
 
 import (
+	"crypto/rand"
+	"encoding/base64"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 )
 
+const (
+	TokenUseAccess  = "access"
+	TokenUseRefresh = "refresh"
+)
+
+// JWTClaims are the claims of both access and refresh tokens. SessionId ties a token to the login
+// session it was issued for; the refresh tokens of one session form its rotation family. Both kinds
+// are signed with the same keys, TokenUse keeps one from being accepted as the other.
 type JWTClaims struct {
-	UserId uuid.UUID `json:"user_id"`
-	Email  string    `json:"email"`
-	Role   string    `json:"role"`
+	UserId    uuid.UUID `json:"user_id"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	SessionId string    `json:"sid,omitempty"`
+	TokenUse  string    `json:"token_use"`
 	jwt.RegisteredClaims
 }
 
-func GenerateToken(userId uuid.UUID, email, role string, key string, duration int) (string, error) {
+func GenerateToken(keys *KeySet, tokenUse string, userId uuid.UUID, email, role, sessionId string, duration int) (string, error) {
 	if duration <= 0 {
 		duration = 60 // default to 60 seconds
 	}
 	expiresAt := time.Now().Add(time.Duration(duration) * time.Second)
 	claims := JWTClaims{
-		UserId: userId,
-		Email:  email,
-		Role:   role,
+		UserId:    userId,
+		Email:     email,
+		Role:      role,
+		SessionId: sessionId,
+		TokenUse:  tokenUse,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
 			Issuer:    "go-ai",
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 		},
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(key))
+	return keys.sign(claims)
 }
 
-func VerifyToken(tokenString string, key string) (*JWTClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (any, error) {
-		return []byte(key), nil
-	})
+func VerifyToken(keys *KeySet, tokenUse string, tokenString string) (*JWTClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, keys.keyfunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg(), jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer("go-ai"),
+	)
 	if err != nil {
 		return nil, err
 	}
-	if claims, ok := token.Claims.(*JWTClaims); ok && token.Valid {
-		return claims, nil
+	claims, ok := token.Claims.(*JWTClaims)
+	if !ok || !token.Valid {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+	if claims.TokenUse != tokenUse {
+		return nil, ErrWrongTokenUse
+	}
+	return claims, nil
+}
+
+// GenerateOpaqueToken returns a URL-safe random token carrying size bytes of entropy.
+func GenerateOpaqueToken(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
 	}
-	return nil, jwt.ErrTokenInvalidClaims
+	return base64.RawURLEncoding.EncodeToString(b), nil
 }